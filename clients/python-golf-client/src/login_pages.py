@@ -5,6 +5,7 @@ from functools import partial
 
 import user_client as uc
 import main_page
+from rpc_errors import format_rpc_error
 
 
 class InitialPage(tk.Frame):
@@ -71,7 +72,7 @@ class CreateUserPage(tk.Frame):
                 login_page = LoginPage(self.parent, self.controller, user_client=self.user_client, golfkeypoints_client=self.golfkeypoints_client)
                 self.controller.show_frame(login_page)
             except grpc.RpcError as e:
-                messagebox.showerror("Create User Failed", f"Invalid username: {e.code()}: {e.details()}")
+                messagebox.showerror("Create User Failed", f"Invalid username: {format_rpc_error(e)}")
         else:
             messagebox.showerror("Create User Failed", "Must input something for username, password, and email")
 
@@ -113,6 +114,6 @@ class LoginPage(tk.Frame):
                 main_app_page = main_page.MainAppPage(self.parent, self.controller, user_client=self.user_client, golfkeypoints_client=self.golfkeypoints_client, session_token=session_token)
                 self.controller.show_frame(main_app_page)
             except grpc.RpcError as e:
-                messagebox.showerror("Login User Failed", f"{e.code()}: {e.details()}")
+                messagebox.showerror("Login User Failed", f"{format_rpc_error(e)}")
         else:
             messagebox.showerror("Login Failed", "Must input something for username and password")
@@ -12,6 +12,7 @@ import datetime
 import golfkeypoints_pb2
 import golf_keypoints_client as gc
 import common_pb2
+from rpc_errors import format_rpc_error
 
 
 class MainAppPage(tk.Frame):
@@ -115,7 +116,7 @@ class MainAppPage(tk.Frame):
                 self.curr_input_image = img
                 self.display_input_image(self.curr_input_image)
             except grpc.RpcError as e:
-                messagebox.showerror("Upload input image failed", f"Could not upload input image: {e.code()}: {e.details()}")
+                messagebox.showerror("Upload input image failed", f"Could not upload input image: {format_rpc_error(e)}")
             
     def show_previous_input_images(self):
         self.clear_canvas()
@@ -133,7 +134,7 @@ class MainAppPage(tk.Frame):
                     curr_button = tk.Button(self.canvas, text=f"{response.timestamp.ToDatetime()}: {response.description}", command=partial(self.display_input_image, self.curr_input_image))
                     self.canvas.create_window(100, 30+(i*50), window=curr_button)
         except grpc.RpcError as e:
-            messagebox.showerror("List Images Failed", f"Could not get a list of images: {e.code()}: {e.details()}")
+            messagebox.showerror("List Images Failed", f"Could not get a list of images: {format_rpc_error(e)}")
     
     def read_input_image(self, input_image_id):
         try:
@@ -141,7 +142,7 @@ class MainAppPage(tk.Frame):
             messagebox.showinfo("Show Image", f"Response length of image: {len(response.image)}, ImageType: {response.image_type}, CalibrationType: {response.calibration_type}, FeetLineMethod: {response.feet_line_method}, Description: {response.description}, Timestamp: {response.timestamp.ToDatetime()}")
             return response
         except grpc.RpcError as e:
-            messagebox.showerror("Show Image Failed", f"Could not get image: {e.code()}: {e.details()}")
+            messagebox.showerror("Show Image Failed", f"Could not get image: {format_rpc_error(e)}")
             return None
         
     def display_image(self, image):
@@ -158,7 +159,7 @@ class MainAppPage(tk.Frame):
             response = self.user_client.read_user(self.session_token)
             messagebox.showinfo("Show User", f"User info: {response}")
         except grpc.RpcError as e:
-            messagebox.showerror("Show User", f"Show user failed: {e.code()}: {e.details()}")
+            messagebox.showerror("Show User", f"Show user failed: {format_rpc_error(e)}")
 
     def update_user(self):
         try:
@@ -168,14 +169,14 @@ class MainAppPage(tk.Frame):
             response = self.user_client.update_user(session_token=self.session_token, username=new_username, password=new_password, email=new_email)
             messagebox.showinfo("Update User", f"Updated user info: {response}")
         except grpc.RpcError as e:
-            messagebox.showerror("Update User", f"Update user failed: {e.code()}: {e.details()}")
+            messagebox.showerror("Update User", f"Update user failed: {format_rpc_error(e)}")
     
     def delete_user(self):
         try:
             response = self.user_client.delete_user(self.session_token)
             messagebox.showinfo("Delete User", f"Successfully deleted user {response}")
         except grpc.RpcError as e:
-            messagebox.showerror("Delete User", f"Delete user failed: {e.code()}: {e.details()}")
+            messagebox.showerror("Delete User", f"Delete user failed: {format_rpc_error(e)}")
 
     def display_input_image(self, image):
         self.display_image(image)
@@ -285,7 +286,7 @@ class MainAppPage(tk.Frame):
             messagebox.showinfo("Calibrate Input Image", f"Calibrate input image successful: {response}, calculate golf keypoints next")
             self.calibrate_button.config(state=tk.DISABLED)
         except grpc.RpcError as e:
-            messagebox.showerror("Calibrate Input Image", f"Calibrate input image failed: {e.code()}: {e.details()}")    
+            messagebox.showerror("Calibrate Input Image", f"Calibrate input image failed: {format_rpc_error(e)}")    
 
     def calculate_golf_keypoints(self):
         try:
@@ -295,7 +296,7 @@ class MainAppPage(tk.Frame):
                 self.process_golf_keypoints(response.output_image, response.golf_keypoints)
                 self.calculate_button.config(state=tk.DISABLED)
         except grpc.RpcError as e:
-            messagebox.showerror("Calculate Golf Keypoints", f"Calculate Golf Keypoints failed: {e.code()}: {e.details()}") 
+            messagebox.showerror("Calculate Golf Keypoints", f"Calculate Golf Keypoints failed: {format_rpc_error(e)}") 
 
     def read_golf_keypoints(self):
         try:
@@ -304,7 +305,7 @@ class MainAppPage(tk.Frame):
             if response.output_image is not None:
                 self.process_golf_keypoints(response.output_image, response.golf_keypoints)
         except grpc.RpcError as e:
-            messagebox.showerror("Read Golf Keypoints", f"Read golf keypoints failed: {e.code()}: {e.details()}")
+            messagebox.showerror("Read Golf Keypoints", f"Read golf keypoints failed: {format_rpc_error(e)}")
 
     def process_golf_keypoints(self, output_image, golf_keypoints):
         buffer = BytesIO(output_image)
@@ -364,7 +365,7 @@ class MainAppPage(tk.Frame):
             response = self.golfkeypoints_client.update_body_keypoints(self.session_token, self.curr_input_image_id, self.body_keypoints)
             messagebox.showinfo("Update Body Keypoints", f"Update Body Keypoints successful: {response}")
         except grpc.RpcError as e:
-            messagebox.showerror("Update Body Keypoints", f"Update Body keypoints failed: {e.code()}: {e.details()}")
+            messagebox.showerror("Update Body Keypoints", f"Update Body keypoints failed: {format_rpc_error(e)}")
         
 
     def delete_input_image(self):
@@ -374,7 +375,7 @@ class MainAppPage(tk.Frame):
             # clear canvas
             self.clear_canvas()
         except grpc.RpcError as e:
-            messagebox.showerror("Delete Input Image", f"Delete input image failed: {e.code()}: {e.details()}")
+            messagebox.showerror("Delete Input Image", f"Delete input image failed: {format_rpc_error(e)}")
 
     def delete_golf_keypoints(self):
         try:
@@ -383,7 +384,7 @@ class MainAppPage(tk.Frame):
             # go back to original input image
             self.display_image(self.curr_input_image)
         except grpc.RpcError as e:
-            messagebox.showerror("Delete Golf Keypoints", f"Delete golf keypoints failed: {e.code()}: {e.details()}")
+            messagebox.showerror("Delete Golf Keypoints", f"Delete golf keypoints failed: {format_rpc_error(e)}")
 
     def draw_circle(self, x, y, color):
         radius = 3
@@ -0,0 +1,23 @@
+import grpc
+from grpc_status import rpc_status
+from google.rpc import error_details_pb2
+
+
+# Formats an rpc error with the google.rpc error details sent by the server, eg. one line per invalid field
+def format_rpc_error(e: grpc.RpcError) -> str:
+    message = f"{e.code()}: {e.details()}"
+    status = rpc_status.from_call(e)
+    if status is None:
+        return message
+    for detail in status.details:
+        if detail.Is(error_details_pb2.BadRequest.DESCRIPTOR):
+            bad_request = error_details_pb2.BadRequest()
+            detail.Unpack(bad_request)
+            for violation in bad_request.field_violations:
+                message += f"\n{violation.field}: {violation.description}"
+        elif detail.Is(error_details_pb2.PreconditionFailure.DESCRIPTOR):
+            precondition_failure = error_details_pb2.PreconditionFailure()
+            detail.Unpack(precondition_failure)
+            for violation in precondition_failure.violations:
+                message += f"\n{violation.subject}: {violation.description}"
+    return message
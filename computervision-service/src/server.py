@@ -1,8 +1,12 @@
 from concurrent import futures
 import logging
+import os
 import openpose
 
 import grpc
+from grpc_health.v1 import health
+from grpc_health.v1 import health_pb2
+from grpc_health.v1 import health_pb2_grpc
 import computervision_pb2
 import computervision_pb2_grpc
 import common_pb2
@@ -84,23 +88,18 @@ class ComputerVisionServiceServicer(computervision_pb2_grpc.ComputerVisionServic
             pose_keypoints=body_25_pose_keypoints
         )
     
+    # each frame of a video stream is handled like a unary request, so every response has success set
     def GetPoseImagesFromVideo(self, request_iterator, context):
         print("GetPoseImagesFromVideo grpc request")
-        img_idx = 0
-        for get_open_pose_image_request in request_iterator:
-            img_idx += 1
-            image_bytes = get_open_pose_image_request.image
-            image = self.open_pose_mgr.get_image_from_bytes(image_bytes)
-            processed_img = self.open_pose_mgr.get_open_pose_image(image, self.body_pose_field_descriptors)
-            print(f"Processed image #{img_idx}. It's size is {len(processed_img)}")
-            get_open_pose_image_response = computervision_pb2.GetPoseImageResponse(
-                image=processed_img
-            )
-            yield get_open_pose_image_response
+        for get_pose_image_request in request_iterator:
+            yield self.GetPoseImage(get_pose_image_request, context)
         print("GetPoseImagesFromVideo grpc request finished")
     
     def GetPoseDataFromVideo(self, request_iterator, context):
-        return super().GetPoseDataFromVideo(request_iterator, context)
+        print("GetPoseDataFromVideo grpc request")
+        for get_pose_data_request in request_iterator:
+            yield self.GetPoseData(get_pose_data_request, context)
+        print("GetPoseDataFromVideo grpc request finished")
     
     def GetPoseHandImagesFromVideo(self, request_iterator, context):
         return super().GetPoseHandImagesFromVideo(request_iterator, context)
@@ -109,15 +108,50 @@ class ComputerVisionServiceServicer(computervision_pb2_grpc.ComputerVisionServic
         return super().GetPoseHandDataFromVideo(request_iterator, context)
     
     def GetPoseAllFromVideo(self, request_iterator, context):
-        return super().GetPoseAllFromVideo(request_iterator, context)
+        print("GetPoseAllFromVideo grpc request")
+        for get_pose_all_request in request_iterator:
+            yield self.GetPoseAll(get_pose_all_request, context)
+        print("GetPoseAllFromVideo grpc request finished")
     
 
+
+def read_file(path):
+    with open(path, "rb") as f:
+        return f.read()
+
+
+# TLS with CV_TLS_CERT_FILE/CV_TLS_KEY_FILE, client certificates are required if CV_TLS_CLIENT_CA_FILE is set (mutual TLS with go-server)
+# Plaintext is only used if CV_INSECURE=1 is set explicitly
+def add_port(server, address):
+    cert_file = os.environ.get("CV_TLS_CERT_FILE", "")
+    key_file = os.environ.get("CV_TLS_KEY_FILE", "")
+    client_ca_file = os.environ.get("CV_TLS_CLIENT_CA_FILE", "")
+    if os.environ.get("CV_INSECURE", "") == "1":
+        print("WARNING: serving without TLS because CV_INSECURE=1 is set")
+        server.add_insecure_port(address)
+        return
+    if not cert_file or not key_file:
+        raise RuntimeError("CV_TLS_CERT_FILE and CV_TLS_KEY_FILE are required unless CV_INSECURE=1 is set")
+    root_certificates = read_file(client_ca_file) if client_ca_file else None
+    credentials = grpc.ssl_server_credentials(
+        [(read_file(key_file), read_file(cert_file))],
+        root_certificates=root_certificates,
+        require_client_auth=root_certificates is not None,
+    )
+    server.add_secure_port(address, credentials)
+
+
 def serve():
     server = grpc.server(futures.ThreadPoolExecutor(max_workers=10))
     computervision_pb2_grpc.add_ComputerVisionServiceServicer_to_server(
         ComputerVisionServiceServicer(), server
     )
-    server.add_insecure_port("[::]:50051")
+    # the go server probes this before sending calls, openpose is loaded once the servicer exists
+    health_servicer = health.HealthServicer()
+    health_pb2_grpc.add_HealthServicer_to_server(health_servicer, server)
+    service_name = computervision_pb2.DESCRIPTOR.services_by_name["ComputerVisionService"].full_name
+    health_servicer.set(service_name, health_pb2.HealthCheckResponse.SERVING)
+    add_port(server, "[::]:50051")
     server.start()
     print("Waiting for computervision requests at port 50051")
     print("Waiting for sigint to stop services")
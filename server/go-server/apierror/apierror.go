@@ -0,0 +1,169 @@
+// Typed errors for the keypoints server that map to grpc status codes
+// Errors carry google.rpc error details (field violations, precondition failures, error info) so clients can show
+// per-field messages. They can be wrapped with fmt.Errorf("...: %w", err), grpc finds the status through the wrap chain.
+package apierror
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// ErrorInfo domain for errors raised by this server
+const Domain = "go-server.sports-keypoints"
+
+type Error struct {
+	code    codes.Code
+	message string
+	// machine readable reason added as google.rpc.ErrorInfo, eg. COMPUTERVISION_UNAVAILABLE
+	reason                 string
+	fieldViolations        []*errdetails.BadRequest_FieldViolation
+	preconditionViolations []*errdetails.PreconditionFailure_Violation
+	// added as google.rpc.RetryInfo when set
+	retryDelay time.Duration
+	err        error
+}
+
+func (e *Error) Error() string {
+	if e.err != nil {
+		return fmt.Sprintf("%s: %s", e.message, e.err.Error())
+	}
+	return e.message
+}
+
+func (e *Error) Unwrap() error {
+	return e.err
+}
+
+func (e *Error) Code() codes.Code {
+	return e.code
+}
+
+// Lets status.FromError and the grpc server turn the error into a status with details
+func (e *Error) GRPCStatus() *status.Status {
+	st := status.New(e.code, e.Error())
+	var details []protoadapt.MessageV1
+	if len(e.fieldViolations) > 0 {
+		details = append(details, &errdetails.BadRequest{FieldViolations: e.fieldViolations})
+	}
+	if len(e.preconditionViolations) > 0 {
+		details = append(details, &errdetails.PreconditionFailure{Violations: e.preconditionViolations})
+	}
+	if e.retryDelay > 0 {
+		details = append(details, &errdetails.RetryInfo{RetryDelay: durationpb.New(e.retryDelay)})
+	}
+	if e.reason != "" {
+		details = append(details, &errdetails.ErrorInfo{Reason: e.reason, Domain: Domain})
+	}
+	if len(details) == 0 {
+		return st
+	}
+	withDetails, err := st.WithDetails(details...)
+	if err != nil {
+		return st
+	}
+	return withDetails
+}
+
+// Adds a google.rpc.ErrorInfo reason
+func (e *Error) WithReason(reason string) *Error {
+	e.reason = reason
+	return e
+}
+
+// Adds another field violation to an InvalidArgument error
+func (e *Error) WithFieldViolation(field string, description string) *Error {
+	e.fieldViolations = append(e.fieldViolations, &errdetails.BadRequest_FieldViolation{Field: field, Description: description})
+	return e
+}
+
+// Field is the proto field name (eg. user_name), an empty field means the whole request is invalid
+func InvalidArgument(field string, format string, args ...any) *Error {
+	e := &Error{code: codes.InvalidArgument, message: fmt.Sprintf(format, args...)}
+	if field != "" {
+		e.WithFieldViolation(field, e.message)
+	}
+	return e
+}
+
+func NotFound(format string, args ...any) *Error {
+	return &Error{code: codes.NotFound, message: fmt.Sprintf(format, args...)}
+}
+
+func AlreadyExists(format string, args ...any) *Error {
+	return &Error{code: codes.AlreadyExists, message: fmt.Sprintf(format, args...)}
+}
+
+// Missing, invalid or expired credentials
+func Unauthenticated(format string, args ...any) *Error {
+	return &Error{code: codes.Unauthenticated, message: fmt.Sprintf(format, args...)}
+}
+
+// Valid credentials, but the user is not allowed to do this
+func PermissionDenied(format string, args ...any) *Error {
+	return &Error{code: codes.PermissionDenied, message: fmt.Sprintf(format, args...)}
+}
+
+// The system is not in the state the request needs (eg. the image has not been calibrated), violationType and subject
+// are added as a google.rpc.PreconditionFailure so clients know what to fix
+func FailedPrecondition(violationType string, subject string, format string, args ...any) *Error {
+	message := fmt.Sprintf(format, args...)
+	return &Error{
+		code:    codes.FailedPrecondition,
+		message: message,
+		preconditionViolations: []*errdetails.PreconditionFailure_Violation{
+			{Type: violationType, Subject: subject, Description: message},
+		},
+	}
+}
+
+// The request conflicted with a concurrent request (eg. a retry while the original is still running), it can be retried
+func Aborted(format string, args ...any) *Error {
+	return &Error{code: codes.Aborted, message: fmt.Sprintf(format, args...)}
+}
+
+// A dependency (eg. computervision) could not be reached, the request can be retried
+func Unavailable(err error, format string, args ...any) *Error {
+	return &Error{code: codes.Unavailable, message: fmt.Sprintf(format, args...), err: err}
+}
+
+// The caller ran out of quota (eg. was rate limited), retryDelay is added as a google.rpc.RetryInfo
+func ResourceExhausted(retryDelay time.Duration, format string, args ...any) *Error {
+	return &Error{code: codes.ResourceExhausted, message: fmt.Sprintf(format, args...), retryDelay: retryDelay}
+}
+
+// Data was corrupted on the way (eg. an uploaded image does not match its checksum), the request can be sent again
+func DataLoss(format string, args ...any) *Error {
+	return &Error{code: codes.DataLoss, message: fmt.Sprintf(format, args...)}
+}
+
+func Internal(err error, format string, args ...any) *Error {
+	return &Error{code: codes.Internal, message: fmt.Sprintf(format, args...), err: err}
+}
+
+// Code of the first typed error or grpc status in err's chain, codes.Unknown if there is none
+func Code(err error) codes.Code {
+	if err == nil {
+		return codes.OK
+	}
+	var e *Error
+	if errors.As(err, &e) {
+		return e.code
+	}
+	return status.Code(err)
+}
+
+// Field violations of the first typed error in err's chain
+func FieldViolations(err error) []*errdetails.BadRequest_FieldViolation {
+	var e *Error
+	if errors.As(err, &e) {
+		return e.fieldViolations
+	}
+	return nil
+}
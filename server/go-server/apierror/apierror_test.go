@@ -0,0 +1,111 @@
+package apierror
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestInvalidArgumentDetails(t *testing.T) {
+	// wrapped errors keep their code and details
+	err := fmt.Errorf("could not create user: %w", InvalidArgument("user_name", "please enter a non-empty username"))
+	st, ok := status.FromError(err)
+	if !ok {
+		t.Fatalf("status.FromError(%v) did not find a status", err)
+	}
+	if st.Code() != codes.InvalidArgument {
+		t.Errorf("code is %s, expected %s", st.Code(), codes.InvalidArgument)
+	}
+	if st.Message() != err.Error() {
+		t.Errorf("message is %q, expected %q", st.Message(), err.Error())
+	}
+	var badRequest *errdetails.BadRequest
+	for _, detail := range st.Details() {
+		if b, ok := detail.(*errdetails.BadRequest); ok {
+			badRequest = b
+		}
+	}
+	if badRequest == nil || len(badRequest.FieldViolations) != 1 {
+		t.Fatalf("expected one field violation, details were %v", st.Details())
+	}
+	if badRequest.FieldViolations[0].Field != "user_name" {
+		t.Errorf("field is %s, expected user_name", badRequest.FieldViolations[0].Field)
+	}
+	// no field, no details
+	st = InvalidArgument("", "request is empty").GRPCStatus()
+	if len(st.Details()) != 0 {
+		t.Errorf("expected no details, details were %v", st.Details())
+	}
+}
+
+func TestFailedPreconditionDetails(t *testing.T) {
+	st := FailedPrecondition("CALIBRATION_IMAGE_REQUIRED", "calibration_image_axes", "calibration image axes is required").GRPCStatus()
+	if st.Code() != codes.FailedPrecondition {
+		t.Errorf("code is %s, expected %s", st.Code(), codes.FailedPrecondition)
+	}
+	if len(st.Details()) != 1 {
+		t.Fatalf("expected one detail, details were %v", st.Details())
+	}
+	preconditionFailure, ok := st.Details()[0].(*errdetails.PreconditionFailure)
+	if !ok || preconditionFailure.Violations[0].Type != "CALIBRATION_IMAGE_REQUIRED" || preconditionFailure.Violations[0].Subject != "calibration_image_axes" {
+		t.Errorf("unexpected precondition failure %v", st.Details()[0])
+	}
+}
+
+func TestResourceExhaustedRetryInfo(t *testing.T) {
+	st := ResourceExhausted(1500*time.Millisecond, "rate limit exceeded").WithReason("RATE_LIMITED").GRPCStatus()
+	if st.Code() != codes.ResourceExhausted {
+		t.Errorf("code is %s, expected %s", st.Code(), codes.ResourceExhausted)
+	}
+	var retryInfo *errdetails.RetryInfo
+	for _, detail := range st.Details() {
+		if r, ok := detail.(*errdetails.RetryInfo); ok {
+			retryInfo = r
+		}
+	}
+	if retryInfo == nil || retryInfo.RetryDelay.AsDuration() != 1500*time.Millisecond {
+		t.Errorf("expected a 1.5s retry delay, details were %v", st.Details())
+	}
+}
+
+func TestUnavailableWrapsCause(t *testing.T) {
+	cause := status.Error(codes.Internal, "model crashed")
+	err := Unavailable(cause, "computervision client GetPoseAll failed").WithReason("COMPUTERVISION_UNAVAILABLE")
+	// the typed error wins over the wrapped computervision status
+	if code := status.Code(err); code != codes.Unavailable {
+		t.Errorf("code is %s, expected %s", code, codes.Unavailable)
+	}
+	if code := Code(fmt.Errorf("could not get pose all: %w", err)); code != codes.Unavailable {
+		t.Errorf("code is %s, expected %s", code, codes.Unavailable)
+	}
+	st := err.GRPCStatus()
+	errorInfo, ok := st.Details()[0].(*errdetails.ErrorInfo)
+	if !ok || errorInfo.Reason != "COMPUTERVISION_UNAVAILABLE" || errorInfo.Domain != Domain {
+		t.Errorf("unexpected error info %v", st.Details()[0])
+	}
+}
+
+func TestCode(t *testing.T) {
+	tests := []struct {
+		err  error
+		code codes.Code
+	}{
+		{nil, codes.OK},
+		{fmt.Errorf("plain error"), codes.Unknown},
+		{NotFound("no users with id: %s", "abc"), codes.NotFound},
+		{fmt.Errorf("could not verify user exists: %w", NotFound("no users with id: %s", "abc")), codes.NotFound},
+		{PermissionDenied("not an admin"), codes.PermissionDenied},
+		{Unauthenticated("no session token provided"), codes.Unauthenticated},
+		{status.FromContextError(context.Canceled).Err(), codes.Canceled},
+	}
+	for _, test := range tests {
+		if code := Code(test.err); code != test.code {
+			t.Errorf("Code(%v) is %s, expected %s", test.err, code, test.code)
+		}
+	}
+}
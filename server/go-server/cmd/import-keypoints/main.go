@@ -0,0 +1,62 @@
+// Imports keypoints detected outside of the server (openpose *_keypoints.json output or Body25PoseKeypoints json) for an
+// uploaded input image and prints the golf keypoints calculated from them, computervision is not needed.
+//
+//	go run ./cmd/import-keypoints -input_image_id <id> -keypoints swing_keypoints.json
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	keypointsclient "github.com/sirfrank96/go-server/keypoints-client"
+
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+var (
+	serverAddr    = flag.String("addr", "localhost:50052", "the address of the keypoints server")
+	useInsecure   = flag.Bool("insecure", false, "connect over plain TCP instead of TLS")
+	caFile        = flag.String("ca_file", "", "CA file to verify the server certificate with")
+	certFile      = flag.String("cert_file", "", "client cert file, if the server requires client certificates")
+	keyFile       = flag.String("key_file", "", "client key file, if the server requires client certificates")
+	sessionToken  = flag.String("session_token", os.Getenv("GO_SERVER_SESSION_TOKEN"), "session token from RegisterUser, defaults to GO_SERVER_SESSION_TOKEN")
+	inputImageId  = flag.String("input_image_id", "", "id of the uploaded input image the keypoints were detected in")
+	keypointsPath = flag.String("keypoints", "", "openpose *_keypoints.json or Body25PoseKeypoints json file")
+)
+
+func main() {
+	flag.Parse()
+	if *sessionToken == "" || *inputImageId == "" || *keypointsPath == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+	if err := run(context.Background()); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// Returns instead of exiting so the connection is closed by its defer
+func run(ctx context.Context) error {
+	creds, err := keypointsclient.GetTransportCredentials(*useInsecure, *caFile, *certFile, *keyFile)
+	if err != nil {
+		return fmt.Errorf("failed to get transport credentials: %w", err)
+	}
+	gClient, closeGolfConn, err := keypointsclient.InitGolfKeypointsServiceGrpcClient(*serverAddr, creds)
+	if err != nil {
+		return fmt.Errorf("failed to connect to golf keypoints: %w", err)
+	}
+	defer closeGolfConn()
+	response, err := keypointsclient.ImportGolfKeypoints(ctx, gClient, *sessionToken, *inputImageId, *keypointsPath)
+	if err != nil {
+		return fmt.Errorf("failed to import golf keypoints: %w", err)
+	}
+	out, err := protojson.MarshalOptions{Multiline: true}.Marshal(response.GolfKeypoints)
+	if err != nil {
+		return fmt.Errorf("failed to marshal golf keypoints: %w", err)
+	}
+	fmt.Println(string(out))
+	return nil
+}
@@ -0,0 +1,501 @@
+// Configuration for the go-server, loaded once at startup from a yaml file and environment variables
+// Every manager gets its own section passed to its constructor. Any setting can be overridden with an environment
+// variable named GO_SERVER_ followed by its yaml path in upper case, eg. GO_SERVER_KEYPOINTS_SERVER_PORT. MONGO_URI and
+// COMPUTER_VISION_URI are still read for the database uri and computervision address.
+package config
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+const envPrefix = "GO_SERVER"
+
+type Config struct {
+	KeypointsServer KeypointsServerConfig `yaml:"keypoints_server"`
+	Database        DatabaseConfig        `yaml:"database"`
+	ComputerVision  ComputerVisionConfig  `yaml:"computervision"`
+	Keypoints       KeypointsConfig       `yaml:"keypoints"`
+	AnalysisJobs    AnalysisJobsConfig    `yaml:"analysis_jobs"`
+	Auth            AuthConfig            `yaml:"auth"`
+	Oidc            OidcConfig            `yaml:"oidc"`
+	Metrics         MetricsConfig         `yaml:"metrics"`
+	Tracing         TracingConfig         `yaml:"tracing"`
+	Logging         LoggingConfig         `yaml:"logging"`
+}
+
+type KeypointsServerConfig struct {
+	Port int `yaml:"port"`
+	// serve plain TCP instead of TLS, only for local development
+	Insecure bool `yaml:"insecure"`
+	// reloaded when they change
+	CertFile string `yaml:"cert_file"`
+	KeyFile  string `yaml:"key_file"`
+	// client certificates are not checked if empty
+	ClientCaFile        string            `yaml:"client_ca_file"`
+	RequireClientCert   bool              `yaml:"require_client_cert"`
+	HealthProbeInterval time.Duration     `yaml:"health_probe_interval"`
+	Reflection          bool              `yaml:"reflection"`
+	Gateway             GatewayConfig     `yaml:"gateway"`
+	GrpcWeb             GrpcWebConfig     `yaml:"grpc_web"`
+	RateLimit           RateLimitConfig   `yaml:"rate_limit"`
+	Idempotency         IdempotencyConfig `yaml:"idempotency"`
+	Messages            MessageConfig     `yaml:"messages"`
+	// largest image assembled from a streaming upload, in bytes (mongodb documents are limited to 16MB)
+	MaxImageSize int64 `yaml:"max_image_size"`
+}
+
+type GatewayConfig struct {
+	// 0 disables the rest gateway
+	Port int `yaml:"port"`
+	// largest multipart image upload, in bytes
+	MaxUploadSize int64 `yaml:"max_upload_size"`
+}
+
+type GrpcWebConfig struct {
+	Enabled bool `yaml:"enabled"`
+	// 0 serves grpc-web next to native grpc on the keypoints server port
+	Port int `yaml:"port"`
+	// comma separated origins allowed by CORS, * allows any origin
+	AllowedOrigins string `yaml:"allowed_origins"`
+}
+
+type RateLimitConfig struct {
+	// requests per second per caller and method, 0 disables rate limiting
+	Rate  float64 `yaml:"rate"`
+	Burst int     `yaml:"burst"`
+	// per method overrides as method=requests_per_second:burst, comma separated
+	Methods string `yaml:"methods"`
+}
+
+// Rpcs sent with an idempotency-key header are only executed once per key and user, retries get the stored response
+type IdempotencyConfig struct {
+	// how long responses are stored, 0 disables idempotency keys
+	Window time.Duration `yaml:"window"`
+	// comma separated rpc names that accept an idempotency key
+	Methods string `yaml:"methods"`
+}
+
+// Message limits and compression of a grpc server or client
+type MessageConfig struct {
+	// in bytes
+	MaxRecvSize int `yaml:"max_recv_size"`
+	MaxSendSize int `yaml:"max_send_size"`
+	// none or gzip
+	Compression string `yaml:"compression"`
+}
+
+type DatabaseConfig struct {
+	Uri  string `yaml:"uri" env:"MONGO_URI"`
+	Name string `yaml:"name"`
+}
+
+type ComputerVisionConfig struct {
+	// comma separated host:port of the computervision backends, dns:///host:port uses every address of host
+	Address string `yaml:"address" env:"COMPUTER_VISION_URI"`
+	// connect over plain TCP instead of TLS, only for local development
+	Insecure bool `yaml:"insecure"`
+	// uses the system roots if empty
+	CaFile string `yaml:"ca_file"`
+	// client certificate for mutual TLS, reloaded when it changes
+	CertFile   string `yaml:"cert_file"`
+	KeyFile    string `yaml:"key_file"`
+	ServerName string `yaml:"server_name"`
+	// timeouts for pose estimation of one image and of a whole video
+	ImageTimeout time.Duration `yaml:"image_timeout"`
+	VideoTimeout time.Duration `yaml:"video_timeout"`
+	// frames of a video stream sent to computervision before their responses are received, more frames are only read
+	// once the oldest response was received by the caller
+	VideoWindow int           `yaml:"video_window"`
+	Messages    MessageConfig `yaml:"messages"`
+	// per method timeouts as method=duration, comma separated, eg. GetPoseAll=90s. Other unary calls use image_timeout
+	// and streams use video_timeout. The caller's deadline applies if it is earlier.
+	Timeouts       string               `yaml:"timeouts"`
+	Retry          RetryConfig          `yaml:"retry"`
+	CircuitBreaker CircuitBreakerConfig `yaml:"circuit_breaker"`
+	Cache          PoseCacheConfig      `yaml:"cache"`
+	// calls sent to one backend at once, 0 is unlimited
+	MaxConcurrentRequests int `yaml:"max_concurrent_requests"`
+	// a backend that fails this many calls in a row gets no calls for ejection_duration
+	EjectAfterFailures int           `yaml:"eject_after_failures"`
+	EjectionDuration   time.Duration `yaml:"ejection_duration"`
+	// grpc calls computervision, record also saves its responses to fixture_dir, replay only serves the responses
+	// saved in fixture_dir so no computervision service is needed
+	Mode       string `yaml:"mode"`
+	FixtureDir string `yaml:"fixture_dir"`
+}
+
+// Unary pose calls that fail with Unavailable or ResourceExhausted are sent again after a jittered exponential backoff
+type RetryConfig struct {
+	// including the first attempt, 1 disables retries
+	MaxAttempts    int           `yaml:"max_attempts"`
+	InitialBackoff time.Duration `yaml:"initial_backoff"`
+	MaxBackoff     time.Duration `yaml:"max_backoff"`
+}
+
+// Computervision calls fail fast with Unavailable for open_duration after failure_threshold calls in a row failed
+type CircuitBreakerConfig struct {
+	// 0 disables the circuit breaker
+	FailureThreshold int           `yaml:"failure_threshold"`
+	OpenDuration     time.Duration `yaml:"open_duration"`
+}
+
+// Successful pose estimations are cached by the content of the image
+type PoseCacheConfig struct {
+	// in bytes, 0 disables the in memory cache
+	MemorySize int64 `yaml:"memory_size"`
+	// also cache results in the database for persistent_ttl
+	Persistent    bool          `yaml:"persistent"`
+	PersistentTtl time.Duration `yaml:"persistent_ttl"`
+	// part of every cache key, changing it (eg. after updating the computervision model) invalidates the cache
+	ModelVersion string `yaml:"model_version"`
+}
+
+type KeypointsConfig struct {
+	// keypoints below this confidence get a warning
+	MinConfidence float64 `yaml:"min_confidence"`
+	// how far off 90 degrees the axes in an axes calibration image can be
+	AxesToleranceDegrees float64 `yaml:"axes_tolerance_degrees"`
+}
+
+// SubmitAnalysis jobs are queued in the database and run by a pool of workers in every go-server
+type AnalysisJobsConfig struct {
+	// jobs run at once by this server, 0 only queues jobs for other servers
+	Workers int `yaml:"workers"`
+	// how often idle workers look for jobs queued by other servers or left behind by a stopped server
+	PollInterval time.Duration `yaml:"poll_interval"`
+	// a job is given up after timeout, the job of a server that stopped while running it is picked up again after it
+	Timeout time.Duration `yaml:"timeout"`
+	// jobs that fail with a transient error (eg. computervision is unavailable) are run again, up to max_attempts times
+	MaxAttempts int `yaml:"max_attempts"`
+	// how long done and failed jobs are kept
+	Retention time.Duration `yaml:"retention"`
+	// how often WatchAnalysisJob checks the job for changes
+	WatchInterval time.Duration `yaml:"watch_interval"`
+}
+
+type AuthConfig struct {
+	SessionTokenLifetime time.Duration `yaml:"session_token_lifetime"`
+	// comma separated user ids that are always admins, used to bootstrap the first admin. Ids and not usernames, so the
+	// setting can not be claimed by registering (or logging in with openid connect as) a listed username.
+	AdminUserIds string `yaml:"admin_user_ids"`
+}
+
+type OidcConfig struct {
+	// json file with the providers, added to the providers listed here
+	ProvidersFile string               `yaml:"providers_file"`
+	Providers     []OidcProviderConfig `yaml:"providers"`
+}
+
+type OidcProviderConfig struct {
+	Name         string `yaml:"name" json:"name"`
+	Issuer       string `yaml:"issuer" json:"issuer"`
+	ClientId     string `yaml:"client_id" json:"client_id"`
+	ClientSecret string `yaml:"client_secret" json:"client_secret"`
+	RedirectUri  string `yaml:"redirect_uri" json:"redirect_uri"`
+	// create a new user the first time an unknown external identity logs in
+	CreateUsers bool `yaml:"create_users" json:"create_users"`
+}
+
+type MetricsConfig struct {
+	// 0 disables the /metrics endpoint
+	Port int `yaml:"port"`
+}
+
+type TracingConfig struct {
+	// none, stdout or otlp
+	Exporter string `yaml:"exporter"`
+	// defaults to OTEL_EXPORTER_OTLP_ENDPOINT or localhost:4317
+	OtlpEndpoint string  `yaml:"otlp_endpoint"`
+	OtlpInsecure bool    `yaml:"otlp_insecure"`
+	SampleRatio  float64 `yaml:"sample_ratio"`
+}
+
+type LoggingConfig struct {
+	// debug, info, warn or error
+	Level string `yaml:"level"`
+	// per package levels that override level, eg. db=debug,cv-client=warn
+	Levels string `yaml:"levels"`
+	// json or text
+	Format string `yaml:"format"`
+}
+
+func Default() *Config {
+	return &Config{
+		KeypointsServer: KeypointsServerConfig{
+			Port:                50052,
+			HealthProbeInterval: 10 * time.Second,
+			Gateway: GatewayConfig{
+				Port:          8080,
+				MaxUploadSize: 32 << 20,
+			},
+			RateLimit: RateLimitConfig{
+				Rate:    5,
+				Burst:   20,
+				Methods: "CalculateGolfKeypoints=0.2:3,SubmitAnalysis=0.2:3,CalibrateInputImage=0.5:5,CalibrateInputImageStream=0.5:5,CreateUser=0.1:5,RegisterUser=0.5:10,VerifyTotp=0.5:10,LoginWithOidc=0.5:10",
+			},
+			Idempotency: IdempotencyConfig{
+				Window:  24 * time.Hour,
+				Methods: "UploadInputImage,UploadInputImageStream,CalibrateInputImage,CalibrateInputImageStream,CalculateGolfKeypoints,SubmitAnalysis",
+			},
+			// room for a gateway upload and the rest of its request
+			Messages: MessageConfig{
+				MaxRecvSize: 33 << 20,
+				MaxSendSize: 33 << 20,
+				Compression: "none",
+			},
+			MaxImageSize: 15 << 20,
+		},
+		Database: DatabaseConfig{
+			Uri:  "mongodb://localhost:27017",
+			Name: "golfkeypointsdatabase",
+		},
+		ComputerVision: ComputerVisionConfig{
+			Address:      "localhost:50051",
+			ImageTimeout: 60 * time.Second,
+			VideoTimeout: 100 * time.Second,
+			VideoWindow:  4,
+			Messages: MessageConfig{
+				MaxRecvSize: 33 << 20,
+				MaxSendSize: 33 << 20,
+				Compression: "none",
+			},
+			Retry: RetryConfig{
+				MaxAttempts:    3,
+				InitialBackoff: 200 * time.Millisecond,
+				MaxBackoff:     2 * time.Second,
+			},
+			CircuitBreaker: CircuitBreakerConfig{
+				FailureThreshold: 5,
+				OpenDuration:     30 * time.Second,
+			},
+			Cache: PoseCacheConfig{
+				MemorySize:    256 << 20,
+				PersistentTtl: 30 * 24 * time.Hour,
+			},
+			MaxConcurrentRequests: 2,
+			EjectAfterFailures:    3,
+			EjectionDuration:      30 * time.Second,
+			Mode:                  "grpc",
+		},
+		Keypoints: KeypointsConfig{
+			MinConfidence:        0.5,
+			AxesToleranceDegrees: 10,
+		},
+		AnalysisJobs: AnalysisJobsConfig{
+			Workers:       2,
+			PollInterval:  5 * time.Second,
+			Timeout:       5 * time.Minute,
+			MaxAttempts:   3,
+			Retention:     7 * 24 * time.Hour,
+			WatchInterval: time.Second,
+		},
+		Auth: AuthConfig{
+			SessionTokenLifetime: 24 * time.Hour,
+		},
+		Metrics: MetricsConfig{
+			Port: 9090,
+		},
+		Tracing: TracingConfig{
+			Exporter:    "none",
+			SampleRatio: 1.0,
+		},
+		Logging: LoggingConfig{
+			Level:  "info",
+			Format: "json",
+		},
+	}
+}
+
+// Defaults, overridden by the yaml file at path (if not empty), overridden by environment variables, then validated
+func Load(path string) (*Config, error) {
+	cfg := Default()
+	if path != "" {
+		file, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("could not read config %s: %w", path, err)
+		}
+		if err := cfg.decode(file); err != nil {
+			return nil, fmt.Errorf("could not parse config %s: %w", path, err)
+		}
+	}
+	if err := applyEnv(reflect.ValueOf(cfg).Elem(), envPrefix, os.LookupEnv); err != nil {
+		return nil, err
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid config: %w", err)
+	}
+	return cfg, nil
+}
+
+// Unknown keys are errors so typos do not silently fall back to defaults
+func (c *Config) decode(file []byte) error {
+	decoder := yaml.NewDecoder(bytes.NewReader(file))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(c); err != nil && !errors.Is(err, io.EOF) {
+		return err
+	}
+	return nil
+}
+
+// Sets every field from GO_SERVER_<YAML_PATH> (and its env tag, if any) when the variable is set.
+// Only scalar fields can be overridden, lists like the oidc providers have to be set in the yaml file.
+func applyEnv(v reflect.Value, prefix string, lookupEnv func(string) (string, bool)) error {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name := strings.Split(field.Tag.Get("yaml"), ",")[0]
+		envName := fmt.Sprintf("%s_%s", prefix, strings.ToUpper(name))
+		fieldValue := v.Field(i)
+		if fieldValue.Kind() == reflect.Struct && field.Type != reflect.TypeOf(time.Duration(0)) {
+			if err := applyEnv(fieldValue, envName, lookupEnv); err != nil {
+				return err
+			}
+			continue
+		}
+		// the generated name wins over the env tag
+		for _, key := range []string{field.Tag.Get("env"), envName} {
+			if key == "" {
+				continue
+			}
+			value, ok := lookupEnv(key)
+			if !ok {
+				continue
+			}
+			if err := setField(fieldValue, value); err != nil {
+				return fmt.Errorf("invalid value %q for %s: %w", value, key, err)
+			}
+		}
+	}
+	return nil
+}
+
+func setField(v reflect.Value, value string) error {
+	if v.Type() == reflect.TypeOf(time.Duration(0)) {
+		d, err := time.ParseDuration(value)
+		if err != nil {
+			return err
+		}
+		v.SetInt(int64(d))
+		return nil
+	}
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(value)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int64:
+		n, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return err
+		}
+		v.SetInt(n)
+	case reflect.Float64:
+		f, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return err
+		}
+		v.SetFloat(f)
+	default:
+		return fmt.Errorf("%s can not be set from the environment", v.Type())
+	}
+	return nil
+}
+
+// Checks the settings that do not depend on other packages, every problem is reported at once
+func (c *Config) Validate() error {
+	var errs []error
+	check := func(ok bool, format string, args ...any) {
+		if !ok {
+			errs = append(errs, fmt.Errorf(format, args...))
+		}
+	}
+	s := c.KeypointsServer
+	check(s.Port > 0 && s.Port <= 65535, "keypoints_server.port %d is not a valid port", s.Port)
+	check(s.Insecure || (s.CertFile != "" && s.KeyFile != ""), "keypoints_server.cert_file and keypoints_server.key_file are required unless keypoints_server.insecure is set")
+	check(!s.RequireClientCert || s.ClientCaFile != "", "keypoints_server.require_client_cert needs keypoints_server.client_ca_file")
+	check(s.HealthProbeInterval > 0, "keypoints_server.health_probe_interval must be positive")
+	check(s.Gateway.Port >= 0 && s.Gateway.Port <= 65535, "keypoints_server.gateway.port %d is not a valid port", s.Gateway.Port)
+	check(s.Gateway.Port != s.Port, "keypoints_server.gateway.port must be different from keypoints_server.port")
+	check(s.Gateway.MaxUploadSize > 0, "keypoints_server.gateway.max_upload_size must be positive")
+	check(s.GrpcWeb.Port >= 0 && s.GrpcWeb.Port <= 65535, "keypoints_server.grpc_web.port %d is not a valid port", s.GrpcWeb.Port)
+	check(s.GrpcWeb.Port == 0 || (s.GrpcWeb.Port != s.Port && s.GrpcWeb.Port != s.Gateway.Port), "keypoints_server.grpc_web.port must be different from the grpc and gateway ports")
+	check(s.RateLimit.Rate >= 0, "keypoints_server.rate_limit.rate must not be negative")
+	check(s.RateLimit.Rate == 0 || s.RateLimit.Burst > 0, "keypoints_server.rate_limit.burst must be positive")
+	check(s.Idempotency.Window >= 0, "keypoints_server.idempotency.window must not be negative")
+	errs = append(errs, s.Messages.validate("keypoints_server.messages")...)
+	check(s.Gateway.Port == 0 || s.Gateway.MaxUploadSize < int64(s.Messages.MaxRecvSize), "keypoints_server.gateway.max_upload_size must be smaller than keypoints_server.messages.max_recv_size")
+	check(s.MaxImageSize > 0, "keypoints_server.max_image_size must be positive")
+	check(c.Database.Uri != "", "database.uri is required")
+	check(c.Database.Name != "", "database.name is required")
+	cv := c.ComputerVision
+	check(cv.Address != "" || cv.Mode == "replay", "computervision.address is required")
+	check((cv.CertFile == "") == (cv.KeyFile == ""), "computervision.cert_file and computervision.key_file must be set together")
+	check(cv.ImageTimeout > 0, "computervision.image_timeout must be positive")
+	check(cv.VideoTimeout > 0, "computervision.video_timeout must be positive")
+	check(cv.VideoWindow > 0, "computervision.video_window must be positive")
+	errs = append(errs, cv.Messages.validate("computervision.messages")...)
+	check(cv.Retry.MaxAttempts > 0, "computervision.retry.max_attempts must be positive")
+	check(cv.Retry.InitialBackoff > 0 && cv.Retry.MaxBackoff >= cv.Retry.InitialBackoff, "computervision.retry.initial_backoff must be positive and at most computervision.retry.max_backoff")
+	check(cv.CircuitBreaker.FailureThreshold >= 0, "computervision.circuit_breaker.failure_threshold must not be negative")
+	check(cv.CircuitBreaker.FailureThreshold == 0 || cv.CircuitBreaker.OpenDuration > 0, "computervision.circuit_breaker.open_duration must be positive")
+	check(cv.Cache.MemorySize >= 0, "computervision.cache.memory_size must not be negative")
+	check(!cv.Cache.Persistent || cv.Cache.PersistentTtl > 0, "computervision.cache.persistent_ttl must be positive")
+	check(cv.MaxConcurrentRequests >= 0, "computervision.max_concurrent_requests must not be negative")
+	check(cv.EjectAfterFailures > 0, "computervision.eject_after_failures must be positive")
+	check(cv.EjectionDuration > 0, "computervision.ejection_duration must be positive")
+	switch cv.Mode {
+	case "grpc":
+	case "record", "replay":
+		check(cv.FixtureDir != "", "computervision.fixture_dir is required in %s mode", cv.Mode)
+	default:
+		check(false, "unknown computervision.mode %s, expected grpc, record or replay", cv.Mode)
+	}
+	check(c.Keypoints.MinConfidence >= 0 && c.Keypoints.MinConfidence <= 1, "keypoints.min_confidence must be between 0 and 1")
+	check(c.Keypoints.AxesToleranceDegrees > 0 && c.Keypoints.AxesToleranceDegrees < 90, "keypoints.axes_tolerance_degrees must be between 0 and 90")
+	j := c.AnalysisJobs
+	check(j.Workers >= 0, "analysis_jobs.workers must not be negative")
+	check(j.PollInterval > 0, "analysis_jobs.poll_interval must be positive")
+	check(j.Timeout > 0, "analysis_jobs.timeout must be positive")
+	check(j.MaxAttempts > 0, "analysis_jobs.max_attempts must be positive")
+	check(j.Retention > 0, "analysis_jobs.retention must be positive")
+	check(j.WatchInterval > 0, "analysis_jobs.watch_interval must be positive")
+	check(c.Auth.SessionTokenLifetime > 0, "auth.session_token_lifetime must be positive")
+	check(c.Metrics.Port >= 0 && c.Metrics.Port <= 65535, "metrics.port %d is not a valid port", c.Metrics.Port)
+	check(c.Metrics.Port == 0 || (c.Metrics.Port != s.Port && c.Metrics.Port != s.Gateway.Port), "metrics.port must be different from the grpc and gateway ports")
+	switch c.Tracing.Exporter {
+	case "none", "stdout", "otlp":
+	default:
+		check(false, "unknown tracing.exporter %s, expected none, stdout or otlp", c.Tracing.Exporter)
+	}
+	check(c.Tracing.SampleRatio >= 0 && c.Tracing.SampleRatio <= 1, "tracing.sample_ratio must be between 0 and 1")
+	check(c.Logging.Format == "json" || c.Logging.Format == "text", "unknown logging.format %s, expected json or text", c.Logging.Format)
+	return errors.Join(errs...)
+}
+
+func (m MessageConfig) validate(prefix string) []error {
+	var errs []error
+	if m.MaxRecvSize <= 0 {
+		errs = append(errs, fmt.Errorf("%s.max_recv_size must be positive", prefix))
+	}
+	if m.MaxSendSize <= 0 {
+		errs = append(errs, fmt.Errorf("%s.max_send_size must be positive", prefix))
+	}
+	if m.Compression != "none" && m.Compression != "gzip" {
+		errs = append(errs, fmt.Errorf("unknown %s.compression %s, expected none or gzip", prefix, m.Compression))
+	}
+	return errs
+}
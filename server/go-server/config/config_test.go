@@ -0,0 +1,140 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func writeConfig(t *testing.T, contents string) string {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(path, []byte(contents), 0600); err != nil {
+		t.Fatalf("could not write config: %s", err.Error())
+	}
+	return path
+}
+
+func TestLoadFile(t *testing.T) {
+	path := writeConfig(t, `
+keypoints_server:
+  port: 6000
+  insecure: true
+  rate_limit:
+    methods: CalculateGolfKeypoints=1:1
+computervision:
+  address: computervision:50051
+  image_timeout: 30s
+keypoints:
+  min_confidence: 0.3
+oidc:
+  providers:
+    - name: club
+      issuer: https://login.example-club.com
+      client_id: sports-keypoints
+`)
+	cfg, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load had an unexpected error: %s", err.Error())
+	}
+	if cfg.KeypointsServer.Port != 6000 || !cfg.KeypointsServer.Insecure || cfg.KeypointsServer.RateLimit.Methods != "CalculateGolfKeypoints=1:1" {
+		t.Errorf("keypoints_server was not loaded: %+v", cfg.KeypointsServer)
+	}
+	if cfg.ComputerVision.Address != "computervision:50051" || cfg.ComputerVision.ImageTimeout != 30*time.Second {
+		t.Errorf("computervision was not loaded: %+v", cfg.ComputerVision)
+	}
+	if cfg.Keypoints.MinConfidence != 0.3 {
+		t.Errorf("min confidence is %v, expected 0.3", cfg.Keypoints.MinConfidence)
+	}
+	if len(cfg.Oidc.Providers) != 1 || cfg.Oidc.Providers[0].ClientId != "sports-keypoints" {
+		t.Errorf("oidc providers were not loaded: %+v", cfg.Oidc.Providers)
+	}
+	// settings that are not in the file keep their defaults
+	defaults := Default()
+	if cfg.ComputerVision.VideoTimeout != defaults.ComputerVision.VideoTimeout || cfg.Database != defaults.Database || cfg.Auth != defaults.Auth {
+		t.Errorf("defaults were not kept: %+v %+v %+v", cfg.ComputerVision, cfg.Database, cfg.Auth)
+	}
+	if cfg.Keypoints.AxesToleranceDegrees != 10 {
+		t.Errorf("axes tolerance is %v, expected the default 10", cfg.Keypoints.AxesToleranceDegrees)
+	}
+}
+
+func TestLoadUnknownKey(t *testing.T) {
+	path := writeConfig(t, "keypoints_server:\n  insecure: true\n  prot: 6000\n")
+	if _, err := Load(path); err == nil || !strings.Contains(err.Error(), "prot") {
+		t.Errorf("Load with an unknown key returned %v, expected an error naming the key", err)
+	}
+}
+
+func TestApplyEnv(t *testing.T) {
+	env := map[string]string{
+		"GO_SERVER_KEYPOINTS_SERVER_PORT":                    "6000",
+		"GO_SERVER_KEYPOINTS_SERVER_INSECURE":                "true",
+		"GO_SERVER_KEYPOINTS_SERVER_GRPC_WEB_ENABLED":        "1",
+		"GO_SERVER_KEYPOINTS_SERVER_GATEWAY_MAX_UPLOAD_SIZE": "1024",
+		"GO_SERVER_AUTH_SESSION_TOKEN_LIFETIME":              "1h",
+		"GO_SERVER_TRACING_SAMPLE_RATIO":                     "0.25",
+		"MONGO_URI":                                          "mongodb://mongodb:27017",
+		"COMPUTER_VISION_URI":                                "computervision:50051",
+		"GO_SERVER_COMPUTERVISION_ADDRESS":                   "other:50051",
+	}
+	lookupEnv := func(key string) (string, bool) {
+		value, ok := env[key]
+		return value, ok
+	}
+	cfg := Default()
+	if err := applyEnv(reflect.ValueOf(cfg).Elem(), envPrefix, lookupEnv); err != nil {
+		t.Fatalf("applyEnv had an unexpected error: %s", err.Error())
+	}
+	if cfg.KeypointsServer.Port != 6000 || !cfg.KeypointsServer.Insecure || !cfg.KeypointsServer.GrpcWeb.Enabled || cfg.KeypointsServer.Gateway.MaxUploadSize != 1024 {
+		t.Errorf("keypoints_server was not overridden: %+v", cfg.KeypointsServer)
+	}
+	if cfg.Auth.SessionTokenLifetime != time.Hour {
+		t.Errorf("session token lifetime is %v, expected 1h", cfg.Auth.SessionTokenLifetime)
+	}
+	if cfg.Tracing.SampleRatio != 0.25 {
+		t.Errorf("sample ratio is %v, expected 0.25", cfg.Tracing.SampleRatio)
+	}
+	if cfg.Database.Uri != "mongodb://mongodb:27017" {
+		t.Errorf("database uri is %s, expected MONGO_URI", cfg.Database.Uri)
+	}
+	// GO_SERVER_ variables win over the older names
+	if cfg.ComputerVision.Address != "other:50051" {
+		t.Errorf("computervision address is %s, expected GO_SERVER_COMPUTERVISION_ADDRESS", cfg.ComputerVision.Address)
+	}
+	env = map[string]string{"GO_SERVER_METRICS_PORT": "ninety"}
+	if err := applyEnv(reflect.ValueOf(Default()).Elem(), envPrefix, lookupEnv); err == nil || !strings.Contains(err.Error(), "GO_SERVER_METRICS_PORT") {
+		t.Errorf("applyEnv with an invalid port returned %v, expected an error naming the variable", err)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	// tls is required unless insecure is set
+	if err := Default().Validate(); err == nil || !strings.Contains(err.Error(), "keypoints_server.cert_file") {
+		t.Errorf("Validate without certificates returned %v, expected a cert_file error", err)
+	}
+	cfg := Default()
+	cfg.KeypointsServer.Insecure = true
+	if err := cfg.Validate(); err != nil {
+		t.Errorf("Validate of the insecure defaults had an unexpected error: %s", err.Error())
+	}
+	cfg.Keypoints.MinConfidence = 2
+	cfg.ComputerVision.ImageTimeout = 0
+	cfg.Tracing.Exporter = "jaeger"
+	cfg.Metrics.Port = cfg.KeypointsServer.Port
+	cfg.ComputerVision.Messages.Compression = "brotli"
+	cfg.KeypointsServer.Messages.MaxRecvSize = 1 << 20
+	cfg.ComputerVision.Mode = "replay"
+	err := cfg.Validate()
+	if err == nil {
+		t.Fatalf("Validate of an invalid config did not return an error")
+	}
+	// every problem is reported at once
+	for _, expected := range []string{"keypoints.min_confidence", "computervision.image_timeout", "tracing.exporter", "metrics.port", "computervision.messages.compression", "keypoints_server.gateway.max_upload_size", "computervision.fixture_dir"} {
+		if !strings.Contains(err.Error(), expected) {
+			t.Errorf("Validate error %q does not mention %s", err.Error(), expected)
+		}
+	}
+}
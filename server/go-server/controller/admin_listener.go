@@ -0,0 +1,274 @@
+package controller
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/sirfrank96/go-server/apierror"
+	db "github.com/sirfrank96/go-server/db"
+	skp "github.com/sirfrank96/go-server/sports-keypoints-proto"
+	"github.com/sirfrank96/go-server/util"
+)
+
+const defaultListUsersPageSize = 50
+
+type AdminListener struct {
+	skp.UnimplementedAdminServiceServer
+	dbmgr *db.DbManager
+	// user ids from auth.admin_user_ids, they are admins without the admin role
+	adminUserIds []string
+}
+
+func newAdminListener(dbmgr *db.DbManager, adminUserIds string) *AdminListener {
+	return &AdminListener{
+		dbmgr:        dbmgr,
+		adminUserIds: parseAdminUserIds(adminUserIds),
+	}
+}
+
+func (a *AdminListener) ListUsers(ctx context.Context, request *skp.ListUsersRequest) (*skp.ListUsersResponse, error) {
+	if _, err := a.verifyAdmin(ctx); err != nil {
+		return nil, err
+	}
+	pageSize := int64(request.PageSize)
+	if pageSize == 0 {
+		pageSize = defaultListUsersPageSize
+	}
+	users, err := a.dbmgr.ListUsers(ctx, request.Query, pageSize, request.PageToken)
+	if err != nil {
+		return nil, fmt.Errorf("could not list users from db: %w", err)
+	}
+	var adminUsers []*skp.AdminUser
+	for _, user := range users {
+		adminUsers = append(adminUsers, convertUserToAdminUser(user))
+	}
+	// a full page may be followed by more users
+	nextPageToken := ""
+	if int64(len(users)) == pageSize {
+		nextPageToken = users[len(users)-1].Id.Hex()
+	}
+	// return response
+	response := &skp.ListUsersResponse{
+		Success:       true,
+		Users:         adminUsers,
+		NextPageToken: nextPageToken,
+	}
+	return response, nil
+}
+
+func (a *AdminListener) SetUserDisabled(ctx context.Context, request *skp.SetUserDisabledRequest) (*skp.SetUserDisabledResponse, error) {
+	admin, err := a.verifyAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	user, err := a.dbmgr.ReadUserFromUsername(ctx, request.UserName)
+	if err != nil {
+		return nil, fmt.Errorf("could not find user with username: %s, error: %w", request.UserName, err)
+	}
+	if request.Disabled && user.Id == admin.Id {
+		return nil, apierror.FailedPrecondition("ADMIN_SELF", request.UserName, "admins can not disable themselves")
+	}
+	if err := a.dbmgr.SetUserDisabled(ctx, user.Id.Hex(), request.Disabled); err != nil {
+		return nil, fmt.Errorf("could not set user %s disabled: %w", request.UserName, err)
+	}
+	logger.InfoContext(ctx, "set user disabled", "username", request.UserName, "disabled", request.Disabled)
+	// return response
+	response := &skp.SetUserDisabledResponse{
+		Success: true,
+	}
+	return response, nil
+}
+
+func (a *AdminListener) ForcePasswordReset(ctx context.Context, request *skp.ForcePasswordResetRequest) (*skp.ForcePasswordResetResponse, error) {
+	if _, err := a.verifyAdmin(ctx); err != nil {
+		return nil, err
+	}
+	user, err := a.dbmgr.ReadUserFromUsername(ctx, request.UserName)
+	if err != nil {
+		return nil, fmt.Errorf("could not find user with username: %s, error: %w", request.UserName, err)
+	}
+	// user logs in with the temporary password and has to set a new one before doing anything else
+	temporaryPassword := rand.Text()
+	passwordHash, err := db.HashPassword(temporaryPassword)
+	if err != nil {
+		return nil, apierror.Internal(err, "could not hash temporary password")
+	}
+	if err := a.dbmgr.ResetUserPassword(ctx, user.Id.Hex(), passwordHash); err != nil {
+		return nil, fmt.Errorf("could not reset password for user %s: %w", request.UserName, err)
+	}
+	logger.InfoContext(ctx, "forced password reset", "username", request.UserName)
+	// return response
+	response := &skp.ForcePasswordResetResponse{
+		Success:           true,
+		TemporaryPassword: temporaryPassword,
+	}
+	return response, nil
+}
+
+func (a *AdminListener) SetUserRole(ctx context.Context, request *skp.SetUserRoleRequest) (*skp.SetUserRoleResponse, error) {
+	if _, err := a.verifyAdmin(ctx); err != nil {
+		return nil, err
+	}
+	user, err := a.dbmgr.ReadUserFromUsername(ctx, request.UserName)
+	if err != nil {
+		return nil, fmt.Errorf("could not find user with username: %s, error: %w", request.UserName, err)
+	}
+	if err := a.dbmgr.SetUserRole(ctx, user.Id.Hex(), request.Role); err != nil {
+		return nil, fmt.Errorf("could not set role for user %s: %w", request.UserName, err)
+	}
+	logger.InfoContext(ctx, "set user role", "username", request.UserName, "role", request.Role.String())
+	// return response
+	response := &skp.SetUserRoleResponse{
+		Success: true,
+	}
+	return response, nil
+}
+
+func (a *AdminListener) GetUserStats(ctx context.Context, request *skp.GetUserStatsRequest) (*skp.GetUserStatsResponse, error) {
+	if _, err := a.verifyAdmin(ctx); err != nil {
+		return nil, err
+	}
+	user, err := a.dbmgr.ReadUserFromUsername(ctx, request.UserName)
+	if err != nil {
+		return nil, fmt.Errorf("could not find user with username: %s, error: %w", request.UserName, err)
+	}
+	stats, err := a.dbmgr.GetUserStats(ctx, user.OrgId, user.Id.Hex())
+	if err != nil {
+		return nil, fmt.Errorf("could not get stats for user %s: %w", request.UserName, err)
+	}
+	userStats := &skp.UserStats{
+		InputImages:      stats.InputImages,
+		CalibratedImages: stats.CalibratedImages,
+		GolfKeypoints:    stats.GolfKeypoints,
+		ImageBytes:       stats.ImageBytes,
+	}
+	if !stats.LastUpload.IsZero() {
+		userStats.LastUpload = timestamppb.New(stats.LastUpload)
+	}
+	// return response
+	response := &skp.GetUserStatsResponse{
+		Success: true,
+		Stats:   userStats,
+	}
+	return response, nil
+}
+
+func (a *AdminListener) AdminDeleteUser(ctx context.Context, request *skp.AdminDeleteUserRequest) (*skp.AdminDeleteUserResponse, error) {
+	admin, err := a.verifyAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	user, err := a.dbmgr.ReadUserFromUsername(ctx, request.UserName)
+	if err != nil {
+		return nil, fmt.Errorf("could not find user with username: %s, error: %w", request.UserName, err)
+	}
+	if user.Id == admin.Id {
+		return nil, apierror.FailedPrecondition("ADMIN_SELF", request.UserName, "admins can not delete themselves with AdminDeleteUser, use DeleteUser")
+	}
+	// an organization can not be left without an admin
+	if user.OrgRole == skp.OrganizationRole_ORGANIZATION_ADMIN {
+		if err := verifyNotLastOrganizationAdmin(ctx, a.dbmgr, user.OrgId); err != nil {
+			return nil, err
+		}
+	}
+	// deletes the user's input images and golf keypoints as well
+	if err := a.dbmgr.DeleteUser(ctx, user.OrgId, user.Id.Hex()); err != nil {
+		return nil, fmt.Errorf("could not delete user %s: %w", request.UserName, err)
+	}
+	logger.InfoContext(ctx, "deleted user", "username", request.UserName)
+	// return response
+	response := &skp.AdminDeleteUserResponse{
+		Success: true,
+	}
+	return response, nil
+}
+
+func (a *AdminListener) RunMaintenanceJob(ctx context.Context, request *skp.RunMaintenanceJobRequest) (*skp.RunMaintenanceJobResponse, error) {
+	if _, err := a.verifyAdmin(ctx); err != nil {
+		return nil, err
+	}
+	var scanned, changed int64
+	var err error
+	switch request.Job {
+	case skp.MaintenanceJob_ORPHAN_CLEANUP:
+		scanned, changed, err = a.dbmgr.CleanupOrphans(ctx, request.DryRun)
+	case skp.MaintenanceJob_RECOMPUTE_GOLF_SETUP_POINTS:
+		scanned, changed, err = a.recomputeGolfSetupPoints(ctx, request.DryRun)
+	default:
+		return nil, apierror.InvalidArgument("job", "unknown maintenance job %s", request.Job.String())
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not run maintenance job %s: %w", request.Job.String(), err)
+	}
+	logger.InfoContext(ctx, "ran maintenance job", "job", request.Job.String(), "dry_run", request.DryRun, "scanned", scanned, "changed", changed)
+	// return response
+	response := &skp.RunMaintenanceJobResponse{
+		Success: true,
+		Scanned: scanned,
+		Changed: changed,
+	}
+	return response, nil
+}
+
+// Recalculates the setup points of every golf keypoints from its body keypoints and its input image's calibration,
+// only the ones that changed are updated
+func (a *AdminListener) recomputeGolfSetupPoints(ctx context.Context, dryRun bool) (int64, int64, error) {
+	var scanned, changed int64
+	err := a.dbmgr.ForEachGolfKeypoints(ctx, func(golfKeypoints *db.GolfKeypoints, inputImage *db.InputImage) error {
+		scanned++
+		if inputImage == nil {
+			// orphans are left for ORPHAN_CLEANUP
+			logger.WarnContext(ctx, "no input image for golf keypoints", "golf_keypoints_id", golfKeypoints.Id.Hex(), "input_image_id", golfKeypoints.InputImageId)
+			return nil
+		}
+		dtl := &golfKeypoints.DtlGolfSetupPoints
+		faceon := &golfKeypoints.FaceonGolfSetupPoints
+		if inputImage.ImageType == skp.ImageType_DTL {
+			dtl = CalculateDTLSetupPoints(ctx, &golfKeypoints.OutputKeypoints, &inputImage.CalibrationInfo)
+		} else {
+			faceon = CalculateFaceOnSetupPoints(ctx, &golfKeypoints.OutputKeypoints, &inputImage.CalibrationInfo)
+		}
+		if proto.Equal(dtl, &golfKeypoints.DtlGolfSetupPoints) && proto.Equal(faceon, &golfKeypoints.FaceonGolfSetupPoints) {
+			return nil
+		}
+		changed++
+		if dryRun {
+			return nil
+		}
+		return a.dbmgr.UpdateGolfSetupPoints(ctx, golfKeypoints.Id.Hex(), dtl, faceon)
+	})
+	if err != nil {
+		return 0, 0, fmt.Errorf("could not recompute golf setup points: %w", err)
+	}
+	return scanned, changed, nil
+}
+
+func (a *AdminListener) verifyAdmin(ctx context.Context) (*db.User, error) {
+	userId, ok := ctx.Value(util.UserIdKey).(string)
+	if !ok {
+		return nil, apierror.Unauthenticated("invalid user id")
+	}
+	admin, err := verifyAdmin(ctx, a.dbmgr, userId, a.adminUserIds)
+	if err != nil {
+		return nil, fmt.Errorf("could not verify user is an admin: %w", err)
+	}
+	return admin, nil
+}
+
+func convertUserToAdminUser(user *db.User) *skp.AdminUser {
+	return &skp.AdminUser{
+		UserId:                user.Id.Hex(),
+		UserName:              user.Username,
+		Email:                 user.Email,
+		OrganizationId:        user.OrgId,
+		OrganizationRole:      user.OrgRole,
+		Role:                  user.Role,
+		Disabled:              user.Disabled,
+		PasswordResetRequired: user.PasswordResetRequired,
+		TotpEnabled:           user.Totp.Enabled,
+	}
+}
@@ -0,0 +1,186 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime/debug"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/sirfrank96/go-server/apierror"
+	"github.com/sirfrank96/go-server/config"
+	db "github.com/sirfrank96/go-server/db"
+	"github.com/sirfrank96/go-server/logging"
+	"github.com/sirfrank96/go-server/metrics"
+	skp "github.com/sirfrank96/go-server/sports-keypoints-proto"
+)
+
+// Time a claimed job stays leased after its timeout, so a slow update of a finished job does not let another server
+// claim it again
+const analysisJobLeaseMargin = 30 * time.Second
+
+// The analysis job queue in the database, DbManager in the server
+type analysisJobStore interface {
+	ClaimAnalysisJob(ctx context.Context, leaseExpiresAt time.Time, maxAttempts int32) (*db.AnalysisJob, error)
+	FailAbandonedAnalysisJobs(ctx context.Context, maxAttempts int32, expiresAt time.Time) (int64, error)
+	RequeueAnalysisJob(ctx context.Context, job *db.AnalysisJob, runAfter time.Time, errorCode int32, errorMessage string) error
+	FinishAnalysisJob(ctx context.Context, job *db.AnalysisJob, state skp.AnalysisJobState, errorCode int32, errorMessage string, expiresAt time.Time) error
+}
+
+// Runs queued analysis jobs from the database. Every go-server runs its own workers, a job is claimed by one of them at
+// a time and claimed again by any server once its lease expires (eg. the server that ran it was killed).
+type analysisWorkers struct {
+	config config.AnalysisJobsConfig
+	store  analysisJobStore
+	// runs the analysis of a claimed job
+	analyze func(ctx context.Context, job *db.AnalysisJob) error
+	// an idle worker is woken up when a job is submitted to this server instead of waiting for the next poll
+	wake    chan struct{}
+	cancel  context.CancelFunc
+	wg      sync.WaitGroup
+	mutex   sync.Mutex
+	running int
+}
+
+func newAnalysisWorkers(analysisJobsConfig config.AnalysisJobsConfig, store analysisJobStore, analyze func(ctx context.Context, job *db.AnalysisJob) error) *analysisWorkers {
+	return &analysisWorkers{
+		config:  analysisJobsConfig,
+		store:   store,
+		analyze: analyze,
+		wake:    make(chan struct{}, 1),
+	}
+}
+
+func (a *analysisWorkers) start() {
+	ctx, cancel := context.WithCancel(logging.WithAttrs(context.Background(), "component", "analysis_worker"))
+	a.cancel = cancel
+	for range a.config.Workers {
+		a.wg.Go(func() { a.work(ctx) })
+	}
+	logger.Info("starting analysis workers", "workers", a.config.Workers)
+}
+
+// Running jobs are cancelled and queued again so another server (or this one after a restart) runs them
+func (a *analysisWorkers) stop() {
+	if a.cancel == nil {
+		return
+	}
+	a.cancel()
+	a.wg.Wait()
+}
+
+func (a *analysisWorkers) notify() {
+	select {
+	case a.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (a *analysisWorkers) work(ctx context.Context) {
+	for {
+		// jobs abandoned too often are not claimed again, they would take down every server that runs them
+		failed, err := a.store.FailAbandonedAnalysisJobs(ctx, int32(a.config.MaxAttempts), time.Now().Add(a.config.Retention))
+		if err != nil && ctx.Err() == nil {
+			logger.WarnContext(ctx, "could not fail abandoned analysis jobs", "error", err)
+		}
+		if failed > 0 {
+			logger.WarnContext(ctx, "failed abandoned analysis jobs", "jobs", failed, "max_attempts", a.config.MaxAttempts)
+		}
+		job, err := a.store.ClaimAnalysisJob(ctx, time.Now().Add(a.config.Timeout+analysisJobLeaseMargin), int32(a.config.MaxAttempts))
+		if err != nil && ctx.Err() == nil {
+			logger.WarnContext(ctx, "could not claim analysis job", "error", err)
+		}
+		if job != nil {
+			a.run(ctx, job)
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-a.wake:
+		case <-time.After(a.config.PollInterval):
+		}
+	}
+}
+
+func (a *analysisWorkers) run(ctx context.Context, job *db.AnalysisJob) {
+	start := time.Now()
+	a.setRunning(1)
+	defer a.setRunning(-1)
+	ctx = logging.WithAttrs(ctx, "job_id", job.Id.Hex(), "user_id", job.UserId, "input_image_id", job.InputImageId, "attempt", job.Attempts)
+	logger.InfoContext(ctx, "running analysis job")
+	err := a.analyzeJob(ctx, job)
+	// the job is updated even if the server is stopping, otherwise it stays running until its lease expires
+	updateCtx := context.WithoutCancel(ctx)
+	var result string
+	var updateErr error
+	switch {
+	case err == nil:
+		result = "done"
+		updateErr = a.store.FinishAnalysisJob(updateCtx, job, skp.AnalysisJobState_ANALYSIS_JOB_DONE, 0, "", time.Now().Add(a.config.Retention))
+	case ctx.Err() != nil:
+		// the server is stopping, queued again right away even if it was the last attempt
+		result = "requeued"
+		updateErr = a.requeue(updateCtx, job, time.Now(), err)
+	case isTransientJobError(err) && job.Attempts < int32(a.config.MaxAttempts):
+		result = "requeued"
+		updateErr = a.requeue(updateCtx, job, time.Now().Add(time.Duration(job.Attempts)*a.config.PollInterval), err)
+	default:
+		result = "failed"
+		updateErr = a.store.FinishAnalysisJob(updateCtx, job, skp.AnalysisJobState_ANALYSIS_JOB_FAILED, int32(jobErrorCode(err)), err.Error(), time.Now().Add(a.config.Retention))
+	}
+	metrics.ObserveAnalysisJobAttempt(result, start)
+	if updateErr != nil {
+		logger.WarnContext(ctx, "could not update analysis job", "result", result, "error", updateErr)
+		return
+	}
+	if err != nil {
+		logger.WarnContext(ctx, "analysis job attempt failed", "result", result, "duration", time.Since(start), "error", err)
+		return
+	}
+	logger.InfoContext(ctx, "analysis job done", "duration", time.Since(start))
+}
+
+// Runs the analysis with the job timeout. A panic fails the job with Internal instead of taking down the server, the
+// rpc recovery interceptor does not cover workers.
+func (a *analysisWorkers) analyzeJob(ctx context.Context, job *db.AnalysisJob) (err error) {
+	ctx, cancel := context.WithTimeout(ctx, a.config.Timeout)
+	defer cancel()
+	defer func() {
+		if r := recover(); r != nil {
+			logger.ErrorContext(ctx, "analysis job panicked", "panic", r, "stack", string(debug.Stack()))
+			err = apierror.Internal(fmt.Errorf("panic: %v", r), "analysis job failed")
+		}
+	}()
+	return a.analyze(ctx, job)
+}
+
+func (a *analysisWorkers) requeue(ctx context.Context, job *db.AnalysisJob, runAfter time.Time, err error) error {
+	return a.store.RequeueAnalysisJob(ctx, job, runAfter, int32(jobErrorCode(err)), err.Error())
+}
+
+func (a *analysisWorkers) setRunning(delta int) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	a.running += delta
+	metrics.SetAnalysisJobsRunning(a.running)
+}
+
+func jobErrorCode(err error) codes.Code {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return codes.DeadlineExceeded
+	}
+	return apierror.Code(err)
+}
+
+// Errors where running the job again later can succeed, eg. computervision or mongodb were unavailable
+func isTransientJobError(err error) bool {
+	switch jobErrorCode(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted, codes.Aborted:
+		return true
+	}
+	return false
+}
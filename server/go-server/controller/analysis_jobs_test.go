@@ -0,0 +1,271 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc/codes"
+
+	"github.com/sirfrank96/go-server/apierror"
+	"github.com/sirfrank96/go-server/config"
+	db "github.com/sirfrank96/go-server/db"
+	skp "github.com/sirfrank96/go-server/sports-keypoints-proto"
+)
+
+func TestIsTransientJobError(t *testing.T) {
+	transient := []error{
+		apierror.Unavailable(errors.New("connection refused"), "computervision is unavailable"),
+		fmt.Errorf("could not get pose all for image: %w", context.DeadlineExceeded),
+		apierror.Aborted("retry later"),
+	}
+	for _, err := range transient {
+		if !isTransientJobError(err) {
+			t.Errorf("%v is supposed to be transient", err)
+		}
+	}
+	permanent := []error{
+		apierror.NotFound("no input images with id: image1"),
+		apierror.InvalidArgument("image", "not an image"),
+		errors.New("could not store golfkeypoints in db"),
+	}
+	for _, err := range permanent {
+		if isTransientJobError(err) {
+			t.Errorf("%v is not supposed to be transient", err)
+		}
+	}
+}
+
+// In memory analysis job queue with the claim and update rules of DbManager
+type fakeAnalysisJobStore struct {
+	mutex sync.Mutex
+	jobs  []*db.AnalysisJob
+}
+
+func (f *fakeAnalysisJobStore) add(job *db.AnalysisJob) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	job.Id = primitive.NewObjectID()
+	job.CreatedAt = time.Now()
+	if job.State == skp.AnalysisJobState_ANALYSIS_JOB_STATE_UNSPECIFIED {
+		job.State = skp.AnalysisJobState_ANALYSIS_JOB_QUEUED
+	}
+	f.jobs = append(f.jobs, job)
+}
+
+func (f *fakeAnalysisJobStore) get(inputImageId string) db.AnalysisJob {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	for _, job := range f.jobs {
+		if job.InputImageId == inputImageId {
+			return *job
+		}
+	}
+	return db.AnalysisJob{}
+}
+
+func (f *fakeAnalysisJobStore) ClaimAnalysisJob(ctx context.Context, leaseExpiresAt time.Time, maxAttempts int32) (*db.AnalysisJob, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	now := time.Now()
+	for _, job := range f.jobs {
+		queued := job.State == skp.AnalysisJobState_ANALYSIS_JOB_QUEUED && !job.RunAfter.After(now)
+		expired := job.State == skp.AnalysisJobState_ANALYSIS_JOB_RUNNING && job.LeaseExpiresAt.Before(now) && job.Attempts < maxAttempts
+		if queued || expired {
+			job.State = skp.AnalysisJobState_ANALYSIS_JOB_RUNNING
+			job.LeaseExpiresAt = leaseExpiresAt
+			job.Attempts++
+			claimed := *job
+			return &claimed, nil
+		}
+	}
+	return nil, nil
+}
+
+func (f *fakeAnalysisJobStore) FailAbandonedAnalysisJobs(ctx context.Context, maxAttempts int32, expiresAt time.Time) (int64, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	var failed int64
+	for _, job := range f.jobs {
+		if job.State == skp.AnalysisJobState_ANALYSIS_JOB_RUNNING && job.LeaseExpiresAt.Before(time.Now()) && job.Attempts >= maxAttempts {
+			job.State = skp.AnalysisJobState_ANALYSIS_JOB_FAILED
+			job.ErrorCode = int32(codes.Internal)
+			job.ExpiresAt = expiresAt
+			failed++
+		}
+	}
+	return failed, nil
+}
+
+func (f *fakeAnalysisJobStore) RequeueAnalysisJob(ctx context.Context, job *db.AnalysisJob, runAfter time.Time, errorCode int32, errorMessage string) error {
+	return f.update(job, func(stored *db.AnalysisJob) {
+		stored.State = skp.AnalysisJobState_ANALYSIS_JOB_QUEUED
+		stored.RunAfter = runAfter
+		stored.ErrorCode = errorCode
+		stored.Error = errorMessage
+	})
+}
+
+func (f *fakeAnalysisJobStore) FinishAnalysisJob(ctx context.Context, job *db.AnalysisJob, state skp.AnalysisJobState, errorCode int32, errorMessage string, expiresAt time.Time) error {
+	return f.update(job, func(stored *db.AnalysisJob) {
+		stored.State = state
+		stored.ErrorCode = errorCode
+		stored.Error = errorMessage
+		stored.ExpiresAt = expiresAt
+	})
+}
+
+// Only the attempt that claimed the job last can update it
+func (f *fakeAnalysisJobStore) update(job *db.AnalysisJob, update func(stored *db.AnalysisJob)) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	for _, stored := range f.jobs {
+		if stored.Id == job.Id && stored.Attempts == job.Attempts && stored.State == skp.AnalysisJobState_ANALYSIS_JOB_RUNNING {
+			update(stored)
+			stored.LeaseExpiresAt = time.Time{}
+			return nil
+		}
+	}
+	return apierror.NotFound("analysis job %s is no longer claimed by this attempt", job.Id.Hex())
+}
+
+func newTestAnalysisJobsConfig() config.AnalysisJobsConfig {
+	return config.AnalysisJobsConfig{
+		Workers:      2,
+		PollInterval: 5 * time.Millisecond,
+		Timeout:      time.Second,
+		MaxAttempts:  3,
+		Retention:    time.Hour,
+	}
+}
+
+// Waits until none of the jobs is queued or running
+func waitForAnalysisJobs(t *testing.T, store *fakeAnalysisJobStore, inputImageIds ...string) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for _, inputImageId := range inputImageIds {
+		for {
+			state := store.get(inputImageId).State
+			if state == skp.AnalysisJobState_ANALYSIS_JOB_DONE || state == skp.AnalysisJobState_ANALYSIS_JOB_FAILED {
+				break
+			}
+			if time.Now().After(deadline) {
+				t.Fatalf("analysis job for %s is still %s", inputImageId, state.String())
+			}
+			time.Sleep(5 * time.Millisecond)
+		}
+	}
+}
+
+func TestAnalysisWorkersRunJobs(t *testing.T) {
+	store := &fakeAnalysisJobStore{}
+	var mutex sync.Mutex
+	calls := map[string]int{}
+	analyze := func(ctx context.Context, job *db.AnalysisJob) error {
+		mutex.Lock()
+		calls[job.InputImageId]++
+		call := calls[job.InputImageId]
+		mutex.Unlock()
+		switch job.InputImageId {
+		case "flaky":
+			if call == 1 {
+				return apierror.Unavailable(errors.New("connection refused"), "computervision is unavailable")
+			}
+		case "unavailable":
+			return apierror.Unavailable(errors.New("connection refused"), "computervision is unavailable")
+		case "invalid":
+			return apierror.InvalidArgument("image", "not an image")
+		case "panic":
+			var keypoints *db.GolfKeypoints
+			_ = keypoints.InputImageId
+		}
+		return nil
+	}
+	inputImageIds := []string{"ok", "flaky", "unavailable", "invalid", "panic"}
+	for _, inputImageId := range inputImageIds {
+		store.add(&db.AnalysisJob{InputImageId: inputImageId})
+	}
+	workers := newAnalysisWorkers(newTestAnalysisJobsConfig(), store, analyze)
+	workers.start()
+	defer workers.stop()
+	waitForAnalysisJobs(t, store, inputImageIds...)
+	expected := map[string]struct {
+		state    skp.AnalysisJobState
+		code     codes.Code
+		attempts int32
+	}{
+		"ok":          {skp.AnalysisJobState_ANALYSIS_JOB_DONE, codes.OK, 1},
+		"flaky":       {skp.AnalysisJobState_ANALYSIS_JOB_DONE, codes.OK, 2},
+		"unavailable": {skp.AnalysisJobState_ANALYSIS_JOB_FAILED, codes.Unavailable, 3},
+		"invalid":     {skp.AnalysisJobState_ANALYSIS_JOB_FAILED, codes.InvalidArgument, 1},
+		"panic":       {skp.AnalysisJobState_ANALYSIS_JOB_FAILED, codes.Internal, 1},
+	}
+	for inputImageId, want := range expected {
+		job := store.get(inputImageId)
+		if job.State != want.state || codes.Code(job.ErrorCode) != want.code || job.Attempts != want.attempts {
+			t.Errorf("job %s is %s with code %s after %d attempts, expected %s with code %s after %d attempts", inputImageId, job.State.String(), codes.Code(job.ErrorCode), job.Attempts, want.state.String(), want.code, want.attempts)
+		}
+	}
+}
+
+func TestAnalysisWorkersStopRequeuesRunningJob(t *testing.T) {
+	store := &fakeAnalysisJobStore{}
+	started := make(chan struct{}, 1)
+	analyze := func(ctx context.Context, job *db.AnalysisJob) error {
+		started <- struct{}{}
+		<-ctx.Done()
+		return ctx.Err()
+	}
+	store.add(&db.AnalysisJob{InputImageId: "slow"})
+	workers := newAnalysisWorkers(newTestAnalysisJobsConfig(), store, analyze)
+	workers.start()
+	<-started
+	workers.stop()
+	job := store.get("slow")
+	if job.State != skp.AnalysisJobState_ANALYSIS_JOB_QUEUED || job.Attempts != 1 || !job.LeaseExpiresAt.IsZero() {
+		t.Errorf("job of a stopped server is %s after %d attempts with lease %v, expected queued without lease", job.State.String(), job.Attempts, job.LeaseExpiresAt)
+	}
+}
+
+// A server that was killed leaves its job running, the job is claimed again once its lease expires unless it was
+// abandoned max_attempts times
+func TestAnalysisWorkersReclaimExpiredLeases(t *testing.T) {
+	store := &fakeAnalysisJobStore{}
+	running := skp.AnalysisJobState_ANALYSIS_JOB_RUNNING
+	store.add(&db.AnalysisJob{InputImageId: "abandoned", State: running, Attempts: 1, LeaseExpiresAt: time.Now().Add(-time.Second)})
+	store.add(&db.AnalysisJob{InputImageId: "poison", State: running, Attempts: 3, LeaseExpiresAt: time.Now().Add(-time.Second)})
+	store.add(&db.AnalysisJob{InputImageId: "leased", State: running, Attempts: 1, LeaseExpiresAt: time.Now().Add(time.Hour)})
+	var mutex sync.Mutex
+	var analyzed []string
+	analyze := func(ctx context.Context, job *db.AnalysisJob) error {
+		mutex.Lock()
+		defer mutex.Unlock()
+		analyzed = append(analyzed, job.InputImageId)
+		return nil
+	}
+	workers := newAnalysisWorkers(newTestAnalysisJobsConfig(), store, analyze)
+	workers.start()
+	waitForAnalysisJobs(t, store, "abandoned", "poison")
+	workers.stop()
+	if job := store.get("abandoned"); job.State != skp.AnalysisJobState_ANALYSIS_JOB_DONE || job.Attempts != 2 {
+		t.Errorf("abandoned job is %s after %d attempts, expected done after 2", job.State.String(), job.Attempts)
+	}
+	if job := store.get("poison"); job.State != skp.AnalysisJobState_ANALYSIS_JOB_FAILED || codes.Code(job.ErrorCode) != codes.Internal || job.Attempts != 3 {
+		t.Errorf("job abandoned 3 times is %s with code %s after %d attempts, expected failed with Internal", job.State.String(), codes.Code(job.ErrorCode), job.Attempts)
+	}
+	if job := store.get("leased"); job.State != running || job.Attempts != 1 {
+		t.Errorf("job with an unexpired lease is %s after %d attempts, expected it to stay running", job.State.String(), job.Attempts)
+	}
+	mutex.Lock()
+	defer mutex.Unlock()
+	if len(analyzed) != 1 || analyzed[0] != "abandoned" {
+		t.Errorf("analyzed %v, expected only the abandoned job", analyzed)
+	}
+}
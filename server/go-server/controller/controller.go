@@ -2,28 +2,57 @@ package controller
 
 import (
 	"context"
-	"log"
 
+	"github.com/sirfrank96/go-server/config"
 	cvclient "github.com/sirfrank96/go-server/cv-client"
 	db "github.com/sirfrank96/go-server/db"
 	kpserver "github.com/sirfrank96/go-server/keypoints-server"
+	"github.com/sirfrank96/go-server/logging"
+	"github.com/sirfrank96/go-server/metrics"
+	"github.com/sirfrank96/go-server/oidc"
+	"github.com/sirfrank96/go-server/tracing"
+	"github.com/sirfrank96/go-server/util"
 )
 
+var logger = logging.Logger("controller")
+
 type Controller struct {
-	cvmgr *cvclient.CvClientManager
-	dbmgr *db.DbManager
-	kpmgr *kpserver.KeypointsServerManager
+	cvmgr      *cvclient.CvClientManager
+	dbmgr      *db.DbManager
+	kpmgr      *kpserver.KeypointsServerManager
+	oidcmgr    *oidc.OidcManager
+	metricsmgr *metrics.MetricsManager
+	tracingmgr *tracing.TracingManager
+	// run SubmitAnalysis jobs
+	analysisWorkers *analysisWorkers
 }
 
-func NewController() *Controller {
+// Each manager gets its own section of the config, settings used by the util helpers are applied here
+func NewController(cfg *config.Config) *Controller {
+	util.ConfigureKeypointVerification(cfg.Keypoints)
+	util.ConfigureAuth(cfg.Auth)
 	p := &Controller{}
-	p.cvmgr = cvclient.NewCvClientManager()
-	p.dbmgr = db.NewDbManager()
-	p.kpmgr = kpserver.NewKeypointsServerManager(newGolfKeypointsListener(p.cvmgr, p.dbmgr), newUserListener(p.cvmgr, p.dbmgr))
-	log.Printf("New Controller")
+	p.cvmgr = cvclient.NewCvClientManager(cfg.ComputerVision)
+	p.dbmgr = db.NewDbManager(cfg.Database)
+	p.oidcmgr = oidc.NewOidcManager(cfg.Oidc)
+	p.metricsmgr = metrics.NewMetricsManager(cfg.Metrics)
+	p.tracingmgr = tracing.NewTracingManager(cfg.Tracing)
+	golfKeypointsListener := newGolfKeypointsListener(p.cvmgr.PoseEstimator(), p.dbmgr, cfg.AnalysisJobs)
+	p.analysisWorkers = golfKeypointsListener.analysisWorkers
+	p.kpmgr = kpserver.NewKeypointsServerManager(cfg.KeypointsServer, golfKeypointsListener, newUserListener(p.cvmgr, p.dbmgr, p.oidcmgr), newOrganizationListener(p.dbmgr), newAdminListener(p.dbmgr, cfg.Auth.AdminUserIds))
+	p.kpmgr.AddHealthProbe("mongodb", p.dbmgr.PingMongoDB)
+	p.kpmgr.AddHealthProbe("computervision", p.cvmgr.PingCvClient)
+	p.kpmgr.AddHealthMonitor("computervision_circuit_breaker", p.cvmgr.CheckCircuitBreaker)
+	p.kpmgr.SetIdempotencyStore(p.dbmgr)
+	p.cvmgr.SetPoseResultStore(p.dbmgr)
+	logger.Info("new controller")
 	return p
 }
 
+func (c *Controller) StartTracing(ctx context.Context) error {
+	return c.tracingmgr.StartTracing(ctx)
+}
+
 func (c *Controller) StartCvClient() error {
 	return c.cvmgr.StartCvClient()
 }
@@ -32,10 +61,27 @@ func (c *Controller) StartDatabaseClient(ctx context.Context) error {
 	return c.dbmgr.StartMongoDBClient(ctx)
 }
 
+func (c *Controller) StartOidcManager() error {
+	return c.oidcmgr.StartOidcManager()
+}
+
+func (c *Controller) StartMetricsServer() error {
+	return c.metricsmgr.StartMetricsServer()
+}
+
 func (c *Controller) StartKeypointsServer() error {
 	return c.kpmgr.StartKeypointsServer()
 }
 
+// Needs the database and cv clients, jobs queued while no server was running are picked up right away
+func (c *Controller) StartAnalysisWorkers() {
+	c.analysisWorkers.start()
+}
+
+func (c *Controller) StopAnalysisWorkers() {
+	c.analysisWorkers.stop()
+}
+
 func (c *Controller) CloseCvClient() error {
 	return c.cvmgr.CloseCvClient()
 }
@@ -47,3 +93,11 @@ func (c *Controller) CloseDatabaseClient(ctx context.Context) error {
 func (c *Controller) StopKeypointsServer() error {
 	return c.kpmgr.StopKeypointsServer()
 }
+
+func (c *Controller) StopMetricsServer(ctx context.Context) error {
+	return c.metricsmgr.StopMetricsServer(ctx)
+}
+
+func (c *Controller) StopTracing(ctx context.Context) error {
+	return c.tracingmgr.StopTracing(ctx)
+}
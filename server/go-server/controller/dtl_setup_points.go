@@ -30,98 +30,73 @@ func VerifyDTLCalibrationImages(axesKeypoints *skp.Body25PoseKeypoints, vanishin
 }
 
 func CalculateDTLSetupPoints(ctx context.Context, keypoints *skp.Body25PoseKeypoints, calibrationInfo *util.CalibrationInfo) *skp.DTLGolfSetupPoints {
-	fmt.Printf("Calculating Dtl setup points. Keypoints: %+v\n CalibrationInfo: %+v\n", keypoints, calibrationInfo)
-	spineAngle, warning := GetSpineAngle(keypoints, calibrationInfo)
-	var spineAngleWarning string
-	if warning != nil {
-		spineAngleWarning = warning.Error()
-	}
-	fmt.Printf("Spine angle is %f\n", spineAngle)
-	feetAlignment, warning := GetFeetAlignment(keypoints, calibrationInfo)
-	var feetAlignmentWarning string
-	if warning != nil {
-		feetAlignmentWarning = warning.Error()
-	}
-	fmt.Printf("Feet alignment is %f\n", feetAlignment)
-	heelAlignment, warning := GetHeelAlignment(keypoints, calibrationInfo)
-	var heelAlignmentWarning string
-	if warning != nil {
-		heelAlignmentWarning = warning.Error()
-	}
-	fmt.Printf("Heel alignment is %f\n", heelAlignment)
-	toeAlignment, warning := GetToeAlignment(keypoints, calibrationInfo)
-	var toeAlignmentWarning string
-	if warning != nil {
-		toeAlignmentWarning = warning.Error()
-	}
-	fmt.Printf("Toe alignment is %f\n", toeAlignment)
-	shoulderAlignment, warning := GetShoulderAlignment(keypoints, calibrationInfo)
-	var shoulderAlignmentWarning string
-	if warning != nil {
-		shoulderAlignmentWarning = warning.Error()
-	}
-	fmt.Printf("Shoulder alignment is %f\n", shoulderAlignment)
-	waistAlignment, warning := GetWaistAlignment(keypoints, calibrationInfo)
-	var waistAlignmentWarning string
-	if warning != nil {
-		waistAlignmentWarning = warning.Error()
-	}
-	fmt.Printf("Waist alignment is %f\n", waistAlignment)
-	kneeBend, warning := GetKneeBend(keypoints)
-	var kneeBendWarning string
-	if warning != nil {
-		kneeBendWarning = warning.Error()
-	}
-	fmt.Printf("Knee bend is %f\n", kneeBend)
-	distanceFromBall, warning := GetDistanceFromBall(keypoints, calibrationInfo)
-	var distanceFromBallWarning string
-	if warning != nil {
-		distanceFromBallWarning = warning.Error()
-	}
-	fmt.Printf("Distance from ball is %f\n", distanceFromBall)
-	ulnarDeviation, warning := GetUlnarDeviation(keypoints, calibrationInfo)
-	var ulnarDeviationWarning string
-	if warning != nil {
-		ulnarDeviationWarning = warning.Error()
-	}
-	fmt.Printf("Ulnar deviation is %f\n", ulnarDeviation)
+	logger.DebugContext(ctx, "calculating dtl setup points", "calibration_type", calibrationInfo.CalibrationType.String())
+	spineAngle, spineAngleWarning := GetSpineAngle(keypoints, calibrationInfo)
+	logger.DebugContext(ctx, "calculated spine angle", "value", spineAngle)
+	feetAlignment, feetAlignmentWarning := GetFeetAlignment(keypoints, calibrationInfo)
+	logger.DebugContext(ctx, "calculated feet alignment", "value", feetAlignment)
+	heelAlignment, heelAlignmentWarning := GetHeelAlignment(keypoints, calibrationInfo)
+	logger.DebugContext(ctx, "calculated heel alignment", "value", heelAlignment)
+	toeAlignment, toeAlignmentWarning := GetToeAlignment(keypoints, calibrationInfo)
+	logger.DebugContext(ctx, "calculated toe alignment", "value", toeAlignment)
+	shoulderAlignment, shoulderAlignmentWarning := GetShoulderAlignment(keypoints, calibrationInfo)
+	logger.DebugContext(ctx, "calculated shoulder alignment", "value", shoulderAlignment)
+	waistAlignment, waistAlignmentWarning := GetWaistAlignment(keypoints, calibrationInfo)
+	logger.DebugContext(ctx, "calculated waist alignment", "value", waistAlignment)
+	kneeBend, kneeBendWarning := GetKneeBend(keypoints)
+	logger.DebugContext(ctx, "calculated knee bend", "value", kneeBend)
+	distanceFromBall, distanceFromBallWarning := GetDistanceFromBall(keypoints, calibrationInfo)
+	logger.DebugContext(ctx, "calculated distance from ball", "value", distanceFromBall)
+	ulnarDeviation, ulnarDeviationWarning := GetUlnarDeviation(keypoints, calibrationInfo)
+	logger.DebugContext(ctx, "calculated ulnar deviation", "value", ulnarDeviation)
 
+	recordSetupPointWarnings(map[string]util.Warning{
+		"spine_angle":        spineAngleWarning,
+		"feet_alignment":     feetAlignmentWarning,
+		"heel_alignment":     heelAlignmentWarning,
+		"toe_alignment":      toeAlignmentWarning,
+		"shoulder_alignment": shoulderAlignmentWarning,
+		"waist_alignment":    waistAlignmentWarning,
+		"knee_bend":          kneeBendWarning,
+		"distance_from_ball": distanceFromBallWarning,
+		"ulnar_deviation":    ulnarDeviationWarning,
+	})
 	dtlGolfSetupPoints := &skp.DTLGolfSetupPoints{
 		SpineAngle: &skp.Double{
 			Data:    spineAngle,
-			Warning: spineAngleWarning,
+			Warning: warningMessage(spineAngleWarning),
 		},
 		FeetAlignment: &skp.Double{
 			Data:    feetAlignment,
-			Warning: feetAlignmentWarning,
+			Warning: warningMessage(feetAlignmentWarning),
 		},
 		HeelAlignment: &skp.Double{
 			Data:    heelAlignment,
-			Warning: heelAlignmentWarning,
+			Warning: warningMessage(heelAlignmentWarning),
 		},
 		ToeAlignment: &skp.Double{
 			Data:    toeAlignment,
-			Warning: toeAlignmentWarning,
+			Warning: warningMessage(toeAlignmentWarning),
 		},
 		KneeBend: &skp.Double{
 			Data:    kneeBend,
-			Warning: kneeBendWarning,
+			Warning: warningMessage(kneeBendWarning),
 		},
 		ShoulderAlignment: &skp.Double{
 			Data:    shoulderAlignment,
-			Warning: shoulderAlignmentWarning,
+			Warning: warningMessage(shoulderAlignmentWarning),
 		},
 		WaistAlignment: &skp.Double{
 			Data:    waistAlignment,
-			Warning: waistAlignmentWarning,
+			Warning: warningMessage(waistAlignmentWarning),
 		},
 		DistanceFromBall: &skp.Double{
 			Data:    distanceFromBall,
-			Warning: distanceFromBallWarning,
+			Warning: warningMessage(distanceFromBallWarning),
 		},
 		UlnarDeviation: &skp.Double{
 			Data:    ulnarDeviation,
-			Warning: ulnarDeviationWarning,
+			Warning: warningMessage(ulnarDeviationWarning),
 		},
 	}
 	return dtlGolfSetupPoints
@@ -138,13 +113,13 @@ func GetSpineAngle(keypoints *skp.Body25PoseKeypoints, calibrationInfo *util.Cal
 		}
 	}
 	var warning util.Warning
-	if w := util.VerifyKeypoint(keypoints.Midhip, "midhip", 0.5); w != nil {
+	if w := util.VerifyKeypoint(keypoints.Midhip, "midhip", util.MinKeypointConfidence()); w != nil {
 		if w.GetSeverity() == util.SEVERE {
 			return 0, w
 		}
 		warning = util.AppendMinorWarnings(warning, w)
 	}
-	if w := util.VerifyKeypoint(keypoints.Neck, "neck", 0.5); w != nil {
+	if w := util.VerifyKeypoint(keypoints.Neck, "neck", util.MinKeypointConfidence()); w != nil {
 		if w.GetSeverity() == util.SEVERE {
 			return 0, w
 		}
@@ -261,13 +236,13 @@ func GetShoulderAlignment(keypoints *skp.Body25PoseKeypoints, calibrationInfo *u
 		return 0, w
 	}
 	var warning util.Warning
-	if w := util.VerifyKeypoint(keypoints.LShoulder, "left shoulder", 0.5); w != nil {
+	if w := util.VerifyKeypoint(keypoints.LShoulder, "left shoulder", util.MinKeypointConfidence()); w != nil {
 		if w.GetSeverity() == util.SEVERE {
 			return 0, w
 		}
 		warning = util.AppendMinorWarnings(warning, w)
 	}
-	if w := util.VerifyKeypoint(keypoints.RShoulder, "right shoulder", 0.5); w != nil {
+	if w := util.VerifyKeypoint(keypoints.RShoulder, "right shoulder", util.MinKeypointConfidence()); w != nil {
 		if w.GetSeverity() == util.SEVERE {
 			return 0, w
 		}
@@ -314,13 +289,13 @@ func GetWaistAlignment(keypoints *skp.Body25PoseKeypoints, calibrationInfo *util
 		}
 	}
 	var warning util.Warning
-	if w := util.VerifyKeypoint(keypoints.LHip, "left hip", 0.5); w != nil {
+	if w := util.VerifyKeypoint(keypoints.LHip, "left hip", util.MinKeypointConfidence()); w != nil {
 		if w.GetSeverity() == util.SEVERE {
 			return 0, w
 		}
 		warning = util.AppendMinorWarnings(warning, w)
 	}
-	if w := util.VerifyKeypoint(keypoints.RHip, "right hip", 0.5); w != nil {
+	if w := util.VerifyKeypoint(keypoints.RHip, "right hip", util.MinKeypointConfidence()); w != nil {
 		if w.GetSeverity() == util.SEVERE {
 			return 0, w
 		}
@@ -341,19 +316,19 @@ func GetWaistAlignment(keypoints *skp.Body25PoseKeypoints, calibrationInfo *util
 // 180 - angle between those lines (ie. angle away from straight legs)
 func GetKneeBend(keypoints *skp.Body25PoseKeypoints) (float64, util.Warning) {
 	var warning util.Warning
-	if w := util.VerifyKeypoint(keypoints.RHip, "right hip", 0.5); w != nil {
+	if w := util.VerifyKeypoint(keypoints.RHip, "right hip", util.MinKeypointConfidence()); w != nil {
 		if w.GetSeverity() == util.SEVERE {
 			return 0, w
 		}
 		warning = util.AppendMinorWarnings(warning, w)
 	}
-	if w := util.VerifyKeypoint(keypoints.RKnee, "right knee", 0.5); w != nil {
+	if w := util.VerifyKeypoint(keypoints.RKnee, "right knee", util.MinKeypointConfidence()); w != nil {
 		if w.GetSeverity() == util.SEVERE {
 			return 0, w
 		}
 		warning = util.AppendMinorWarnings(warning, w)
 	}
-	if w := util.VerifyKeypoint(keypoints.RAnkle, "right ankle", 0.5); w != nil {
+	if w := util.VerifyKeypoint(keypoints.RAnkle, "right ankle", util.MinKeypointConfidence()); w != nil {
 		if w.GetSeverity() == util.SEVERE {
 			return 0, w
 		}
@@ -375,19 +350,19 @@ func GetKneeBend(keypoints *skp.Body25PoseKeypoints) (float64, util.Warning) {
 // the larger the number the farther away from ball
 func GetDistanceFromBall(keypoints *skp.Body25PoseKeypoints, calibrationInfo *util.CalibrationInfo) (float64, util.Warning) {
 	var warning util.Warning
-	if w := util.VerifyKeypoint(keypoints.Midhip, "midhip", 0.5); w != nil {
+	if w := util.VerifyKeypoint(keypoints.Midhip, "midhip", util.MinKeypointConfidence()); w != nil {
 		if w.GetSeverity() == util.SEVERE {
 			return 0, w
 		}
 		warning = util.AppendMinorWarnings(warning, w)
 	}
-	if w := util.VerifyKeypoint(keypoints.Neck, "neck", 0.5); w != nil {
+	if w := util.VerifyKeypoint(keypoints.Neck, "neck", util.MinKeypointConfidence()); w != nil {
 		if w.GetSeverity() == util.SEVERE {
 			return 0, w
 		}
 		warning = util.AppendMinorWarnings(warning, w)
 	}
-	if w := util.VerifyKeypoint(&calibrationInfo.GolfBallPoint, "golf ball", 0.5); w != nil {
+	if w := util.VerifyKeypoint(&calibrationInfo.GolfBallPoint, "golf ball", util.MinKeypointConfidence()); w != nil {
 		if w.GetSeverity() == util.SEVERE {
 			return 0, w
 		}
@@ -418,19 +393,19 @@ func GetDistanceFromBall(keypoints *skp.Body25PoseKeypoints, calibrationInfo *ut
 // the larger the number the more ulnar deviation (ie. higher hands)
 func GetUlnarDeviation(keypoints *skp.Body25PoseKeypoints, calibrationInfo *util.CalibrationInfo) (float64, util.Warning) {
 	var warning util.Warning
-	if w := util.VerifyKeypoint(keypoints.RElbow, "right elbow", 0.5); w != nil {
+	if w := util.VerifyKeypoint(keypoints.RElbow, "right elbow", util.MinKeypointConfidence()); w != nil {
 		if w.GetSeverity() == util.SEVERE {
 			return 0, w
 		}
 		warning = util.AppendMinorWarnings(warning, w)
 	}
-	if w := util.VerifyKeypoint(keypoints.RWrist, "right wrist", 0.5); w != nil {
+	if w := util.VerifyKeypoint(keypoints.RWrist, "right wrist", util.MinKeypointConfidence()); w != nil {
 		if w.GetSeverity() == util.SEVERE {
 			return 0, w
 		}
 		warning = util.AppendMinorWarnings(warning, w)
 	}
-	if w := util.VerifyKeypoint(&calibrationInfo.ClubHeadPoint, "club head", 0.5); w != nil {
+	if w := util.VerifyKeypoint(&calibrationInfo.ClubHeadPoint, "club head", util.MinKeypointConfidence()); w != nil {
 		if w.GetSeverity() == util.SEVERE {
 			return 0, w
 		}
@@ -1,7 +1,9 @@
 package controller
 
 import (
+	"context"
 	"math"
+	"strings"
 	"testing"
 
 	skp "github.com/sirfrank96/go-server/sports-keypoints-proto"
@@ -608,3 +610,29 @@ func TestGetUlnarDeviation(t *testing.T) {
 		t.Errorf("GetUlnarDeviation(%+v, %+v) = %f; expected %f", keypoints, calibrationInfoDTL, lowActual, lowExpected)
 	}
 }
+
+func TestCalculateDTLSetupPointsConvertedSchemas(t *testing.T) {
+	// coco17 has no feet
+	keypoints, err := util.ConvertLandmarksToBody25(skp.KeypointSchema_KEYPOINT_SCHEMA_COCO17, newLandmarks(17))
+	if err != nil {
+		t.Fatalf("ConvertLandmarksToBody25 had an unexpected error: %s", err.Error())
+	}
+	setupPoints := CalculateDTLSetupPoints(context.Background(), keypoints, calibrationInfoDTL)
+	for name, point := range map[string]*skp.Double{
+		"heel alignment": setupPoints.HeelAlignment,
+		"toe alignment":  setupPoints.ToeAlignment,
+	} {
+		if !strings.Contains(point.GetWarning(), "could not find keypoint") {
+			t.Errorf("%s warning is %q, expected a missing foot keypoint", name, point.GetWarning())
+		}
+	}
+	// mediapipe33 has heels and big toes
+	keypoints, err = util.ConvertLandmarksToBody25(skp.KeypointSchema_KEYPOINT_SCHEMA_MEDIAPIPE33, newLandmarks(33))
+	if err != nil {
+		t.Fatalf("ConvertLandmarksToBody25 had an unexpected error: %s", err.Error())
+	}
+	setupPoints = CalculateDTLSetupPoints(context.Background(), keypoints, calibrationInfoDTL)
+	if strings.Contains(setupPoints.HeelAlignment.GetWarning(), "could not find") {
+		t.Errorf("heel alignment warning is %q, expected the heels to be found", setupPoints.HeelAlignment.GetWarning())
+	}
+}
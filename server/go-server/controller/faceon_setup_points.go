@@ -2,7 +2,7 @@ package controller
 
 import (
 	"context"
-	"fmt"
+
 	skp "github.com/sirfrank96/go-server/sports-keypoints-proto"
 	"github.com/sirfrank96/go-server/util"
 )
@@ -16,118 +16,87 @@ func VerifyFaceOnCalibrationImage(keypoints *skp.Body25PoseKeypoints, calibratio
 }
 
 func CalculateFaceOnSetupPoints(ctx context.Context, keypoints *skp.Body25PoseKeypoints, calibrationInfo *util.CalibrationInfo) *skp.FaceOnGolfSetupPoints {
-	fmt.Printf("Calculating Face on setup points. Keypoints: %#v\n CalibrationInfo: %#v\n", keypoints, calibrationInfo)
-	sideBend, warning := GetSideBend(keypoints, calibrationInfo)
-	var sideBendWarning string
-	if warning != nil {
-		sideBendWarning = warning.Error()
-	}
-	fmt.Printf("Side bend is %f\n", sideBend)
-	lFootFlare, warning := GetLeftFootFlare(keypoints, calibrationInfo)
-	var lFootFlareWarning string
-	if warning != nil {
-		lFootFlareWarning = warning.Error()
-	}
-	fmt.Printf("Left foot flare is %f\n", lFootFlare)
-	rFootFlare, warning := GetRightFootFlare(keypoints, calibrationInfo)
-	var rFootFlareWarning string
-	if warning != nil {
-		rFootFlareWarning = warning.Error()
-	}
-	fmt.Printf("Right foot flare is %f\n", rFootFlare)
-	stanceWidth, warning := GetStanceWidth(keypoints)
-	var stanceWidthWarning string
-	if warning != nil {
-		stanceWidthWarning = warning.Error()
-	}
-	fmt.Printf("Stance width is %f\n", stanceWidth)
-	shoulderTilt, warning := GetShoulderTilt(keypoints, calibrationInfo)
-	var shoulderTiltWarning string
-	if warning != nil {
-		shoulderTiltWarning = warning.Error()
-	}
-	fmt.Printf("Shoulder tilt is %f\n", shoulderTilt)
-	waistTilt, warning := GetWaistTilt(keypoints, calibrationInfo)
-	var waistTiltWarning string
-	if warning != nil {
-		waistTiltWarning = warning.Error()
-	}
-	fmt.Printf("Waist tilt is %f\n", waistTilt)
-	shaftLean, warning := GetShaftLean(calibrationInfo)
-	var shaftLeanWarning string
-	if warning != nil {
-		shaftLeanWarning = warning.Error()
-	}
-	fmt.Printf("Shaft lean is %f\n", shaftLean)
-	ballPosition, warning := GetBallPosition(keypoints, calibrationInfo)
-	var ballPositionWarning string
-	if warning != nil {
-		ballPositionWarning = warning.Error()
-	}
-	fmt.Printf("Ball position is %f\n", ballPosition)
-	headPosition, warning := GetHeadPosition(keypoints, calibrationInfo)
-	var headPositionWarning string
-	if warning != nil {
-		headPositionWarning = warning.Error()
-	}
-	fmt.Printf("Head position is %f\n", headPosition)
-	chestPosition, warning := GetChestPosition(keypoints, calibrationInfo)
-	var chestPositionWarning string
-	if warning != nil {
-		chestPositionWarning = warning.Error()
-	}
-	fmt.Printf("Chest position is %f\n", chestPosition)
-	midHipPosition, warning := GetMidhipPosition(keypoints, calibrationInfo)
-	var midHipPositionWarning string
-	if warning != nil {
-		midHipPositionWarning = warning.Error()
-	}
-	fmt.Printf("Mid hip position is %f\n", midHipPosition)
+	logger.DebugContext(ctx, "calculating face on setup points", "calibration_type", calibrationInfo.CalibrationType.String())
+	sideBend, sideBendWarning := GetSideBend(keypoints, calibrationInfo)
+	logger.DebugContext(ctx, "calculated side bend", "value", sideBend)
+	lFootFlare, lFootFlareWarning := GetLeftFootFlare(keypoints, calibrationInfo)
+	logger.DebugContext(ctx, "calculated left foot flare", "value", lFootFlare)
+	rFootFlare, rFootFlareWarning := GetRightFootFlare(keypoints, calibrationInfo)
+	logger.DebugContext(ctx, "calculated right foot flare", "value", rFootFlare)
+	stanceWidth, stanceWidthWarning := GetStanceWidth(keypoints)
+	logger.DebugContext(ctx, "calculated stance width", "value", stanceWidth)
+	shoulderTilt, shoulderTiltWarning := GetShoulderTilt(keypoints, calibrationInfo)
+	logger.DebugContext(ctx, "calculated shoulder tilt", "value", shoulderTilt)
+	waistTilt, waistTiltWarning := GetWaistTilt(keypoints, calibrationInfo)
+	logger.DebugContext(ctx, "calculated waist tilt", "value", waistTilt)
+	shaftLean, shaftLeanWarning := GetShaftLean(calibrationInfo)
+	logger.DebugContext(ctx, "calculated shaft lean", "value", shaftLean)
+	ballPosition, ballPositionWarning := GetBallPosition(keypoints, calibrationInfo)
+	logger.DebugContext(ctx, "calculated ball position", "value", ballPosition)
+	headPosition, headPositionWarning := GetHeadPosition(keypoints, calibrationInfo)
+	logger.DebugContext(ctx, "calculated head position", "value", headPosition)
+	chestPosition, chestPositionWarning := GetChestPosition(keypoints, calibrationInfo)
+	logger.DebugContext(ctx, "calculated chest position", "value", chestPosition)
+	midHipPosition, midHipPositionWarning := GetMidhipPosition(keypoints, calibrationInfo)
+	logger.DebugContext(ctx, "calculated mid hip position", "value", midHipPosition)
 
+	recordSetupPointWarnings(map[string]util.Warning{
+		"side_bend":        sideBendWarning,
+		"l_foot_flare":     lFootFlareWarning,
+		"r_foot_flare":     rFootFlareWarning,
+		"stance_width":     stanceWidthWarning,
+		"shoulder_tilt":    shoulderTiltWarning,
+		"waist_tilt":       waistTiltWarning,
+		"shaft_lean":       shaftLeanWarning,
+		"ball_position":    ballPositionWarning,
+		"head_position":    headPositionWarning,
+		"chest_position":   chestPositionWarning,
+		"mid_hip_position": midHipPositionWarning,
+	})
 	faceOnGolfSetupPoints := &skp.FaceOnGolfSetupPoints{
 		SideBend: &skp.Double{
 			Data:    sideBend,
-			Warning: sideBendWarning,
+			Warning: warningMessage(sideBendWarning),
 		},
 		LFootFlare: &skp.Double{
 			Data:    lFootFlare,
-			Warning: lFootFlareWarning,
+			Warning: warningMessage(lFootFlareWarning),
 		},
 		RFootFlare: &skp.Double{
 			Data:    rFootFlare,
-			Warning: rFootFlareWarning,
+			Warning: warningMessage(rFootFlareWarning),
 		},
 		StanceWidth: &skp.Double{
 			Data:    stanceWidth,
-			Warning: stanceWidthWarning,
+			Warning: warningMessage(stanceWidthWarning),
 		},
 		ShoulderTilt: &skp.Double{
 			Data:    shoulderTilt,
-			Warning: shoulderTiltWarning,
+			Warning: warningMessage(shoulderTiltWarning),
 		},
 		WaistTilt: &skp.Double{
 			Data:    waistTilt,
-			Warning: waistTiltWarning,
+			Warning: warningMessage(waistTiltWarning),
 		},
 		ShaftLean: &skp.Double{
 			Data:    shaftLean,
-			Warning: shaftLeanWarning,
+			Warning: warningMessage(shaftLeanWarning),
 		},
 		BallPosition: &skp.Double{
 			Data:    ballPosition,
-			Warning: ballPositionWarning,
+			Warning: warningMessage(ballPositionWarning),
 		},
 		HeadPosition: &skp.Double{
 			Data:    headPosition,
-			Warning: headPositionWarning,
+			Warning: warningMessage(headPositionWarning),
 		},
 		ChestPosition: &skp.Double{
 			Data:    chestPosition,
-			Warning: chestPositionWarning,
+			Warning: warningMessage(chestPositionWarning),
 		},
 		MidHipPosition: &skp.Double{
 			Data:    midHipPosition,
-			Warning: midHipPositionWarning,
+			Warning: warningMessage(midHipPositionWarning),
 		},
 	}
 	return faceOnGolfSetupPoints
@@ -144,13 +113,13 @@ func GetSideBend(keypoints *skp.Body25PoseKeypoints, calibrationInfo *util.Calib
 		}
 	}
 	var warning util.Warning
-	if w := util.VerifyKeypoint(keypoints.Midhip, "midhip", 0.5); w != nil {
+	if w := util.VerifyKeypoint(keypoints.Midhip, "midhip", util.MinKeypointConfidence()); w != nil {
 		if w.GetSeverity() == util.SEVERE {
 			return 0, w
 		}
 		warning = util.AppendMinorWarnings(warning, w)
 	}
-	if w := util.VerifyKeypoint(keypoints.Neck, "neck", 0.5); w != nil {
+	if w := util.VerifyKeypoint(keypoints.Neck, "neck", util.MinKeypointConfidence()); w != nil {
 		if w.GetSeverity() == util.SEVERE {
 			return 0, w
 		}
@@ -197,13 +166,13 @@ func GetLeftFootFlare(keypoints *skp.Body25PoseKeypoints, calibrationInfo *util.
 		}
 	}
 	var warning util.Warning
-	if w := util.VerifyKeypoint(keypoints.LHeel, "left heel", 0.5); w != nil {
+	if w := util.VerifyKeypoint(keypoints.LHeel, "left heel", util.MinKeypointConfidence()); w != nil {
 		if w.GetSeverity() == util.SEVERE {
 			return 0, w
 		}
 		warning = util.AppendMinorWarnings(warning, w)
 	}
-	if w := util.VerifyKeypoint(keypoints.LBigToe, "left big toe", 0.5); w != nil {
+	if w := util.VerifyKeypoint(keypoints.LBigToe, "left big toe", util.MinKeypointConfidence()); w != nil {
 		if w.GetSeverity() == util.SEVERE {
 			return 0, w
 		}
@@ -220,13 +189,13 @@ func GetRightFootFlare(keypoints *skp.Body25PoseKeypoints, calibrationInfo *util
 		}
 	}
 	var warning util.Warning
-	if w := util.VerifyKeypoint(keypoints.RHeel, "right heel", 0.5); w != nil {
+	if w := util.VerifyKeypoint(keypoints.RHeel, "right heel", util.MinKeypointConfidence()); w != nil {
 		if w.GetSeverity() == util.SEVERE {
 			return 0, w
 		}
 		warning = util.AppendMinorWarnings(warning, w)
 	}
-	if w := util.VerifyKeypoint(keypoints.RBigToe, "right big toe", 0.5); w != nil {
+	if w := util.VerifyKeypoint(keypoints.RBigToe, "right big toe", util.MinKeypointConfidence()); w != nil {
 		if w.GetSeverity() == util.SEVERE {
 			return 0, w
 		}
@@ -242,25 +211,25 @@ func GetRightFootFlare(keypoints *skp.Body25PoseKeypoints, calibrationInfo *util
 // the larger the number the wider the stance
 func GetStanceWidth(keypoints *skp.Body25PoseKeypoints) (float64, util.Warning) {
 	var warning util.Warning
-	if w := util.VerifyKeypoint(keypoints.LHeel, "left heel", 0.5); w != nil {
+	if w := util.VerifyKeypoint(keypoints.LHeel, "left heel", util.MinKeypointConfidence()); w != nil {
 		if w.GetSeverity() == util.SEVERE {
 			return 0, w
 		}
 		warning = util.AppendMinorWarnings(warning, w)
 	}
-	if w := util.VerifyKeypoint(keypoints.RHeel, "right heel", 0.5); w != nil {
+	if w := util.VerifyKeypoint(keypoints.RHeel, "right heel", util.MinKeypointConfidence()); w != nil {
 		if w.GetSeverity() == util.SEVERE {
 			return 0, w
 		}
 		warning = util.AppendMinorWarnings(warning, w)
 	}
-	if w := util.VerifyKeypoint(keypoints.Midhip, "midhip", 0.5); w != nil {
+	if w := util.VerifyKeypoint(keypoints.Midhip, "midhip", util.MinKeypointConfidence()); w != nil {
 		if w.GetSeverity() == util.SEVERE {
 			return 0, w
 		}
 		warning = util.AppendMinorWarnings(warning, w)
 	}
-	if w := util.VerifyKeypoint(keypoints.Neck, "neck", 0.5); w != nil {
+	if w := util.VerifyKeypoint(keypoints.Neck, "neck", util.MinKeypointConfidence()); w != nil {
 		if w.GetSeverity() == util.SEVERE {
 			return 0, w
 		}
@@ -288,13 +257,13 @@ func GetShoulderTilt(keypoints *skp.Body25PoseKeypoints, calibrationInfo *util.C
 		}
 	}
 	var warning util.Warning
-	if w := util.VerifyKeypoint(keypoints.LShoulder, "left shoulder", 0.5); w != nil {
+	if w := util.VerifyKeypoint(keypoints.LShoulder, "left shoulder", util.MinKeypointConfidence()); w != nil {
 		if w.GetSeverity() == util.SEVERE {
 			return 0, w
 		}
 		warning = util.AppendMinorWarnings(warning, w)
 	}
-	if w := util.VerifyKeypoint(keypoints.RShoulder, "right shoulder", 0.5); w != nil {
+	if w := util.VerifyKeypoint(keypoints.RShoulder, "right shoulder", util.MinKeypointConfidence()); w != nil {
 		if w.GetSeverity() == util.SEVERE {
 			return 0, w
 		}
@@ -322,13 +291,13 @@ func GetWaistTilt(keypoints *skp.Body25PoseKeypoints, calibrationInfo *util.Cali
 		}
 	}
 	var warning util.Warning
-	if w := util.VerifyKeypoint(keypoints.LHip, "left hip", 0.5); w != nil {
+	if w := util.VerifyKeypoint(keypoints.LHip, "left hip", util.MinKeypointConfidence()); w != nil {
 		if w.GetSeverity() == util.SEVERE {
 			return 0, w
 		}
 		warning = util.AppendMinorWarnings(warning, w)
 	}
-	if w := util.VerifyKeypoint(keypoints.RHip, "right hip", 0.5); w != nil {
+	if w := util.VerifyKeypoint(keypoints.RHip, "right hip", util.MinKeypointConfidence()); w != nil {
 		if w.GetSeverity() == util.SEVERE {
 			return 0, w
 		}
@@ -357,13 +326,13 @@ func GetShaftLean(calibrationInfo *util.CalibrationInfo) (float64, util.Warning)
 		}
 	}
 	var warning util.Warning
-	if w := util.VerifyKeypoint(&calibrationInfo.ClubButtPoint, "club butt", 0.5); w != nil {
+	if w := util.VerifyKeypoint(&calibrationInfo.ClubButtPoint, "club butt", util.MinKeypointConfidence()); w != nil {
 		if w.GetSeverity() == util.SEVERE {
 			return 0, w
 		}
 		warning = util.AppendMinorWarnings(warning, w)
 	}
-	if w := util.VerifyKeypoint(&calibrationInfo.ClubHeadPoint, "club head", 0.5); w != nil {
+	if w := util.VerifyKeypoint(&calibrationInfo.ClubHeadPoint, "club head", util.MinKeypointConfidence()); w != nil {
 		if w.GetSeverity() == util.SEVERE {
 			return 0, w
 		}
@@ -387,17 +356,22 @@ func GetShaftLean(calibrationInfo *util.CalibrationInfo) (float64, util.Warning)
 // positive angle means ball closer to lead side, negative angle means ball closer to trail side
 func GetBallPosition(keypoints *skp.Body25PoseKeypoints, calibrationInfo *util.CalibrationInfo) (float64, util.Warning) {
 	var warning util.Warning
-	if w := util.VerifyKeypoint(&calibrationInfo.GolfBallPoint, "golf ball", 0.5); w != nil {
+	if w := util.VerifyKeypoint(&calibrationInfo.GolfBallPoint, "golf ball", util.MinKeypointConfidence()); w != nil {
+		if w.GetSeverity() == util.SEVERE {
+			return 0, w
+		}
+		warning = util.AppendMinorWarnings(warning, w)
+	}
+	feetLine, w := util.GetFeetLine(keypoints, calibrationInfo.FeetLineMethod)
+	if w != nil {
 		if w.GetSeverity() == util.SEVERE {
 			return 0, w
 		}
 		warning = util.AppendMinorWarnings(warning, w)
 	}
 	// convert keypoints to point
-	lFootPoint, _ := util.GetLeftFootPoint(keypoints, calibrationInfo.FeetLineMethod)
-	rFootPoint, _ := util.GetRightFootPoint(keypoints, calibrationInfo.FeetLineMethod)
-	lfoot := util.ConvertKeypointToPoint(lFootPoint)
-	rfoot := util.ConvertKeypointToPoint(rFootPoint)
+	lfoot := &feetLine.LPoint
+	rfoot := &feetLine.RPoint
 	golfball := util.ConvertKeypointToPoint(&calibrationInfo.GolfBallPoint)
 	// calculate ball position
 	feetLineMidpoint := util.GetMidpoint(lfoot, rfoot)
@@ -422,17 +396,22 @@ func GetBallPosition(keypoints *skp.Body25PoseKeypoints, calibrationInfo *util.C
 // positive angle means head is closer to lead side, negative angle means head is closer to trail side
 func GetHeadPosition(keypoints *skp.Body25PoseKeypoints, calibrationInfo *util.CalibrationInfo) (float64, util.Warning) {
 	var warning util.Warning
-	if w := util.VerifyKeypoint(keypoints.Nose, "nose", 0.5); w != nil {
+	if w := util.VerifyKeypoint(keypoints.Nose, "nose", util.MinKeypointConfidence()); w != nil {
+		if w.GetSeverity() == util.SEVERE {
+			return 0, w
+		}
+		warning = util.AppendMinorWarnings(warning, w)
+	}
+	feetLine, w := util.GetFeetLine(keypoints, calibrationInfo.FeetLineMethod)
+	if w != nil {
 		if w.GetSeverity() == util.SEVERE {
 			return 0, w
 		}
 		warning = util.AppendMinorWarnings(warning, w)
 	}
 	// convert keypoints to point
-	lFootPoint, _ := util.GetLeftFootPoint(keypoints, calibrationInfo.FeetLineMethod)
-	rFootPoint, _ := util.GetRightFootPoint(keypoints, calibrationInfo.FeetLineMethod)
-	lfoot := util.ConvertKeypointToPoint(lFootPoint)
-	rfoot := util.ConvertKeypointToPoint(rFootPoint)
+	lfoot := &feetLine.LPoint
+	rfoot := &feetLine.RPoint
 	nose := util.ConvertKeypointToPoint(keypoints.Nose)
 	// calculate head position
 	feetLineMidpoint := util.GetMidpoint(lfoot, rfoot)
@@ -457,17 +436,22 @@ func GetHeadPosition(keypoints *skp.Body25PoseKeypoints, calibrationInfo *util.C
 // positive angle means head is closer to lead side, negative angle means head is closer to trail side
 func GetChestPosition(keypoints *skp.Body25PoseKeypoints, calibrationInfo *util.CalibrationInfo) (float64, util.Warning) {
 	var warning util.Warning
-	if w := util.VerifyKeypoint(keypoints.Neck, "neck", 0.5); w != nil {
+	if w := util.VerifyKeypoint(keypoints.Neck, "neck", util.MinKeypointConfidence()); w != nil {
+		if w.GetSeverity() == util.SEVERE {
+			return 0, w
+		}
+		warning = util.AppendMinorWarnings(warning, w)
+	}
+	feetLine, w := util.GetFeetLine(keypoints, calibrationInfo.FeetLineMethod)
+	if w != nil {
 		if w.GetSeverity() == util.SEVERE {
 			return 0, w
 		}
 		warning = util.AppendMinorWarnings(warning, w)
 	}
 	// convert keypoints to point
-	lFootPoint, _ := util.GetLeftFootPoint(keypoints, calibrationInfo.FeetLineMethod)
-	rFootPoint, _ := util.GetRightFootPoint(keypoints, calibrationInfo.FeetLineMethod)
-	lfoot := util.ConvertKeypointToPoint(lFootPoint)
-	rfoot := util.ConvertKeypointToPoint(rFootPoint)
+	lfoot := &feetLine.LPoint
+	rfoot := &feetLine.RPoint
 	neck := util.ConvertKeypointToPoint(keypoints.Neck)
 	// calculate chest position
 	feetLineMidpoint := util.GetMidpoint(lfoot, rfoot)
@@ -492,17 +476,22 @@ func GetChestPosition(keypoints *skp.Body25PoseKeypoints, calibrationInfo *util.
 // positive angle means head is closer to lead side, negative angle means head is closer to trail side
 func GetMidhipPosition(keypoints *skp.Body25PoseKeypoints, calibrationInfo *util.CalibrationInfo) (float64, util.Warning) {
 	var warning util.Warning
-	if w := util.VerifyKeypoint(keypoints.Midhip, "mid hip", 0.5); w != nil {
+	if w := util.VerifyKeypoint(keypoints.Midhip, "mid hip", util.MinKeypointConfidence()); w != nil {
+		if w.GetSeverity() == util.SEVERE {
+			return 0, w
+		}
+		warning = util.AppendMinorWarnings(warning, w)
+	}
+	feetLine, w := util.GetFeetLine(keypoints, calibrationInfo.FeetLineMethod)
+	if w != nil {
 		if w.GetSeverity() == util.SEVERE {
 			return 0, w
 		}
 		warning = util.AppendMinorWarnings(warning, w)
 	}
 	// convert keypoints to point
-	lFootPoint, _ := util.GetLeftFootPoint(keypoints, calibrationInfo.FeetLineMethod)
-	rFootPoint, _ := util.GetRightFootPoint(keypoints, calibrationInfo.FeetLineMethod)
-	lfoot := util.ConvertKeypointToPoint(lFootPoint)
-	rfoot := util.ConvertKeypointToPoint(rFootPoint)
+	lfoot := &feetLine.LPoint
+	rfoot := &feetLine.RPoint
 	midhip := util.ConvertKeypointToPoint(keypoints.Midhip)
 	// calculate midhip position
 	feetLineMidpoint := util.GetMidpoint(lfoot, rfoot)
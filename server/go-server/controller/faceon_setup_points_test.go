@@ -1,9 +1,15 @@
 package controller
 
 import (
+	"context"
+	"encoding/json"
 	"math"
+	"strings"
 	"testing"
 
+	"google.golang.org/protobuf/proto"
+
+	db "github.com/sirfrank96/go-server/db"
 	skp "github.com/sirfrank96/go-server/sports-keypoints-proto"
 	"github.com/sirfrank96/go-server/util"
 )
@@ -700,3 +706,71 @@ func TestGetMidhipPosition(t *testing.T) {
 		t.Errorf("GetMidhipPosition(%+v, %+v) = %f; expected %f", keypoints, calibrationInfoFaceOn, backActual, backExpected)
 	}
 }
+
+// Landmark i is at 500+i,1000+10*i so every landmark exists and no two share a point
+func newLandmarks(n int) []*skp.Keypoint {
+	landmarks := make([]*skp.Keypoint, n)
+	for i := range landmarks {
+		landmarks[i] = &skp.Keypoint{X: float64(500 + i), Y: float64(1000 + 10*i), Confidence: 0.9}
+	}
+	return landmarks
+}
+
+func TestCalculateFaceOnSetupPointsConvertedSchemas(t *testing.T) {
+	// coco17 has no feet, so every setup point measured against the feet line has a severe warning
+	keypoints, err := util.ConvertLandmarksToBody25(skp.KeypointSchema_KEYPOINT_SCHEMA_COCO17, newLandmarks(17))
+	if err != nil {
+		t.Fatalf("ConvertLandmarksToBody25 had an unexpected error: %s", err.Error())
+	}
+	setupPoints := CalculateFaceOnSetupPoints(context.Background(), keypoints, calibrationInfoFaceOn)
+	for name, point := range map[string]*skp.Double{
+		"ball position":    setupPoints.BallPosition,
+		"head position":    setupPoints.HeadPosition,
+		"chest position":   setupPoints.ChestPosition,
+		"mid hip position": setupPoints.MidHipPosition,
+	} {
+		if !strings.Contains(point.GetWarning(), "could not find keypoint left heel") {
+			t.Errorf("%s warning is %q, expected the missing left heel", name, point.GetWarning())
+		}
+	}
+	// mediapipe33 has heels and big toes
+	keypoints, err = util.ConvertLandmarksToBody25(skp.KeypointSchema_KEYPOINT_SCHEMA_MEDIAPIPE33, newLandmarks(33))
+	if err != nil {
+		t.Fatalf("ConvertLandmarksToBody25 had an unexpected error: %s", err.Error())
+	}
+	setupPoints = CalculateFaceOnSetupPoints(context.Background(), keypoints, calibrationInfoFaceOn)
+	if strings.Contains(setupPoints.BallPosition.GetWarning(), "heel") {
+		t.Errorf("ball position warning is %q, expected the heels to be found", setupPoints.BallPosition.GetWarning())
+	}
+}
+
+func TestCalculateFaceOnSetupPointsImportMissingHeel(t *testing.T) {
+	// openpose writes 0,0 for the left heel it did not detect
+	poseKeypoints := make([]float64, 0, 25*3)
+	for _, landmark := range newLandmarks(25) {
+		poseKeypoints = append(poseKeypoints, landmark.X, landmark.Y, landmark.Confidence)
+	}
+	poseKeypoints[21*3], poseKeypoints[21*3+1], poseKeypoints[21*3+2] = 0, 0, 0
+	data, err := json.Marshal(map[string]any{"people": []map[string]any{{"pose_keypoints_2d": poseKeypoints}}})
+	if err != nil {
+		t.Fatalf("could not marshal openpose json: %s", err.Error())
+	}
+	openPoseKeypoints, err := util.ParseOpenPoseKeypoints(data)
+	if err != nil {
+		t.Fatalf("ParseOpenPoseKeypoints had an unexpected error: %s", err.Error())
+	}
+	// body keypoints imported without a left heel
+	bodyKeypoints := proto.Clone(openPoseKeypoints).(*skp.Body25PoseKeypoints)
+	bodyKeypoints.LHeel = nil
+	for name, keypoints := range map[string]*skp.Body25PoseKeypoints{"openpose json": openPoseKeypoints, "body keypoints": bodyKeypoints} {
+		golfKeypoints := &db.GolfKeypoints{KeypointSchema: skp.KeypointSchema_KEYPOINT_SCHEMA_BODY25}
+		proto.Merge(&golfKeypoints.OutputKeypoints, keypoints)
+		setupPoints := CalculateFaceOnSetupPoints(context.Background(), &golfKeypoints.OutputKeypoints, calibrationInfoFaceOn)
+		if !strings.Contains(setupPoints.HeadPosition.GetWarning(), "could not find keypoint left heel") {
+			t.Errorf("head position warning for %s is %q, expected the missing left heel", name, setupPoints.HeadPosition.GetWarning())
+		}
+		if strings.Contains(setupPoints.SideBend.GetWarning(), "heel") {
+			t.Errorf("side bend warning for %s is %q, expected it not to need the heels", name, setupPoints.SideBend.GetWarning())
+		}
+	}
+}
@@ -3,47 +3,63 @@ package controller
 import (
 	"context"
 	"fmt"
+	"time"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/sirfrank96/go-server/apierror"
+	"github.com/sirfrank96/go-server/config"
 	cvclient "github.com/sirfrank96/go-server/cv-client"
 	db "github.com/sirfrank96/go-server/db"
 	skp "github.com/sirfrank96/go-server/sports-keypoints-proto"
 	"github.com/sirfrank96/go-server/util"
 )
 
+// PreconditionFailure type when a calibration type needs a calibration image that was not uploaded
+const calibrationImageRequired = "CALIBRATION_IMAGE_REQUIRED"
+
 type GolfKeypointsListener struct {
 	skp.UnimplementedGolfKeypointsServiceServer
-	cvmgr *cvclient.CvClientManager
-	dbmgr *db.DbManager
+	poseEstimator cvclient.PoseEstimator
+	dbmgr         *db.DbManager
+	// how often WatchAnalysisJob reads the job
+	watchInterval   time.Duration
+	analysisWorkers *analysisWorkers
 }
 
-func newGolfKeypointsListener(cvmgr *cvclient.CvClientManager, dbmgr *db.DbManager) *GolfKeypointsListener {
-	return &GolfKeypointsListener{
-		cvmgr: cvmgr,
-		dbmgr: dbmgr,
-	}
+func newGolfKeypointsListener(poseEstimator cvclient.PoseEstimator, dbmgr *db.DbManager, analysisJobsConfig config.AnalysisJobsConfig) *GolfKeypointsListener {
+	g := &GolfKeypointsListener{
+		poseEstimator: poseEstimator,
+		dbmgr:         dbmgr,
+		watchInterval: analysisJobsConfig.WatchInterval,
+	}
+	g.analysisWorkers = newAnalysisWorkers(analysisJobsConfig, dbmgr, g.runAnalysisJob)
+	return g
 }
 
 func (g *GolfKeypointsListener) UploadInputImage(ctx context.Context, request *skp.UploadInputImageRequest) (*skp.UploadInputImageResponse, error) {
 	// make sure user exists
 	userId, ok := ctx.Value(util.UserIdKey).(string)
 	if !ok {
-		return nil, fmt.Errorf("unable to get userId from context")
+		return nil, apierror.Unauthenticated("invalid user id")
 	}
-	if _, err := verifyUserExists(ctx, g.dbmgr, userId); err != nil {
-		return nil, fmt.Errorf("could not verify user exists")
+	user, err := verifyUserExists(ctx, g.dbmgr, userId)
+	if err != nil {
+		return nil, fmt.Errorf("could not verify user exists: %w", err)
 	}
 	// put image into db
 	inputImage := &db.InputImage{
 		UserId:          userId,
+		OrgId:           user.OrgId,
 		ImageType:       request.ImageType,
 		InputImg:        request.Image,
 		Description:     request.Description,
 		Timestamp:       request.Timestamp.AsTime(), // UTC
 		CalibrationInfo: *util.GetEmptyCalibrationInfo(),
 	}
-	inputImage, err := g.dbmgr.CreateInputImage(ctx, inputImage)
+	inputImage, err = g.dbmgr.CreateInputImage(ctx, inputImage)
 	if err != nil {
 		return nil, fmt.Errorf("could not store input image: %w", err)
 	}
@@ -59,13 +75,14 @@ func (g *GolfKeypointsListener) ListInputImagesForUser(ctx context.Context, requ
 	// make sure user exists
 	userId, ok := ctx.Value(util.UserIdKey).(string)
 	if !ok {
-		return nil, fmt.Errorf("invalid user id")
+		return nil, apierror.Unauthenticated("invalid user id")
 	}
-	if _, err := verifyUserExists(ctx, g.dbmgr, userId); err != nil {
-		return nil, fmt.Errorf("could not verify user exists")
+	user, err := verifyUserExists(ctx, g.dbmgr, userId)
+	if err != nil {
+		return nil, fmt.Errorf("could not verify user exists: %w", err)
 	}
 	// get all input images for userid from db
-	inputImgs, err := g.dbmgr.ReadInputImagesForUser(ctx, userId)
+	inputImgs, err := g.dbmgr.ReadInputImagesForUser(ctx, user.OrgId, userId)
 	if err != nil {
 		return nil, fmt.Errorf("could not get images for user from db: %w", err)
 	}
@@ -85,13 +102,14 @@ func (g *GolfKeypointsListener) ReadInputImage(ctx context.Context, request *skp
 	// make sure user exists
 	userId, ok := ctx.Value(util.UserIdKey).(string)
 	if !ok {
-		return nil, fmt.Errorf("invalid user id")
+		return nil, apierror.Unauthenticated("invalid user id")
 	}
-	if _, err := verifyUserExists(ctx, g.dbmgr, userId); err != nil {
-		return nil, fmt.Errorf("could not verify user exists")
+	user, err := verifyUserExists(ctx, g.dbmgr, userId)
+	if err != nil {
+		return nil, fmt.Errorf("could not verify user exists: %w", err)
 	}
 	// get inputimg with inputimgid from db
-	inputImg, err := g.dbmgr.ReadInputImage(ctx, request.InputImageId)
+	inputImg, err := g.dbmgr.ReadInputImage(ctx, user.OrgId, request.InputImageId)
 	if err != nil {
 		return nil, fmt.Errorf("could not read input image with id: %s: %w", request.InputImageId, err)
 	}
@@ -112,13 +130,14 @@ func (g *GolfKeypointsListener) DeleteInputImage(ctx context.Context, request *s
 	// make sure user exists
 	userId, ok := ctx.Value(util.UserIdKey).(string)
 	if !ok {
-		return nil, fmt.Errorf("invalid user id")
+		return nil, apierror.Unauthenticated("invalid user id")
 	}
-	if _, err := verifyUserExists(ctx, g.dbmgr, userId); err != nil {
-		return nil, fmt.Errorf("could not verify user exists")
+	user, err := verifyUserExists(ctx, g.dbmgr, userId)
+	if err != nil {
+		return nil, fmt.Errorf("could not verify user exists: %w", err)
 	}
 	// delete inputimg with inputimgid in db
-	err := g.dbmgr.DeleteInputImage(ctx, request.InputImageId)
+	err = g.dbmgr.DeleteInputImage(ctx, user.OrgId, request.InputImageId)
 	if err != nil {
 		return nil, fmt.Errorf("could not delete input image with id: %s: %w", request.InputImageId, err)
 	}
@@ -133,13 +152,14 @@ func (g *GolfKeypointsListener) CalibrateInputImage(ctx context.Context, request
 	// make sure user exists
 	userId, ok := ctx.Value(util.UserIdKey).(string)
 	if !ok {
-		return nil, fmt.Errorf("invalid user id")
+		return nil, apierror.Unauthenticated("invalid user id")
 	}
-	if _, err := verifyUserExists(ctx, g.dbmgr, userId); err != nil {
-		return nil, fmt.Errorf("could not verify user exists")
+	user, err := verifyUserExists(ctx, g.dbmgr, userId)
+	if err != nil {
+		return nil, fmt.Errorf("could not verify user exists: %w", err)
 	}
 	// get inputimg with inputimgid from db
-	inputImage, err := g.dbmgr.ReadInputImage(ctx, request.InputImageId)
+	inputImage, err := g.dbmgr.ReadInputImage(ctx, user.OrgId, request.InputImageId)
 	if err != nil {
 		return nil, fmt.Errorf("could not read input image with id: %s: %w", request.InputImageId, err)
 	}
@@ -164,22 +184,22 @@ func (g *GolfKeypointsListener) CalibrateInputImage(ctx context.Context, request
 		// axes calibration
 		if calibrationInfo.CalibrationType != skp.CalibrationType_NO_CALIBRATION {
 			if inputImage.CalibrationImgAxes == nil {
-				return nil, fmt.Errorf("calibration image axes is required")
+				return nil, apierror.FailedPrecondition(calibrationImageRequired, "calibration_image_axes", "calibration image axes is required for calibration type %s", calibrationInfo.CalibrationType.String())
 			}
-			getPoseDataResponse, err := g.cvmgr.GetPoseData(inputImage.CalibrationImgAxes)
+			getPoseDataResponse, err := g.poseEstimator.GetPoseData(ctx, inputImage.CalibrationImgAxes)
 			if err != nil {
 				return nil, fmt.Errorf("could not get pose data for calibration image axes %w", err)
 			}
-			fmt.Printf("Axes calibration image processed\n")
 			var warning util.Warning
 			calibrationInfo, warning = util.VerifyCalibrationImageAxes(getPoseDataResponse.Keypoints, calibrationInfo)
 			if warning != nil {
-				return nil, fmt.Errorf("could not verify calibration image axes: %s", warning.Error())
+				return nil, apierror.InvalidArgument("calibration_image_axes", "could not verify calibration image axes: %s", warning.Error())
 			}
+			logger.DebugContext(ctx, "verified axes calibration image", "hor_axis_slope", calibrationInfo.HorAxisLine.Slope, "vert_axis_slope", calibrationInfo.VertAxisLine.Slope)
 			// vanishing point calibration
 			if calibrationInfo.CalibrationType != skp.CalibrationType_AXES_CALIBRATION_ONLY {
 				if inputImage.CalibrationImgVanishingPoint == nil {
-					return nil, fmt.Errorf("calibration image vanishing point is required")
+					return nil, apierror.FailedPrecondition(calibrationImageRequired, "calibration_image_vanishing_point", "calibration image vanishing point is required for calibration type %s", calibrationInfo.CalibrationType.String())
 				}
 				// add shoulder tilt for shoulder alignment calculation if provided
 				if request.ShoulderTilt != nil {
@@ -187,15 +207,15 @@ func (g *GolfKeypointsListener) CalibrateInputImage(ctx context.Context, request
 				} else {
 					calibrationInfo.ShoulderTilt = skp.Double{Data: 0, Warning: "Shoulder tilt not provided"}
 				}
-				getPoseDataResponse, err := g.cvmgr.GetPoseData(inputImage.CalibrationImgVanishingPoint)
+				getPoseDataResponse, err := g.poseEstimator.GetPoseData(ctx, inputImage.CalibrationImgVanishingPoint)
 				if err != nil {
 					return nil, fmt.Errorf("could not get pose data for calibration image vanishingpoint %w", err)
 				}
-				fmt.Printf("Vanishing point calibration image processed\n")
 				calibrationInfo, warning = util.VerifyCalibrationImageVanishingPoint(getPoseDataResponse.Keypoints, calibrationInfo)
 				if warning != nil {
-					return nil, fmt.Errorf("could not verify calibration image axes: %s", warning.Error())
+					return nil, apierror.InvalidArgument("calibration_image_vanishing_point", "could not verify calibration image vanishing point: %s", warning.Error())
 				}
+				logger.DebugContext(ctx, "verified vanishing point calibration image", "vanishing_point_x", calibrationInfo.VanishingPoint.XPos, "vanishing_point_y", calibrationInfo.VanishingPoint.YPos)
 			}
 		}
 		// face on calibration via calibration image
@@ -203,23 +223,23 @@ func (g *GolfKeypointsListener) CalibrateInputImage(ctx context.Context, request
 		// axes calibration
 		if calibrationInfo.CalibrationType != skp.CalibrationType_NO_CALIBRATION {
 			if inputImage.CalibrationImgAxes == nil {
-				return nil, fmt.Errorf("calibration image axes is required")
+				return nil, apierror.FailedPrecondition(calibrationImageRequired, "calibration_image_axes", "calibration image axes is required for calibration type %s", calibrationInfo.CalibrationType.String())
 			}
-			getPoseDataResponse, err := g.cvmgr.GetPoseData(inputImage.CalibrationImgAxes)
+			getPoseDataResponse, err := g.poseEstimator.GetPoseData(ctx, inputImage.CalibrationImgAxes)
 			if err != nil {
 				return nil, fmt.Errorf("could not get pose data for calibration image axes %w", err)
 			}
-			fmt.Printf("Axes calibration image processed\n")
 			var warning util.Warning
 			calibrationInfo, warning = util.VerifyCalibrationImageAxes(getPoseDataResponse.Keypoints, calibrationInfo)
 			if warning != nil {
-				return nil, fmt.Errorf("could not verify calibration image axes: %s", warning.Error())
+				return nil, apierror.InvalidArgument("calibration_image_axes", "could not verify calibration image axes: %s", warning.Error())
 			}
+			logger.DebugContext(ctx, "verified axes calibration image", "hor_axis_slope", calibrationInfo.HorAxisLine.Slope, "vert_axis_slope", calibrationInfo.VertAxisLine.Slope)
 		}
 	}
 	inputImage.CalibrationInfo = *calibrationInfo
 	// update inputimg with inputimgid in db
-	_, err = g.dbmgr.UpdateInputImage(ctx, request.InputImageId, inputImage)
+	_, err = g.dbmgr.UpdateInputImage(ctx, user.OrgId, request.InputImageId, inputImage)
 	if err != nil {
 		return nil, fmt.Errorf("could not update input image with id: %s with calibration info: %w", request.InputImageId, err)
 	}
@@ -234,45 +254,105 @@ func (g *GolfKeypointsListener) CalculateGolfKeypoints(ctx context.Context, requ
 	// make sure user exists
 	userId, ok := ctx.Value(util.UserIdKey).(string)
 	if !ok {
-		return nil, fmt.Errorf("invalid user id")
+		return nil, apierror.Unauthenticated("invalid user id")
 	}
-	if _, err := verifyUserExists(ctx, g.dbmgr, userId); err != nil {
-		return nil, fmt.Errorf("could not verify user exists")
+	user, err := verifyUserExists(ctx, g.dbmgr, userId)
+	if err != nil {
+		return nil, fmt.Errorf("could not verify user exists: %w", err)
 	}
+	golfKeypoints, err := g.calculateGolfKeypoints(ctx, userId, user.OrgId, request.InputImageId)
+	if err != nil {
+		return nil, err
+	}
+	// return response
+	response := &skp.CalculateGolfKeypointsResponse{
+		Success:       true,
+		OutputImage:   golfKeypoints.OutputImg,
+		GolfKeypoints: db.ConvertGolfKeypointsToCVGolfKeypoints(golfKeypoints),
+	}
+	return response, nil
+}
+
+// Estimates the pose in the input image and stores the golf keypoints calculated from it, for CalculateGolfKeypoints
+// and analysis jobs
+func (g *GolfKeypointsListener) calculateGolfKeypoints(ctx context.Context, userId string, orgId string, inputImageId string) (*db.GolfKeypoints, error) {
 	// get inputimage from db
-	inputImage, err := g.dbmgr.ReadInputImage(ctx, request.InputImageId)
+	inputImage, err := g.dbmgr.ReadInputImage(ctx, orgId, inputImageId)
 	if err != nil {
-		return nil, fmt.Errorf("could not get input image with id: %s, error was %w", request.InputImageId, err)
+		return nil, fmt.Errorf("could not get input image with id: %s, error was %w", inputImageId, err)
 	}
 	// get pose image and data for input img
-	getPoseAllResponse, err := g.cvmgr.GetPoseAll(inputImage.InputImg)
+	getPoseAllResponse, err := g.poseEstimator.GetPoseAll(ctx, inputImage.InputImg)
 	if err != nil {
 		return nil, fmt.Errorf("could not get pose all for image: %w", err)
 	}
-	// calculate golf setup points
 	golfKeypoints := &db.GolfKeypoints{
 		UserId:          userId,
-		InputImageId:    request.InputImageId,
+		OrgId:           orgId,
+		InputImageId:    inputImageId,
 		OutputImg:       getPoseAllResponse.Image,
 		OutputKeypoints: *getPoseAllResponse.PoseKeypoints,
+		KeypointSchema:  getPoseAllResponse.KeypointSchema,
 	}
+	return g.storeGolfKeypoints(ctx, inputImage, golfKeypoints)
+}
+
+// Calculates the golf setup points from the body keypoints of golfKeypoints and stores them, replacing the golf
+// keypoints the input image had
+func (g *GolfKeypointsListener) storeGolfKeypoints(ctx context.Context, inputImage *db.InputImage, golfKeypoints *db.GolfKeypoints) (*db.GolfKeypoints, error) {
 	// dtl setup points
 	if inputImage.ImageType == skp.ImageType_DTL {
-		golfKeypoints.DtlGolfSetupPoints = *CalculateDTLSetupPoints(ctx, getPoseAllResponse.PoseKeypoints, &inputImage.CalibrationInfo)
+		golfKeypoints.DtlGolfSetupPoints = *CalculateDTLSetupPoints(ctx, &golfKeypoints.OutputKeypoints, &inputImage.CalibrationInfo)
 	} else { // face on setup points
-		golfKeypoints.FaceonGolfSetupPoints = *CalculateFaceOnSetupPoints(ctx, getPoseAllResponse.PoseKeypoints, &inputImage.CalibrationInfo)
+		golfKeypoints.FaceonGolfSetupPoints = *CalculateFaceOnSetupPoints(ctx, &golfKeypoints.OutputKeypoints, &inputImage.CalibrationInfo)
 	}
 
 	// store golfkeypoints in db
-	_, err = g.dbmgr.CreateGolfKeypoints(ctx, golfKeypoints)
+	_, err := g.dbmgr.CreateGolfKeypoints(ctx, golfKeypoints)
 	if err != nil {
 		return nil, fmt.Errorf("could not store golfkeypoints in db %w", err)
 	}
+	return golfKeypoints, nil
+}
 
+func (g *GolfKeypointsListener) ImportGolfKeypoints(ctx context.Context, request *skp.ImportGolfKeypointsRequest) (*skp.ImportGolfKeypointsResponse, error) {
+	// make sure user exists
+	userId, ok := ctx.Value(util.UserIdKey).(string)
+	if !ok {
+		return nil, apierror.Unauthenticated("invalid user id")
+	}
+	user, err := verifyUserExists(ctx, g.dbmgr, userId)
+	if err != nil {
+		return nil, fmt.Errorf("could not verify user exists: %w", err)
+	}
+	// get inputimage from db
+	inputImage, err := g.dbmgr.ReadInputImage(ctx, user.OrgId, request.InputImageId)
+	if err != nil {
+		return nil, fmt.Errorf("could not get input image with id: %s, error was %w", request.InputImageId, err)
+	}
+	bodyKeypoints := request.GetBodyKeypoints()
+	if request.GetOpenposeJson() != nil {
+		bodyKeypoints, err = util.ParseOpenPoseKeypoints(request.GetOpenposeJson())
+		if err != nil {
+			return nil, apierror.InvalidArgument("openpose_json", "%s", err.Error())
+		}
+	}
+	// imported keypoints have no output image, computervision is not called
+	// keypoints openpose did not detect are missing and get a severe warning on the setup points that need them
+	golfKeypoints := &db.GolfKeypoints{
+		UserId:         userId,
+		OrgId:          user.OrgId,
+		InputImageId:   request.InputImageId,
+		KeypointSchema: skp.KeypointSchema_KEYPOINT_SCHEMA_BODY25,
+	}
+	proto.Merge(&golfKeypoints.OutputKeypoints, bodyKeypoints)
+	golfKeypoints, err = g.storeGolfKeypoints(ctx, inputImage, golfKeypoints)
+	if err != nil {
+		return nil, err
+	}
 	// return response
-	response := &skp.CalculateGolfKeypointsResponse{
+	response := &skp.ImportGolfKeypointsResponse{
 		Success:       true,
-		OutputImage:   getPoseAllResponse.Image,
 		GolfKeypoints: db.ConvertGolfKeypointsToCVGolfKeypoints(golfKeypoints),
 	}
 	return response, nil
@@ -282,13 +362,14 @@ func (g *GolfKeypointsListener) ReadGolfKeypoints(ctx context.Context, request *
 	// make sure user exists
 	userId, ok := ctx.Value(util.UserIdKey).(string)
 	if !ok {
-		return nil, fmt.Errorf("invalid user id")
+		return nil, apierror.Unauthenticated("invalid user id")
 	}
-	if _, err := verifyUserExists(ctx, g.dbmgr, userId); err != nil {
-		return nil, fmt.Errorf("could not verify user exists")
+	user, err := verifyUserExists(ctx, g.dbmgr, userId)
+	if err != nil {
+		return nil, fmt.Errorf("could not verify user exists: %w", err)
 	}
 	// find golf keypoints for associated input image id in db
-	golfKeypoints, err := g.dbmgr.ReadGolfKeypointsForInputImage(ctx, request.InputImageId)
+	golfKeypoints, err := g.dbmgr.ReadGolfKeypointsForInputImage(ctx, user.OrgId, request.InputImageId)
 	if err != nil {
 		return nil, fmt.Errorf("could not read golf keypoints from db for input image: %s, %w", request.InputImageId, err)
 	}
@@ -305,18 +386,19 @@ func (g *GolfKeypointsListener) UpdateBodyKeypoints(ctx context.Context, request
 	// make sure user exists
 	userId, ok := ctx.Value(util.UserIdKey).(string)
 	if !ok {
-		return nil, fmt.Errorf("invalid user id")
+		return nil, apierror.Unauthenticated("invalid user id")
 	}
-	if _, err := verifyUserExists(ctx, g.dbmgr, userId); err != nil {
-		return nil, fmt.Errorf("could not verify user exists")
+	user, err := verifyUserExists(ctx, g.dbmgr, userId)
+	if err != nil {
+		return nil, fmt.Errorf("could not verify user exists: %w", err)
 	}
 	// get inputimage from db
-	inputImage, err := g.dbmgr.ReadInputImage(ctx, request.InputImageId)
+	inputImage, err := g.dbmgr.ReadInputImage(ctx, user.OrgId, request.InputImageId)
 	if err != nil {
 		return nil, fmt.Errorf("could not get input image with id: %s, error was %w", request.InputImageId, err)
 	}
 	// get current golf keypoints for associated input image id in db
-	golfKeypoints, err := g.dbmgr.ReadGolfKeypointsForInputImage(ctx, request.InputImageId)
+	golfKeypoints, err := g.dbmgr.ReadGolfKeypointsForInputImage(ctx, user.OrgId, request.InputImageId)
 	if err != nil {
 		return nil, fmt.Errorf("could not read golf keypoints from db for input image: %s, %w", request.InputImageId, err)
 	}
@@ -329,7 +411,7 @@ func (g *GolfKeypointsListener) UpdateBodyKeypoints(ctx context.Context, request
 		golfKeypoints.FaceonGolfSetupPoints = *CalculateFaceOnSetupPoints(ctx, &golfKeypoints.OutputKeypoints, &inputImage.CalibrationInfo)
 	}
 	// update new golf keypoints in db
-	updatedGolfKeypoints, err := g.dbmgr.UpdateGolfKeypointsForInputImage(ctx, request.InputImageId, golfKeypoints)
+	updatedGolfKeypoints, err := g.dbmgr.UpdateGolfKeypointsForInputImage(ctx, user.OrgId, request.InputImageId, golfKeypoints)
 	if err != nil {
 		return nil, fmt.Errorf("could not update golf keypoints from db for input image: %s, %w", request.InputImageId, err)
 	}
@@ -345,13 +427,14 @@ func (g *GolfKeypointsListener) DeleteGolfKeypoints(ctx context.Context, request
 	// make sure user exists
 	userId, ok := ctx.Value(util.UserIdKey).(string)
 	if !ok {
-		return nil, fmt.Errorf("invalid user id")
+		return nil, apierror.Unauthenticated("invalid user id")
 	}
-	if _, err := verifyUserExists(ctx, g.dbmgr, userId); err != nil {
-		return nil, fmt.Errorf("could not verify user exists")
+	user, err := verifyUserExists(ctx, g.dbmgr, userId)
+	if err != nil {
+		return nil, fmt.Errorf("could not verify user exists: %w", err)
 	}
 	// delete golf keypoints for associated input image id in db
-	err := g.dbmgr.DeleteGolfKeypointsForInputImage(ctx, request.InputImageId)
+	err = g.dbmgr.DeleteGolfKeypointsForInputImage(ctx, user.OrgId, request.InputImageId)
 	if err != nil {
 		return nil, fmt.Errorf("could not delete golf keypoints from db for input image: %s, %w", request.InputImageId, err)
 	}
@@ -361,3 +444,190 @@ func (g *GolfKeypointsListener) DeleteGolfKeypoints(ctx context.Context, request
 	}
 	return response, nil
 }
+
+func (g *GolfKeypointsListener) DownloadInputImage(request *skp.DownloadInputImageRequest, stream skp.GolfKeypointsService_DownloadInputImageServer) error {
+	ctx := stream.Context()
+	// make sure user exists
+	userId, ok := ctx.Value(util.UserIdKey).(string)
+	if !ok {
+		return apierror.Unauthenticated("invalid user id")
+	}
+	user, err := verifyUserExists(ctx, g.dbmgr, userId)
+	if err != nil {
+		return fmt.Errorf("could not verify user exists: %w", err)
+	}
+	// get inputimg with inputimgid from db
+	inputImg, err := g.dbmgr.ReadInputImage(ctx, user.OrgId, request.InputImageId)
+	if err != nil {
+		return fmt.Errorf("could not read input image with id: %s: %w", request.InputImageId, err)
+	}
+	return sendImage(inputImg.InputImg, stream.Send)
+}
+
+func (g *GolfKeypointsListener) DownloadCalibrationImage(request *skp.DownloadCalibrationImageRequest, stream skp.GolfKeypointsService_DownloadCalibrationImageServer) error {
+	ctx := stream.Context()
+	// make sure user exists
+	userId, ok := ctx.Value(util.UserIdKey).(string)
+	if !ok {
+		return apierror.Unauthenticated("invalid user id")
+	}
+	user, err := verifyUserExists(ctx, g.dbmgr, userId)
+	if err != nil {
+		return fmt.Errorf("could not verify user exists: %w", err)
+	}
+	// get inputimg with inputimgid from db
+	inputImg, err := g.dbmgr.ReadInputImage(ctx, user.OrgId, request.InputImageId)
+	if err != nil {
+		return fmt.Errorf("could not read input image with id: %s: %w", request.InputImageId, err)
+	}
+	image := inputImg.CalibrationImgAxes
+	if request.CalibrationImage == skp.CalibrationImage_CALIBRATION_IMAGE_VANISHING_POINT {
+		image = inputImg.CalibrationImgVanishingPoint
+	}
+	if len(image) == 0 {
+		return apierror.NotFound("input image %s has no %s image", request.InputImageId, request.CalibrationImage.String())
+	}
+	return sendImage(image, stream.Send)
+}
+
+func (g *GolfKeypointsListener) DownloadOutputImage(request *skp.DownloadOutputImageRequest, stream skp.GolfKeypointsService_DownloadOutputImageServer) error {
+	ctx := stream.Context()
+	// make sure user exists
+	userId, ok := ctx.Value(util.UserIdKey).(string)
+	if !ok {
+		return apierror.Unauthenticated("invalid user id")
+	}
+	user, err := verifyUserExists(ctx, g.dbmgr, userId)
+	if err != nil {
+		return fmt.Errorf("could not verify user exists: %w", err)
+	}
+	// find golf keypoints for associated input image id in db
+	golfKeypoints, err := g.dbmgr.ReadGolfKeypointsForInputImage(ctx, user.OrgId, request.InputImageId)
+	if err != nil {
+		return fmt.Errorf("could not read golf keypoints from db for input image: %s, %w", request.InputImageId, err)
+	}
+	// imported golf keypoints were not drawn by computervision
+	if len(golfKeypoints.OutputImg) == 0 {
+		return apierror.NotFound("golf keypoints for input image %s have no output image", request.InputImageId)
+	}
+	return sendImage(golfKeypoints.OutputImg, stream.Send)
+}
+
+func (g *GolfKeypointsListener) SubmitAnalysis(ctx context.Context, request *skp.SubmitAnalysisRequest) (*skp.SubmitAnalysisResponse, error) {
+	// make sure user exists
+	userId, ok := ctx.Value(util.UserIdKey).(string)
+	if !ok {
+		return nil, apierror.Unauthenticated("invalid user id")
+	}
+	user, err := verifyUserExists(ctx, g.dbmgr, userId)
+	if err != nil {
+		return nil, fmt.Errorf("could not verify user exists: %w", err)
+	}
+	// fail now instead of in the job if the input image does not exist
+	if _, err := g.dbmgr.ReadInputImage(ctx, user.OrgId, request.InputImageId); err != nil {
+		return nil, fmt.Errorf("could not get input image with id: %s, error was %w", request.InputImageId, err)
+	}
+	// queue the job in db
+	job := &db.AnalysisJob{
+		UserId:       userId,
+		OrgId:        user.OrgId,
+		InputImageId: request.InputImageId,
+	}
+	job, err = g.dbmgr.CreateAnalysisJob(ctx, job)
+	if err != nil {
+		return nil, fmt.Errorf("could not queue analysis job: %w", err)
+	}
+	g.analysisWorkers.notify()
+	logger.InfoContext(ctx, "submitted analysis job", "job_id", job.Id.Hex(), "input_image_id", request.InputImageId)
+	// return response
+	response := &skp.SubmitAnalysisResponse{
+		Success: true,
+		JobId:   job.Id.Hex(),
+	}
+	return response, nil
+}
+
+func (g *GolfKeypointsListener) GetAnalysisJob(ctx context.Context, request *skp.GetAnalysisJobRequest) (*skp.GetAnalysisJobResponse, error) {
+	// make sure user exists
+	userId, ok := ctx.Value(util.UserIdKey).(string)
+	if !ok {
+		return nil, apierror.Unauthenticated("invalid user id")
+	}
+	user, err := verifyUserExists(ctx, g.dbmgr, userId)
+	if err != nil {
+		return nil, fmt.Errorf("could not verify user exists: %w", err)
+	}
+	job, err := g.readAnalysisJob(ctx, user.OrgId, request.JobId)
+	if err != nil {
+		return nil, err
+	}
+	// return response
+	response := &skp.GetAnalysisJobResponse{
+		Success: true,
+		Job:     job,
+	}
+	return response, nil
+}
+
+func (g *GolfKeypointsListener) WatchAnalysisJob(request *skp.WatchAnalysisJobRequest, stream skp.GolfKeypointsService_WatchAnalysisJobServer) error {
+	ctx := stream.Context()
+	// make sure user exists
+	userId, ok := ctx.Value(util.UserIdKey).(string)
+	if !ok {
+		return apierror.Unauthenticated("invalid user id")
+	}
+	user, err := verifyUserExists(ctx, g.dbmgr, userId)
+	if err != nil {
+		return fmt.Errorf("could not verify user exists: %w", err)
+	}
+	ticker := time.NewTicker(g.watchInterval)
+	defer ticker.Stop()
+	var sent *skp.AnalysisJob
+	for {
+		job, err := g.readAnalysisJob(ctx, user.OrgId, request.JobId)
+		if err != nil {
+			return err
+		}
+		// updated_at changes with every state change and attempt
+		if sent == nil || !job.UpdatedAt.AsTime().Equal(sent.UpdatedAt.AsTime()) {
+			if err := stream.Send(job); err != nil {
+				return err
+			}
+			sent = job
+		}
+		if job.State == skp.AnalysisJobState_ANALYSIS_JOB_DONE || job.State == skp.AnalysisJobState_ANALYSIS_JOB_FAILED {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Job of the user's organization, with its golf keypoints once it is done
+func (g *GolfKeypointsListener) readAnalysisJob(ctx context.Context, orgId string, jobId string) (*skp.AnalysisJob, error) {
+	job, err := g.dbmgr.ReadAnalysisJob(ctx, orgId, jobId)
+	if err != nil {
+		return nil, fmt.Errorf("could not read analysis job with id: %s: %w", jobId, err)
+	}
+	var golfKeypoints *skp.GolfKeypoints
+	if job.State == skp.AnalysisJobState_ANALYSIS_JOB_DONE {
+		// the golf keypoints can be deleted or recalculated after the job is done, the job then has no result
+		stored, err := g.dbmgr.ReadGolfKeypointsForInputImage(ctx, orgId, job.InputImageId)
+		if err != nil && apierror.Code(err) != codes.NotFound {
+			return nil, fmt.Errorf("could not read golf keypoints from db for input image: %s, %w", job.InputImageId, err)
+		}
+		if stored != nil {
+			golfKeypoints = db.ConvertGolfKeypointsToCVGolfKeypoints(stored)
+		}
+	}
+	return db.ConvertAnalysisJobToCVAnalysisJob(job, golfKeypoints), nil
+}
+
+// Runs a claimed analysis job like CalculateGolfKeypoints for the user that submitted it
+func (g *GolfKeypointsListener) runAnalysisJob(ctx context.Context, job *db.AnalysisJob) error {
+	_, err := g.calculateGolfKeypoints(ctx, job.UserId, job.OrgId, job.InputImageId)
+	return err
+}
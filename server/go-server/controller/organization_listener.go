@@ -0,0 +1,290 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sirfrank96/go-server/apierror"
+	db "github.com/sirfrank96/go-server/db"
+	skp "github.com/sirfrank96/go-server/sports-keypoints-proto"
+	"github.com/sirfrank96/go-server/util"
+)
+
+type OrganizationListener struct {
+	skp.UnimplementedOrganizationServiceServer
+	dbmgr *db.DbManager
+}
+
+func newOrganizationListener(dbmgr *db.DbManager) *OrganizationListener {
+	return &OrganizationListener{
+		dbmgr: dbmgr,
+	}
+}
+
+func (o *OrganizationListener) CreateOrganization(ctx context.Context, request *skp.CreateOrganizationRequest) (*skp.CreateOrganizationResponse, error) {
+	// make sure user exists
+	userId, ok := ctx.Value(util.UserIdKey).(string)
+	if !ok {
+		return nil, apierror.Unauthenticated("invalid user id")
+	}
+	user, err := verifyUserExists(ctx, o.dbmgr, userId)
+	if err != nil {
+		return nil, fmt.Errorf("could not verify user exists: %w", err)
+	}
+	if user.OrgId != "" {
+		return nil, apierror.AlreadyExists("user is already in organization %s", user.OrgId)
+	}
+	// create org and move user into it as an admin
+	org, err := o.dbmgr.CreateOrganization(ctx, &db.Organization{Name: request.Name})
+	if err != nil {
+		return nil, fmt.Errorf("could not store organization in db: %w", err)
+	}
+	if err := o.dbmgr.SetUserOrganization(ctx, userId, org.Id.Hex(), skp.OrganizationRole_ORGANIZATION_ADMIN); err != nil {
+		return nil, fmt.Errorf("could not add user to organization: %w", err)
+	}
+	// return response
+	response := &skp.CreateOrganizationResponse{
+		Success:        true,
+		OrganizationId: org.Id.Hex(),
+	}
+	return response, nil
+}
+
+func (o *OrganizationListener) ReadOrganization(ctx context.Context, request *skp.ReadOrganizationRequest) (*skp.ReadOrganizationResponse, error) {
+	// make sure user exists
+	userId, ok := ctx.Value(util.UserIdKey).(string)
+	if !ok {
+		return nil, apierror.Unauthenticated("invalid user id")
+	}
+	user, err := verifyUserExists(ctx, o.dbmgr, userId)
+	if err != nil {
+		return nil, fmt.Errorf("could not verify user exists: %w", err)
+	}
+	if user.OrgId == "" {
+		return nil, apierror.NotFound("user is not in an organization")
+	}
+	// read user's org from db
+	org, err := o.dbmgr.ReadOrganization(ctx, user.OrgId)
+	if err != nil {
+		return nil, fmt.Errorf("could not read organization: %w", err)
+	}
+	// return response
+	response := &skp.ReadOrganizationResponse{
+		Success: true,
+		Organization: &skp.Organization{
+			OrganizationId: org.Id.Hex(),
+			Name:           org.Name,
+		},
+		Role: user.OrgRole,
+	}
+	return response, nil
+}
+
+func (o *OrganizationListener) ListOrganizationMembers(ctx context.Context, request *skp.ListOrganizationMembersRequest) (*skp.ListOrganizationMembersResponse, error) {
+	// make sure user is an org admin
+	userId, ok := ctx.Value(util.UserIdKey).(string)
+	if !ok {
+		return nil, apierror.Unauthenticated("invalid user id")
+	}
+	admin, err := verifyOrganizationAdmin(ctx, o.dbmgr, userId)
+	if err != nil {
+		return nil, fmt.Errorf("could not verify user is an organization admin: %w", err)
+	}
+	// get all users in org from db
+	users, err := o.dbmgr.ReadUsersForOrganization(ctx, admin.OrgId)
+	if err != nil {
+		return nil, fmt.Errorf("could not get users for organization from db: %w", err)
+	}
+	var members []*skp.OrganizationMember
+	for _, user := range users {
+		members = append(members, &skp.OrganizationMember{
+			UserName: user.Username,
+			Email:    user.Email,
+			Role:     user.OrgRole,
+		})
+	}
+	// return response
+	response := &skp.ListOrganizationMembersResponse{
+		Success: true,
+		Members: members,
+	}
+	return response, nil
+}
+
+func (o *OrganizationListener) InviteOrganizationMember(ctx context.Context, request *skp.InviteOrganizationMemberRequest) (*skp.InviteOrganizationMemberResponse, error) {
+	// make sure user is an org admin
+	userId, ok := ctx.Value(util.UserIdKey).(string)
+	if !ok {
+		return nil, apierror.Unauthenticated("invalid user id")
+	}
+	admin, err := verifyOrganizationAdmin(ctx, o.dbmgr, userId)
+	if err != nil {
+		return nil, fmt.Errorf("could not verify user is an organization admin: %w", err)
+	}
+	// new member can only be in one org, they join once they accept the invitation
+	member, err := o.dbmgr.ReadUserFromUsername(ctx, request.UserName)
+	if err != nil {
+		return nil, fmt.Errorf("could not find user with username: %s, error: %w", request.UserName, err)
+	}
+	if member.OrgId != "" {
+		return nil, apierror.AlreadyExists("user %s is already in an organization", request.UserName)
+	}
+	role := request.Role
+	if role == skp.OrganizationRole_ORGANIZATION_ROLE_UNSPECIFIED {
+		role = skp.OrganizationRole_ORGANIZATION_MEMBER
+	}
+	if err := o.dbmgr.InviteUserToOrganization(ctx, member.Id.Hex(), admin.OrgId, role); err != nil {
+		return nil, fmt.Errorf("could not invite user %s to organization: %w", request.UserName, err)
+	}
+	// return response
+	response := &skp.InviteOrganizationMemberResponse{
+		Success: true,
+	}
+	return response, nil
+}
+
+func (o *OrganizationListener) ListOrganizationInvitations(ctx context.Context, request *skp.ListOrganizationInvitationsRequest) (*skp.ListOrganizationInvitationsResponse, error) {
+	// make sure user exists
+	userId, ok := ctx.Value(util.UserIdKey).(string)
+	if !ok {
+		return nil, apierror.Unauthenticated("invalid user id")
+	}
+	user, err := verifyUserExists(ctx, o.dbmgr, userId)
+	if err != nil {
+		return nil, fmt.Errorf("could not verify user exists: %w", err)
+	}
+	var invitations []*skp.OrganizationInvitation
+	for _, invitation := range user.OrgInvitations {
+		org, err := o.dbmgr.ReadOrganization(ctx, invitation.OrgId)
+		if err != nil {
+			return nil, fmt.Errorf("could not read organization of invitation: %w", err)
+		}
+		invitations = append(invitations, &skp.OrganizationInvitation{
+			OrganizationId: org.Id.Hex(),
+			Name:           org.Name,
+			Role:           invitation.OrgRole,
+		})
+	}
+	// return response
+	response := &skp.ListOrganizationInvitationsResponse{
+		Success:     true,
+		Invitations: invitations,
+	}
+	return response, nil
+}
+
+func (o *OrganizationListener) AcceptOrganizationInvitation(ctx context.Context, request *skp.AcceptOrganizationInvitationRequest) (*skp.AcceptOrganizationInvitationResponse, error) {
+	// make sure user exists
+	userId, ok := ctx.Value(util.UserIdKey).(string)
+	if !ok {
+		return nil, apierror.Unauthenticated("invalid user id")
+	}
+	user, err := verifyUserExists(ctx, o.dbmgr, userId)
+	if err != nil {
+		return nil, fmt.Errorf("could not verify user exists: %w", err)
+	}
+	if user.OrgId != "" {
+		return nil, apierror.AlreadyExists("user is already in organization %s", user.OrgId)
+	}
+	// join org, user's images move into it
+	if _, err := o.dbmgr.AcceptOrganizationInvitation(ctx, userId, request.OrganizationId); err != nil {
+		return nil, fmt.Errorf("could not accept invitation to organization %s: %w", request.OrganizationId, err)
+	}
+	// return response
+	response := &skp.AcceptOrganizationInvitationResponse{
+		Success: true,
+	}
+	return response, nil
+}
+
+func (o *OrganizationListener) DeclineOrganizationInvitation(ctx context.Context, request *skp.DeclineOrganizationInvitationRequest) (*skp.DeclineOrganizationInvitationResponse, error) {
+	// make sure user exists
+	userId, ok := ctx.Value(util.UserIdKey).(string)
+	if !ok {
+		return nil, apierror.Unauthenticated("invalid user id")
+	}
+	if _, err := verifyUserExists(ctx, o.dbmgr, userId); err != nil {
+		return nil, fmt.Errorf("could not verify user exists: %w", err)
+	}
+	if err := o.dbmgr.DeleteOrganizationInvitation(ctx, userId, request.OrganizationId); err != nil {
+		return nil, fmt.Errorf("could not decline invitation to organization %s: %w", request.OrganizationId, err)
+	}
+	// return response
+	response := &skp.DeclineOrganizationInvitationResponse{
+		Success: true,
+	}
+	return response, nil
+}
+
+func (o *OrganizationListener) UpdateOrganizationMemberRole(ctx context.Context, request *skp.UpdateOrganizationMemberRoleRequest) (*skp.UpdateOrganizationMemberRoleResponse, error) {
+	// make sure user is an org admin
+	userId, ok := ctx.Value(util.UserIdKey).(string)
+	if !ok {
+		return nil, apierror.Unauthenticated("invalid user id")
+	}
+	admin, err := verifyOrganizationAdmin(ctx, o.dbmgr, userId)
+	if err != nil {
+		return nil, fmt.Errorf("could not verify user is an organization admin: %w", err)
+	}
+	member, err := o.readOrganizationMember(ctx, admin.OrgId, request.UserName)
+	if err != nil {
+		return nil, err
+	}
+	// an organization can not be left without an admin
+	if member.OrgRole == skp.OrganizationRole_ORGANIZATION_ADMIN && request.Role != skp.OrganizationRole_ORGANIZATION_ADMIN {
+		if err := verifyNotLastOrganizationAdmin(ctx, o.dbmgr, admin.OrgId); err != nil {
+			return nil, err
+		}
+	}
+	if err := o.dbmgr.SetUserOrganization(ctx, member.Id.Hex(), admin.OrgId, request.Role); err != nil {
+		return nil, fmt.Errorf("could not update role for user %s: %w", request.UserName, err)
+	}
+	// return response
+	response := &skp.UpdateOrganizationMemberRoleResponse{
+		Success: true,
+	}
+	return response, nil
+}
+
+func (o *OrganizationListener) RemoveOrganizationMember(ctx context.Context, request *skp.RemoveOrganizationMemberRequest) (*skp.RemoveOrganizationMemberResponse, error) {
+	// make sure user is an org admin
+	userId, ok := ctx.Value(util.UserIdKey).(string)
+	if !ok {
+		return nil, apierror.Unauthenticated("invalid user id")
+	}
+	admin, err := verifyOrganizationAdmin(ctx, o.dbmgr, userId)
+	if err != nil {
+		return nil, fmt.Errorf("could not verify user is an organization admin: %w", err)
+	}
+	member, err := o.readOrganizationMember(ctx, admin.OrgId, request.UserName)
+	if err != nil {
+		return nil, err
+	}
+	// an organization can not be left without an admin
+	if member.OrgRole == skp.OrganizationRole_ORGANIZATION_ADMIN {
+		if err := verifyNotLastOrganizationAdmin(ctx, o.dbmgr, admin.OrgId); err != nil {
+			return nil, err
+		}
+	}
+	// move member and their images back to the default tenant
+	if err := o.dbmgr.SetUserOrganization(ctx, member.Id.Hex(), "", skp.OrganizationRole_ORGANIZATION_ROLE_UNSPECIFIED); err != nil {
+		return nil, fmt.Errorf("could not remove user %s from organization: %w", request.UserName, err)
+	}
+	// return response
+	response := &skp.RemoveOrganizationMemberResponse{
+		Success: true,
+	}
+	return response, nil
+}
+
+// Only returns the user if they are in the given org, so admins can not manage users in other orgs
+func (o *OrganizationListener) readOrganizationMember(ctx context.Context, orgId string, userName string) (*db.User, error) {
+	member, err := o.dbmgr.ReadUserFromUsername(ctx, userName)
+	if err != nil {
+		return nil, fmt.Errorf("could not find user with username: %s, error: %w", userName, err)
+	}
+	if member.OrgId != orgId {
+		return nil, apierror.NotFound("user %s is not in organization %s", userName, orgId)
+	}
+	return member, nil
+}
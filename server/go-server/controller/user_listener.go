@@ -3,31 +3,56 @@ package controller
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
+	"github.com/sirfrank96/go-server/apierror"
 	cvclient "github.com/sirfrank96/go-server/cv-client"
 	db "github.com/sirfrank96/go-server/db"
+	"github.com/sirfrank96/go-server/oidc"
 	skp "github.com/sirfrank96/go-server/sports-keypoints-proto"
 	"github.com/sirfrank96/go-server/util"
+
+	"google.golang.org/grpc/codes"
+)
+
+// PreconditionFailure types for two-factor authentication state
+const (
+	totpEnabled     = "TOTP_ENABLED"
+	totpNotEnabled  = "TOTP_NOT_ENABLED"
+	totpNotEnrolled = "TOTP_NOT_ENROLLED"
+)
+
+// Wrong totp or recovery codes a user can enter before they are locked out, challenge tokens handed out before the
+// lockout can not be used afterwards
+const (
+	maxTotpFailedAttempts = 5
+	totpLockoutDuration   = 15 * time.Minute
 )
 
 type UserListener struct {
 	skp.UnimplementedUserServiceServer
-	cvmgr *cvclient.CvClientManager
-	dbmgr *db.DbManager
+	cvmgr   *cvclient.CvClientManager
+	dbmgr   *db.DbManager
+	oidcmgr *oidc.OidcManager
 }
 
-func newUserListener(cvmgr *cvclient.CvClientManager, dbmgr *db.DbManager) *UserListener {
+func newUserListener(cvmgr *cvclient.CvClientManager, dbmgr *db.DbManager, oidcmgr *oidc.OidcManager) *UserListener {
 	return &UserListener{
-		cvmgr: cvmgr,
-		dbmgr: dbmgr,
+		cvmgr:   cvmgr,
+		dbmgr:   dbmgr,
+		oidcmgr: oidcmgr,
 	}
 }
 
 func (u *UserListener) CreateUser(ctx context.Context, request *skp.CreateUserRequest) (*skp.CreateUserResponse, error) {
 	// check if user exists already
 	user, err := u.dbmgr.ReadUserFromUsername(ctx, request.UserName)
-	if user != nil && err == nil {
-		return nil, fmt.Errorf("user with username: %s already exists", request.UserName)
+	if err == nil {
+		return nil, apierror.AlreadyExists("user with username: %s already exists", request.UserName)
+	}
+	if apierror.Code(err) != codes.NotFound {
+		return nil, fmt.Errorf("could not check if user exists: %w", err)
 	}
 	hashedPassword, err := db.HashPassword(request.Password)
 	if err != nil {
@@ -50,31 +75,161 @@ func (u *UserListener) CreateUser(ctx context.Context, request *skp.CreateUserRe
 
 func (u *UserListener) RegisterUser(ctx context.Context, request *skp.RegisterUserRequest) (*skp.RegisterUserResponse, error) {
 	user, err := u.dbmgr.ReadUserFromUsername(ctx, request.UserName)
+	if apierror.Code(err) == codes.NotFound {
+		return nil, apierror.Unauthenticated("could not find user with username: %s", request.UserName)
+	}
 	if err != nil {
-		return nil, fmt.Errorf("could not fine user with username: %s, error: %w", request.UserName, err)
+		return nil, fmt.Errorf("could not read user: %w", err)
 	}
 	if !db.VerifyPasswordHash(user.Password, request.Password) {
-		return nil, fmt.Errorf("passwords do not match, could not register user")
+		return nil, apierror.Unauthenticated("passwords do not match, could not register user")
 	}
-	sessionToken, err := util.CreateJWTSessionToken(user.Id.Hex())
+	if user.Disabled {
+		return nil, apierror.PermissionDenied("user %s is disabled", user.Username).WithReason(accountDisabled)
+	}
+	sessionToken, challengeToken, err := getLoginTokens(user)
 	if err != nil {
-		return nil, fmt.Errorf("could not create session token from id: %w", err)
+		return nil, err
 	}
 	response := &skp.RegisterUserResponse{
-		Success:      true,
-		SessionToken: sessionToken,
+		Success:               true,
+		SessionToken:          sessionToken,
+		TotpRequired:          challengeToken != "",
+		TotpChallengeToken:    challengeToken,
+		PasswordResetRequired: user.PasswordResetRequired,
 	}
 	return response, nil
 }
 
-func (u *UserListener) ReadUser(ctx context.Context, request *skp.ReadUserRequest) (*skp.User, error) {
+func (u *UserListener) LoginWithOidc(ctx context.Context, request *skp.LoginWithOidcRequest) (*skp.LoginWithOidcResponse, error) {
+	// validate external identity with the provider
+	identity, err := getOidcIdentity(ctx, u.oidcmgr, request.Provider, request.AuthorizationCode, request.RedirectUri, request.CodeVerifier, request.IdToken, request.Nonce)
+	if err != nil {
+		return nil, err
+	}
+	// find user linked to external identity, or create one if provider is configured to
+	createdUser := false
+	user, err := u.dbmgr.ReadUserFromExternalIdentity(ctx, identity.Issuer, identity.Subject)
+	if err != nil && apierror.Code(err) != codes.NotFound {
+		return nil, fmt.Errorf("could not read user linked to %s identity: %w", request.Provider, err)
+	}
+	if err != nil {
+		providerConfig, err := u.oidcmgr.GetProviderConfig(request.Provider)
+		if err != nil {
+			return nil, err
+		}
+		if !providerConfig.CreateUsers {
+			return nil, apierror.FailedPrecondition("OIDC_IDENTITY_NOT_LINKED", request.Provider, "no user is linked to this %s identity, log in with a password and call LinkOidcIdentity first", request.Provider)
+		}
+		user, err = u.createUserForExternalIdentity(ctx, identity)
+		if err != nil {
+			return nil, err
+		}
+		createdUser = true
+	}
+	if user.Disabled {
+		return nil, apierror.PermissionDenied("user %s is disabled", user.Username).WithReason(accountDisabled)
+	}
+	sessionToken, challengeToken, err := getLoginTokens(user)
+	if err != nil {
+		return nil, err
+	}
+	// return response
+	response := &skp.LoginWithOidcResponse{
+		Success:               true,
+		SessionToken:          sessionToken,
+		TotpRequired:          challengeToken != "",
+		TotpChallengeToken:    challengeToken,
+		CreatedUser:           createdUser,
+		PasswordResetRequired: user.PasswordResetRequired,
+	}
+	return response, nil
+}
+
+func (u *UserListener) LinkOidcIdentity(ctx context.Context, request *skp.LinkOidcIdentityRequest) (*skp.LinkOidcIdentityResponse, error) {
 	// make sure user exists
 	userId, ok := ctx.Value(util.UserIdKey).(string)
 	if !ok {
-		return nil, fmt.Errorf("invalid user id")
+		return nil, apierror.Unauthenticated("invalid user id")
 	}
 	if _, err := verifyUserExists(ctx, u.dbmgr, userId); err != nil {
-		return nil, fmt.Errorf("could not verify user exists")
+		return nil, fmt.Errorf("could not verify user exists: %w", err)
+	}
+	// validate external identity with the provider
+	identity, err := getOidcIdentity(ctx, u.oidcmgr, request.Provider, request.AuthorizationCode, request.RedirectUri, request.CodeVerifier, request.IdToken, request.Nonce)
+	if err != nil {
+		return nil, err
+	}
+	// link external identity to user in db
+	externalIdentity := &db.ExternalIdentity{
+		Provider: identity.Provider,
+		Issuer:   identity.Issuer,
+		Subject:  identity.Subject,
+	}
+	if _, err := u.dbmgr.AddUserExternalIdentity(ctx, userId, externalIdentity); err != nil {
+		return nil, fmt.Errorf("could not link %s identity: %w", request.Provider, err)
+	}
+	// return response
+	response := &skp.LinkOidcIdentityResponse{
+		Success: true,
+	}
+	return response, nil
+}
+
+// Creates a user without a password for an external identity, username is taken from the id token if it is not in use
+func (u *UserListener) createUserForExternalIdentity(ctx context.Context, identity *oidc.Identity) (*db.User, error) {
+	baseUsername := identity.PreferredUsername
+	if baseUsername == "" && identity.EmailVerified {
+		baseUsername = strings.Split(identity.Email, "@")[0]
+	}
+	if baseUsername == "" {
+		baseUsername = identity.Provider + "-user"
+	}
+	username := ""
+	for i := 0; i < 100; i++ {
+		candidate := baseUsername
+		if i > 0 {
+			candidate = fmt.Sprintf("%s%d", baseUsername, i)
+		}
+		_, err := u.dbmgr.ReadUserFromUsername(ctx, candidate)
+		if apierror.Code(err) == codes.NotFound {
+			username = candidate
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("could not check if username %s is in use: %w", candidate, err)
+		}
+	}
+	if username == "" {
+		return nil, fmt.Errorf("could not find an unused username for %s", baseUsername)
+	}
+	user := &db.User{
+		Username: username,
+		ExternalIdentities: []db.ExternalIdentity{{
+			Provider: identity.Provider,
+			Issuer:   identity.Issuer,
+			Subject:  identity.Subject,
+		}},
+	}
+	if identity.EmailVerified {
+		user.Email = identity.Email
+	}
+	user, err := u.dbmgr.CreateUser(ctx, user)
+	if err != nil {
+		return nil, fmt.Errorf("could not store user in db: %w", err)
+	}
+	return user, nil
+}
+
+func (u *UserListener) ReadUser(ctx context.Context, request *skp.ReadUserRequest) (*skp.User, error) {
+	// make sure user exists
+	userId, ok := ctx.Value(util.UserIdKey).(string)
+	if !ok {
+		return nil, apierror.Unauthenticated("invalid user id")
+	}
+	// users that have to reset their password can still read their user
+	if _, err := verifyUserActive(ctx, u.dbmgr, userId); err != nil {
+		return nil, fmt.Errorf("could not verify user exists: %w", err)
 	}
 	// find user with associated user id in db
 	user, err := u.dbmgr.ReadUser(ctx, userId)
@@ -83,8 +238,12 @@ func (u *UserListener) ReadUser(ctx context.Context, request *skp.ReadUserReques
 	}
 	// return response
 	response := &skp.User{
-		UserName: user.Username,
-		Email:    user.Email,
+		UserName:              user.Username,
+		Email:                 user.Email,
+		OrganizationId:        user.OrgId,
+		OrganizationRole:      user.OrgRole,
+		Role:                  user.Role,
+		PasswordResetRequired: user.PasswordResetRequired,
 	}
 	return response, nil
 }
@@ -93,10 +252,11 @@ func (u *UserListener) UpdateUser(ctx context.Context, request *skp.UpdateUserRe
 	// make sure user exists
 	userId, ok := ctx.Value(util.UserIdKey).(string)
 	if !ok {
-		return nil, fmt.Errorf("invalid user id")
+		return nil, apierror.Unauthenticated("invalid user id")
 	}
-	if _, err := verifyUserExists(ctx, u.dbmgr, userId); err != nil {
-		return nil, fmt.Errorf("could not verify user exists")
+	// users that have to reset their password do it here
+	if _, err := verifyUserActive(ctx, u.dbmgr, userId); err != nil {
+		return nil, fmt.Errorf("could not verify user exists: %w", err)
 	}
 	// find user with associated user id in db
 	currUser, err := u.dbmgr.ReadUser(ctx, userId)
@@ -108,19 +268,26 @@ func (u *UserListener) UpdateUser(ctx context.Context, request *skp.UpdateUserRe
 	if request.Password != "" {
 		newPassword, err = db.HashPassword(request.Password)
 		if err != nil {
-			return nil, fmt.Errorf("could not hash new password")
+			return nil, apierror.Internal(err, "could not hash new password")
 		}
 	}
 	newUser := &db.User{Username: request.UserName, Password: newPassword, Email: request.Email}
 	updatedFieldsUser := db.UpdateUserFields(currUser, newUser)
+	if newPassword != "" {
+		updatedFieldsUser.PasswordResetRequired = false
+	}
 	updatedUser, err := u.dbmgr.UpdateUser(ctx, userId, updatedFieldsUser)
 	if err != nil {
 		return nil, fmt.Errorf("could not update user in db: %w", err)
 	}
 	// return response
 	response := &skp.User{
-		UserName: updatedUser.Username,
-		Email:    updatedUser.Email,
+		UserName:              updatedUser.Username,
+		Email:                 updatedUser.Email,
+		OrganizationId:        updatedUser.OrgId,
+		OrganizationRole:      updatedUser.OrgRole,
+		Role:                  updatedUser.Role,
+		PasswordResetRequired: updatedUser.PasswordResetRequired,
 	}
 	return response, nil
 }
@@ -129,13 +296,20 @@ func (u *UserListener) DeleteUser(ctx context.Context, request *skp.DeleteUserRe
 	// make sure user exists
 	userId, ok := ctx.Value(util.UserIdKey).(string)
 	if !ok {
-		return nil, fmt.Errorf("invalid user id")
+		return nil, apierror.Unauthenticated("invalid user id")
 	}
-	if _, err := verifyUserExists(ctx, u.dbmgr, userId); err != nil {
-		return nil, fmt.Errorf("could not verify user exists")
+	user, err := verifyUserExists(ctx, u.dbmgr, userId)
+	if err != nil {
+		return nil, fmt.Errorf("could not verify user exists: %w", err)
+	}
+	// an organization can not be left without an admin
+	if user.OrgRole == skp.OrganizationRole_ORGANIZATION_ADMIN {
+		if err := verifyNotLastOrganizationAdmin(ctx, u.dbmgr, user.OrgId); err != nil {
+			return nil, err
+		}
 	}
 	// delete user with associated user id in db
-	err := u.dbmgr.DeleteUser(ctx, userId)
+	err = u.dbmgr.DeleteUser(ctx, user.OrgId, userId)
 	if err != nil {
 		return nil, fmt.Errorf("could not delete user: %w", err)
 	}
@@ -145,3 +319,211 @@ func (u *UserListener) DeleteUser(ctx context.Context, request *skp.DeleteUserRe
 	}
 	return response, nil
 }
+
+func (u *UserListener) EnrollTotp(ctx context.Context, request *skp.EnrollTotpRequest) (*skp.EnrollTotpResponse, error) {
+	// make sure user exists
+	userId, ok := ctx.Value(util.UserIdKey).(string)
+	if !ok {
+		return nil, apierror.Unauthenticated("invalid user id")
+	}
+	user, err := verifyUserExists(ctx, u.dbmgr, userId)
+	if err != nil {
+		return nil, fmt.Errorf("could not verify user exists: %w", err)
+	}
+	if user.Totp.Enabled {
+		return nil, apierror.FailedPrecondition(totpEnabled, "totp", "two-factor authentication is already enabled, disable it before enrolling again")
+	}
+	// store new secret, not enabled until confirmed with a code from the authenticator app
+	secret, err := util.GenerateTotpSecret()
+	if err != nil {
+		return nil, err
+	}
+	if _, err = u.dbmgr.UpdateUserTotp(ctx, userId, &db.UserTotp{Secret: secret}); err != nil {
+		return nil, fmt.Errorf("could not store totp secret in db: %w", err)
+	}
+	// return response
+	response := &skp.EnrollTotpResponse{
+		Success:         true,
+		Secret:          secret,
+		ProvisioningUri: util.GetTotpProvisioningURI(user.Username, secret),
+	}
+	return response, nil
+}
+
+func (u *UserListener) ConfirmTotp(ctx context.Context, request *skp.ConfirmTotpRequest) (*skp.ConfirmTotpResponse, error) {
+	// make sure user exists
+	userId, ok := ctx.Value(util.UserIdKey).(string)
+	if !ok {
+		return nil, apierror.Unauthenticated("invalid user id")
+	}
+	user, err := verifyUserExists(ctx, u.dbmgr, userId)
+	if err != nil {
+		return nil, fmt.Errorf("could not verify user exists: %w", err)
+	}
+	if user.Totp.Secret == "" {
+		return nil, apierror.FailedPrecondition(totpNotEnrolled, "totp", "two-factor authentication has not been enrolled, call EnrollTotp first")
+	}
+	if user.Totp.Enabled {
+		return nil, apierror.FailedPrecondition(totpEnabled, "totp", "two-factor authentication is already enabled")
+	}
+	step, ok := util.VerifyTotpCode(user.Totp.Secret, request.Code, time.Now())
+	if !ok {
+		return nil, apierror.InvalidArgument("code", "invalid totp code")
+	}
+	// enable totp and store hashes of recovery codes
+	recoveryCodes, err := util.GenerateRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+	var hashedRecoveryCodes []string
+	for _, recoveryCode := range recoveryCodes {
+		hashedRecoveryCodes = append(hashedRecoveryCodes, db.HashRecoveryCode(recoveryCode))
+	}
+	totp := &db.UserTotp{
+		Secret:        user.Totp.Secret,
+		Enabled:       true,
+		RecoveryCodes: hashedRecoveryCodes,
+		LastUsedStep:  step,
+	}
+	if _, err = u.dbmgr.UpdateUserTotp(ctx, userId, totp); err != nil {
+		return nil, fmt.Errorf("could not enable totp in db: %w", err)
+	}
+	// return response
+	response := &skp.ConfirmTotpResponse{
+		Success:       true,
+		RecoveryCodes: recoveryCodes,
+	}
+	return response, nil
+}
+
+func (u *UserListener) DisableTotp(ctx context.Context, request *skp.DisableTotpRequest) (*skp.DisableTotpResponse, error) {
+	// make sure user exists
+	userId, ok := ctx.Value(util.UserIdKey).(string)
+	if !ok {
+		return nil, apierror.Unauthenticated("invalid user id")
+	}
+	user, err := verifyUserExists(ctx, u.dbmgr, userId)
+	if err != nil {
+		return nil, fmt.Errorf("could not verify user exists: %w", err)
+	}
+	if !user.Totp.Enabled {
+		return nil, apierror.FailedPrecondition(totpNotEnabled, "totp", "two-factor authentication is not enabled")
+	}
+	if ok, err := u.useTotpOrRecoveryCode(ctx, user, request.Code, time.Time{}); err != nil {
+		return nil, err
+	} else if !ok {
+		return nil, apierror.InvalidArgument("code", "invalid totp or recovery code")
+	}
+	// clear secret and recovery codes
+	if _, err = u.dbmgr.UpdateUserTotp(ctx, userId, &db.UserTotp{}); err != nil {
+		return nil, fmt.Errorf("could not disable totp in db: %w", err)
+	}
+	// return response
+	response := &skp.DisableTotpResponse{
+		Success: true,
+	}
+	return response, nil
+}
+
+func (u *UserListener) VerifyTotp(ctx context.Context, request *skp.VerifyTotpRequest) (*skp.VerifyTotpResponse, error) {
+	// get user id from challenge token handed out by RegisterUser
+	claims, err := util.VerifyJWTTotpChallengeToken(request.TotpChallengeToken)
+	if err != nil {
+		return nil, apierror.Unauthenticated("could not verify totp challenge token: %s", err.Error())
+	}
+	userId, err := util.GetUserIdFromClaims(claims)
+	if err != nil {
+		return nil, apierror.Unauthenticated("could not verify totp challenge token: %s", err.Error())
+	}
+	// users that have to reset their password still need a session token to do it
+	user, err := verifyUserActive(ctx, u.dbmgr, userId)
+	if err != nil {
+		return nil, fmt.Errorf("could not verify user exists: %w", err)
+	}
+	if !user.Totp.Enabled {
+		return nil, apierror.FailedPrecondition(totpNotEnabled, "totp", "two-factor authentication is not enabled")
+	}
+	if ok, err := u.useTotpOrRecoveryCode(ctx, user, request.Code, time.Unix(util.GetIssuedAtFromClaims(claims), 0)); err != nil {
+		return nil, err
+	} else if !ok {
+		return nil, apierror.Unauthenticated("invalid totp or recovery code")
+	}
+	sessionToken, err := util.CreateJWTSessionToken(userId)
+	if err != nil {
+		return nil, fmt.Errorf("could not create session token from id: %w", err)
+	}
+	// return response
+	response := &skp.VerifyTotpResponse{
+		Success:               true,
+		SessionToken:          sessionToken,
+		PasswordResetRequired: user.PasswordResetRequired,
+	}
+	return response, nil
+}
+
+// Returns a session token, or a totp challenge token if the user has two-factor authentication enabled
+func getLoginTokens(user *db.User) (string, string, error) {
+	if user.Totp.Enabled {
+		challengeToken, err := util.CreateJWTTotpChallengeToken(user.Id.Hex())
+		if err != nil {
+			return "", "", fmt.Errorf("could not create totp challenge token from id: %w", err)
+		}
+		return "", challengeToken, nil
+	}
+	sessionToken, err := util.CreateJWTSessionToken(user.Id.Hex())
+	if err != nil {
+		return "", "", fmt.Errorf("could not create session token from id: %w", err)
+	}
+	return sessionToken, "", nil
+}
+
+func getOidcIdentity(ctx context.Context, oidcmgr *oidc.OidcManager, provider string, authorizationCode string, redirectUri string, codeVerifier string, idToken string, nonce string) (*oidc.Identity, error) {
+	var identity *oidc.Identity
+	var err error
+	if authorizationCode != "" {
+		identity, err = oidcmgr.LoginWithAuthorizationCode(ctx, provider, authorizationCode, redirectUri, codeVerifier, nonce)
+	} else {
+		identity, err = oidcmgr.LoginWithIdToken(ctx, provider, idToken, nonce)
+	}
+	if err != nil {
+		return nil, apierror.Unauthenticated("could not log in with %s: %s", provider, err.Error())
+	}
+	return identity, nil
+}
+
+// Checks code against the user's totp secret and recovery codes and uses it up, so the same code is never accepted twice
+// (even by concurrent requests). Wrong codes count towards a lockout, and once a user is locked out every code is rejected
+// along with challenge tokens issued before the lockout. challengeIssuedAt is zero if the code was not sent with a
+// challenge token. Returns false if the code is wrong or was already used.
+func (u *UserListener) useTotpOrRecoveryCode(ctx context.Context, user *db.User, code string, challengeIssuedAt time.Time) (bool, error) {
+	userId := user.Id.Hex()
+	if !user.Totp.LockedUntil.IsZero() {
+		if retryDelay := time.Until(user.Totp.LockedUntil); retryDelay > 0 {
+			return false, apierror.ResourceExhausted(retryDelay, "too many invalid totp codes, try again later")
+		}
+		if !challengeIssuedAt.IsZero() && !challengeIssuedAt.After(user.Totp.LockedUntil.Add(-totpLockoutDuration)) {
+			return false, apierror.Unauthenticated("totp challenge token was revoked, please log in again")
+		}
+	}
+	used := false
+	var err error
+	if step, ok := util.VerifyTotpCode(user.Totp.Secret, code, time.Now()); ok {
+		used, err = u.dbmgr.UseTotpStep(ctx, userId, step)
+	} else {
+		used, err = u.dbmgr.UseTotpRecoveryCode(ctx, userId, db.HashRecoveryCode(code))
+	}
+	if err != nil {
+		return false, fmt.Errorf("could not use totp code: %w", err)
+	}
+	if used {
+		return true, nil
+	}
+	lockedUntil, err := u.dbmgr.RecordTotpFailure(ctx, userId, maxTotpFailedAttempts, totpLockoutDuration)
+	if err != nil {
+		return false, fmt.Errorf("could not record invalid totp code: %w", err)
+	}
+	if !lockedUntil.IsZero() {
+		return false, apierror.ResourceExhausted(totpLockoutDuration, "too many invalid totp codes, try again later")
+	}
+	return false, nil
+}
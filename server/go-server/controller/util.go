@@ -2,15 +2,135 @@ package controller
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"slices"
+	"strings"
 
+	"github.com/sirfrank96/go-server/apierror"
 	db "github.com/sirfrank96/go-server/db"
+	"github.com/sirfrank96/go-server/metrics"
+	skp "github.com/sirfrank96/go-server/sports-keypoints-proto"
+	"github.com/sirfrank96/go-server/util"
 )
 
+// size of the chunks images are downloaded in
+const imageChunkSize = 64 << 10
+
+const (
+	// PreconditionFailure type while a user has to set a new password after an admin reset it
+	passwordResetRequired = "PASSWORD_RESET_REQUIRED"
+	// ErrorInfo reason for users an admin disabled
+	accountDisabled = "ACCOUNT_DISABLED"
+)
+
+// Returns the user if they exist, are not disabled, the session was not revoked and they do not have to reset their password
 func verifyUserExists(ctx context.Context, dbmgr *db.DbManager, userId string) (*db.User, error) {
+	user, err := verifyUserActive(ctx, dbmgr, userId)
+	if err != nil {
+		return nil, err
+	}
+	if user.PasswordResetRequired {
+		return nil, apierror.FailedPrecondition(passwordResetRequired, "password", "please set a new password with UpdateUser")
+	}
+	return user, nil
+}
+
+// Like verifyUserExists, but allows users that still have to reset their password (eg. so they can call UpdateUser)
+func verifyUserActive(ctx context.Context, dbmgr *db.DbManager, userId string) (*db.User, error) {
 	user, err := dbmgr.ReadUser(ctx, userId)
 	if err != nil {
 		return nil, fmt.Errorf("could not find user %s: %w", userId, err)
 	}
+	if user.Disabled {
+		return nil, apierror.PermissionDenied("user %s is disabled", userId).WithReason(accountDisabled)
+	}
+	// session tokens issued before the user was disabled or their password was reset are revoked
+	if issuedAt, ok := ctx.Value(util.IssuedAtKey).(int64); ok && issuedAt < user.SessionsValidAfter.Unix() {
+		return nil, apierror.Unauthenticated("session token was revoked, please log in again")
+	}
+	return user, nil
+}
+
+// Returns the user if they have the admin role or are listed in auth.admin_user_ids
+func verifyAdmin(ctx context.Context, dbmgr *db.DbManager, userId string, adminUserIds []string) (*db.User, error) {
+	user, err := verifyUserExists(ctx, dbmgr, userId)
+	if err != nil {
+		return nil, err
+	}
+	if user.Role != skp.UserRole_USER_ROLE_ADMIN && !slices.Contains(adminUserIds, user.Id.Hex()) {
+		return nil, apierror.PermissionDenied("user %s is not an admin", userId)
+	}
+	return user, nil
+}
+
+// User ids from the comma separated auth.admin_user_ids setting
+func parseAdminUserIds(adminUserIds string) []string {
+	var res []string
+	for _, userId := range strings.Split(adminUserIds, ",") {
+		if userId = strings.TrimSpace(userId); userId != "" {
+			res = append(res, userId)
+		}
+	}
+	return res
+}
+
+// Returns the user if they are an admin of their organization
+func verifyOrganizationAdmin(ctx context.Context, dbmgr *db.DbManager, userId string) (*db.User, error) {
+	user, err := verifyUserExists(ctx, dbmgr, userId)
+	if err != nil {
+		return nil, err
+	}
+	if user.OrgId == "" {
+		return nil, apierror.PermissionDenied("user %s is not in an organization", userId)
+	}
+	if user.OrgRole != skp.OrganizationRole_ORGANIZATION_ADMIN {
+		return nil, apierror.PermissionDenied("user %s is not an admin of organization %s", userId, user.OrgId)
+	}
 	return user, nil
 }
+
+func verifyNotLastOrganizationAdmin(ctx context.Context, dbmgr *db.DbManager, orgId string) error {
+	numAdmins, err := dbmgr.CountOrganizationAdmins(ctx, orgId)
+	if err != nil {
+		return err
+	}
+	if numAdmins <= 1 {
+		return apierror.FailedPrecondition("LAST_ORGANIZATION_ADMIN", orgId, "organization %s must have at least one admin", orgId)
+	}
+	return nil
+}
+
+// Sends the size and checksum of the image first, then the image in chunks
+func sendImage(image []byte, send func(*skp.DownloadImageResponse) error) error {
+	sum := sha256.Sum256(image)
+	info := &skp.ImageInfo{Size: int64(len(image)), Sha256: hex.EncodeToString(sum[:])}
+	if err := send(&skp.DownloadImageResponse{Data: &skp.DownloadImageResponse_Info{Info: info}}); err != nil {
+		return fmt.Errorf("could not send image info: %w", err)
+	}
+	for start := 0; start < len(image); start += imageChunkSize {
+		end := min(start+imageChunkSize, len(image))
+		if err := send(&skp.DownloadImageResponse{Data: &skp.DownloadImageResponse_Chunk{Chunk: image[start:end]}}); err != nil {
+			return fmt.Errorf("could not send image chunk: %w", err)
+		}
+	}
+	return nil
+}
+
+// Message of a setup point's warning, empty if there is none
+func warningMessage(warning util.Warning) string {
+	if warning == nil {
+		return ""
+	}
+	return warning.Error()
+}
+
+// Counts the warnings of one setup points calculation by setup point and severity, setup points without a warning are nil
+func recordSetupPointWarnings(warnings map[string]util.Warning) {
+	for metricName, warning := range warnings {
+		if warning != nil {
+			metrics.IncMetricWarning(metricName, warning.GetSeverity())
+		}
+	}
+}
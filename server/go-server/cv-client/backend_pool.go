@@ -0,0 +1,318 @@
+package cvclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirfrank96/go-server/metrics"
+	skp "github.com/sirfrank96/go-server/sports-keypoints-proto"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/connectivity"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+// addresses starting with this are resolved to every address of the host, eg. dns:///computervision:50051
+const dnsScheme = "dns:///"
+
+// One computervision server, calls are only sent to it while it is healthy and not ejected
+type backend struct {
+	address string
+	conn    *grpc.ClientConn
+	client  skp.ComputerVisionServiceClient
+	// set by the health probes, new backends are unhealthy until their first probe passes
+	healthy bool
+	// calls that were sent to the backend and have not finished
+	outstanding         int
+	consecutiveFailures int
+	ejectedUntil        time.Time
+	// no longer returned by dns, closed once its outstanding calls finish
+	removed bool
+}
+
+// Spreads computervision calls over the backends with the fewest outstanding calls. A backend gets at most
+// maxConcurrent calls at once (0 is unlimited), callers wait for a free slot. Backends that fail ejectAfterFailures
+// calls in a row are ejected for ejectionDuration.
+type backendPool struct {
+	mutex    sync.Mutex
+	backends []*backend
+	// comma separated addresses from computervision.address
+	address            string
+	maxConcurrent      int
+	ejectAfterFailures int
+	ejectionDuration   time.Duration
+	dial               func(address string) (*grpc.ClientConn, error)
+	lookupHost         func(ctx context.Context, host string) ([]string, error)
+	now                func() time.Time
+	// closed and replaced whenever a slot frees up or a backend becomes usable
+	changed chan struct{}
+}
+
+func newBackendPool(address string, maxConcurrent int, ejectAfterFailures int, ejectionDuration time.Duration, dial func(address string) (*grpc.ClientConn, error)) *backendPool {
+	return &backendPool{
+		address:            address,
+		maxConcurrent:      maxConcurrent,
+		ejectAfterFailures: ejectAfterFailures,
+		ejectionDuration:   ejectionDuration,
+		dial:               dial,
+		lookupHost:         net.DefaultResolver.LookupHost,
+		now:                time.Now,
+		changed:            make(chan struct{}),
+	}
+}
+
+// Resolves the addresses and connects to new backends, backends that are no longer returned are removed.
+// If a dns name can not be resolved its current backends are kept.
+func (p *backendPool) refresh(ctx context.Context) error {
+	var addresses []string
+	var errs []error
+	for _, address := range strings.Split(p.address, ",") {
+		address = strings.TrimSpace(address)
+		if address == "" {
+			continue
+		}
+		if !strings.HasPrefix(address, dnsScheme) {
+			addresses = append(addresses, address)
+			continue
+		}
+		resolved, err := p.resolve(ctx, strings.TrimPrefix(address, dnsScheme))
+		if err != nil {
+			errs = append(errs, err)
+			addresses = append(addresses, p.currentAddresses(address)...)
+			continue
+		}
+		addresses = append(addresses, resolved...)
+	}
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	for _, b := range p.backends {
+		if !slices.Contains(addresses, b.address) {
+			p.remove(b)
+		}
+	}
+	p.backends = slices.DeleteFunc(p.backends, func(b *backend) bool { return b.removed })
+	for _, address := range addresses {
+		if slices.ContainsFunc(p.backends, func(b *backend) bool { return b.address == address }) {
+			continue
+		}
+		conn, err := p.dial(address)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("could not connect to %s: %w", address, err))
+			continue
+		}
+		logger.Info("added computervision backend", "backend", address)
+		p.backends = append(p.backends, &backend{address: address, conn: conn, client: skp.NewComputerVisionServiceClient(conn)})
+	}
+	return errors.Join(errs...)
+}
+
+func (p *backendPool) resolve(ctx context.Context, hostPort string) ([]string, error) {
+	host, port, err := net.SplitHostPort(hostPort)
+	if err != nil {
+		return nil, fmt.Errorf("invalid computervision address %s%s: %w", dnsScheme, hostPort, err)
+	}
+	ips, err := p.lookupHost(ctx, host)
+	if err != nil {
+		return nil, fmt.Errorf("could not resolve %s: %w", host, err)
+	}
+	addresses := make([]string, 0, len(ips))
+	for _, ip := range ips {
+		addresses = append(addresses, net.JoinHostPort(ip, port))
+	}
+	slices.Sort(addresses)
+	return addresses, nil
+}
+
+// Backends that were resolved from a dns address before, the port tells them apart from other addresses
+func (p *backendPool) currentAddresses(dnsAddress string) []string {
+	_, port, _ := net.SplitHostPort(strings.TrimPrefix(dnsAddress, dnsScheme))
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	var addresses []string
+	for _, b := range p.backends {
+		if _, backendPort, _ := net.SplitHostPort(b.address); backendPort == port {
+			addresses = append(addresses, b.address)
+		}
+	}
+	return addresses
+}
+
+// p.mutex must be held
+func (p *backendPool) remove(b *backend) {
+	b.removed = true
+	logger.Info("removed computervision backend", "backend", b.address)
+	if b.outstanding == 0 && b.conn != nil {
+		b.conn.Close()
+	}
+}
+
+// Probes every backend at the same time, returns an error if none of them is healthy
+func (p *backendPool) probe(ctx context.Context) error {
+	p.mutex.Lock()
+	backends := slices.Clone(p.backends)
+	p.mutex.Unlock()
+	errs := make([]error, len(backends))
+	var wg sync.WaitGroup
+	for i, b := range backends {
+		wg.Go(func() {
+			errs[i] = probeConn(ctx, b.conn)
+		})
+	}
+	wg.Wait()
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	healthy := 0
+	for i, b := range backends {
+		if b.healthy != (errs[i] == nil) {
+			logger.Info("computervision backend health changed", "backend", b.address, "healthy", errs[i] == nil, "error", errs[i])
+		}
+		b.healthy = errs[i] == nil
+		if b.healthy {
+			healthy++
+			errs[i] = nil
+		} else {
+			errs[i] = fmt.Errorf("%s: %w", b.address, errs[i])
+		}
+	}
+	p.notify()
+	if len(backends) == 0 {
+		return errors.New("no computervision backends")
+	}
+	if healthy == 0 {
+		return fmt.Errorf("no healthy computervision backend: %w", errors.Join(errs...))
+	}
+	return nil
+}
+
+// Waits for the connection to become ready (or the ctx to expire), then asks the backend's health service whether it
+// serves computervision calls, a server that is still loading openpose accepts connections but is not serving yet
+func probeConn(ctx context.Context, conn *grpc.ClientConn) error {
+	for state := conn.GetState(); state != connectivity.Ready; state = conn.GetState() {
+		if state == connectivity.Idle {
+			conn.Connect()
+		}
+		if !conn.WaitForStateChange(ctx, state) {
+			return fmt.Errorf("connection is %s", state)
+		}
+	}
+	response, err := healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{Service: skp.ComputerVisionService_ServiceDesc.ServiceName})
+	if err != nil {
+		return fmt.Errorf("health check failed: %w", err)
+	}
+	if response.GetStatus() != healthpb.HealthCheckResponse_SERVING {
+		return fmt.Errorf("health check returned %s", response.GetStatus())
+	}
+	return nil
+}
+
+// Returns the usable backend with the fewest outstanding calls, waits while every usable backend is at its concurrency
+// cap. Fails right away if there is no usable backend. The backend must be released once the call finishes.
+func (p *backendPool) acquire(ctx context.Context) (*backend, error) {
+	for {
+		p.mutex.Lock()
+		var best *backend
+		usable := 0
+		now := p.now()
+		for _, b := range p.backends {
+			if !b.healthy || b.removed || now.Before(b.ejectedUntil) {
+				continue
+			}
+			usable++
+			if p.maxConcurrent > 0 && b.outstanding >= p.maxConcurrent {
+				continue
+			}
+			if best == nil || b.outstanding < best.outstanding {
+				best = b
+			}
+		}
+		if best != nil {
+			best.outstanding++
+			metrics.SetCvBackendOutstanding(best.address, best.outstanding)
+			p.mutex.Unlock()
+			return best, nil
+		}
+		changed := p.changed
+		p.mutex.Unlock()
+		if usable == 0 {
+			return nil, errors.New("no healthy computervision backend")
+		}
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("waited for a free computervision backend: %w", ctx.Err())
+		case <-changed:
+		}
+	}
+}
+
+// Counts the result of a call, err is the error of the call (nil on success)
+func (p *backendPool) release(b *backend, err error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	b.outstanding--
+	metrics.SetCvBackendOutstanding(b.address, b.outstanding)
+	if b.removed && b.outstanding == 0 && b.conn != nil {
+		b.conn.Close()
+	}
+	if !isBackendFailure(err) {
+		b.consecutiveFailures = 0
+		p.notify()
+		return
+	}
+	b.consecutiveFailures++
+	if b.consecutiveFailures >= p.ejectAfterFailures && !p.now().Before(b.ejectedUntil) {
+		b.ejectedUntil = p.now().Add(p.ejectionDuration)
+		b.consecutiveFailures = 0
+		metrics.IncCvBackendEjection(b.address)
+		logger.Warn("ejected computervision backend", "backend", b.address, "duration", p.ejectionDuration, "error", err)
+	}
+	p.notify()
+}
+
+// Wakes up the callers waiting in acquire, p.mutex must be held
+func (p *backendPool) notify() {
+	close(p.changed)
+	p.changed = make(chan struct{})
+}
+
+// The error a call is counted with by the pool. DeadlineExceeded only counts if the
+// per-method timeout expired, not if the caller's own deadline (parent) did, so clients with short deadlines do not
+// eject healthy backends.
+func backendError(parent context.Context, err error) error {
+	if status.Code(err) == codes.DeadlineExceeded && parent.Err() != nil {
+		return nil
+	}
+	return err
+}
+
+// Errors that say the backend is down or overloaded, not that the request was bad or the caller gave up. Errors
+// without a status did not come from a backend.
+func isBackendFailure(err error) bool {
+	st, ok := status.FromError(err)
+	if err == nil || !ok {
+		return false
+	}
+	switch st.Code() {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted, codes.Internal, codes.Unknown:
+		return true
+	}
+	return false
+}
+
+func (p *backendPool) close() {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	for _, b := range p.backends {
+		if b.conn != nil {
+			b.conn.Close()
+		}
+	}
+	p.backends = nil
+}
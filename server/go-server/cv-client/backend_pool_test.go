@@ -0,0 +1,201 @@
+package cvclient
+
+import (
+	"context"
+	"errors"
+	"net"
+	"slices"
+	"testing"
+	"time"
+
+	skp "github.com/sirfrank96/go-server/sports-keypoints-proto"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+// Pool of healthy backends that are not connected, calls are never sent to them
+func newTestBackendPool(maxConcurrent int, addresses ...string) *backendPool {
+	p := newBackendPool("", maxConcurrent, 2, time.Minute, nil)
+	for _, address := range addresses {
+		p.backends = append(p.backends, &backend{address: address, healthy: true})
+	}
+	return p
+}
+
+func TestBackendPoolLeastOutstanding(t *testing.T) {
+	p := newTestBackendPool(2, "cv1:50051", "cv2:50051")
+	ctx := context.Background()
+	first, _ := p.acquire(ctx)
+	second, _ := p.acquire(ctx)
+	if first == second {
+		t.Errorf("both calls went to %s, expected the backend with fewer outstanding calls", first.address)
+	}
+	p.release(first, nil)
+	third, _ := p.acquire(ctx)
+	if third != first {
+		t.Errorf("call went to %s, expected %s which has no outstanding calls", third.address, first.address)
+	}
+	// both backends are at their cap, the next call waits for a free slot
+	p.acquire(ctx)
+	p.acquire(ctx)
+	waitCtx, cancel := context.WithTimeout(ctx, 20*time.Millisecond)
+	defer cancel()
+	if _, err := p.acquire(waitCtx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("acquire returned %v while every backend was busy, expected to wait until the deadline", err)
+	}
+	acquired := make(chan *backend)
+	go func() {
+		b, _ := p.acquire(ctx)
+		acquired <- b
+	}()
+	time.Sleep(10 * time.Millisecond)
+	p.release(second, nil)
+	select {
+	case b := <-acquired:
+		if b != second {
+			t.Errorf("waiting call went to %s, expected the released %s", b.address, second.address)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("waiting call did not get the released backend")
+	}
+}
+
+func TestBackendPoolEjection(t *testing.T) {
+	p := newTestBackendPool(0, "cv1:50051", "cv2:50051")
+	now := time.Now()
+	p.now = func() time.Time { return now }
+	ctx := context.Background()
+	unavailable := status.Error(codes.Unavailable, "gpu node is down")
+	cv1 := p.backends[0]
+	// bad requests and cancelled calls do not count against the backend
+	for _, err := range []error{status.Error(codes.InvalidArgument, "bad image"), status.Error(codes.Canceled, "client went away")} {
+		cv1.outstanding++
+		p.release(cv1, err)
+	}
+	if cv1.consecutiveFailures != 0 {
+		t.Errorf("backend has %d failures, expected client errors not to count", cv1.consecutiveFailures)
+	}
+	for range 2 {
+		cv1.outstanding++
+		p.release(cv1, unavailable)
+	}
+	for range 3 {
+		b, _ := p.acquire(ctx)
+		if b == cv1 {
+			t.Errorf("call went to the ejected backend %s", cv1.address)
+		}
+	}
+	// ejected backends get calls again once the ejection is over
+	now = now.Add(time.Minute)
+	p.backends[1].outstanding = 5
+	if b, _ := p.acquire(ctx); b != cv1 {
+		t.Errorf("call went to %s, expected %s after its ejection", b.address, cv1.address)
+	}
+	// unhealthy backends never get calls
+	for _, b := range p.backends {
+		b.healthy = false
+	}
+	if _, err := p.acquire(ctx); err == nil {
+		t.Errorf("acquire is supposed to fail without a healthy backend")
+	}
+}
+
+func TestBackendPoolRefresh(t *testing.T) {
+	dial := func(address string) (*grpc.ClientConn, error) {
+		return grpc.NewClient(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	}
+	p := newBackendPool("dns:///computervision:50051, other:50052", 0, 3, time.Minute, dial)
+	defer p.close()
+	ips := []string{"10.0.0.2", "10.0.0.1"}
+	var lookupErr error
+	p.lookupHost = func(ctx context.Context, host string) ([]string, error) {
+		return ips, lookupErr
+	}
+	addresses := func() []string {
+		var res []string
+		for _, b := range p.backends {
+			res = append(res, b.address)
+		}
+		slices.Sort(res)
+		return res
+	}
+	ctx := context.Background()
+	if err := p.refresh(ctx); err != nil {
+		t.Fatalf("refresh had an unexpected error: %s", err.Error())
+	}
+	if got := addresses(); !slices.Equal(got, []string{"10.0.0.1:50051", "10.0.0.2:50051", "other:50052"}) {
+		t.Errorf("backends are %v after resolving", got)
+	}
+	// new backends are unhealthy until they are probed
+	if _, err := p.acquire(ctx); err == nil {
+		t.Errorf("acquire is supposed to fail before the backends are probed")
+	}
+	ips = []string{"10.0.0.3", "10.0.0.1"}
+	p.refresh(ctx)
+	if got := addresses(); !slices.Equal(got, []string{"10.0.0.1:50051", "10.0.0.3:50051", "other:50052"}) {
+		t.Errorf("backends are %v after dns changed", got)
+	}
+	// backends are kept while dns fails
+	lookupErr = errors.New("no such host")
+	if err := p.refresh(ctx); err == nil {
+		t.Errorf("refresh is supposed to return the dns error")
+	}
+	if got := addresses(); len(got) != 3 {
+		t.Errorf("backends are %v after dns failed, expected them to be kept", got)
+	}
+}
+
+func TestProbeConnChecksHealth(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("could not listen: %s", err.Error())
+	}
+	server := grpc.NewServer()
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(server, healthServer)
+	go server.Serve(listener)
+	defer server.Stop()
+	conn, err := grpc.NewClient(listener.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("could not create client: %s", err.Error())
+	}
+	defer conn.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	// connected, but computervision is not registered as serving yet
+	if err := probeConn(ctx, conn); err == nil {
+		t.Errorf("probeConn is supposed to fail before computervision is serving")
+	}
+	healthServer.SetServingStatus(skp.ComputerVisionService_ServiceDesc.ServiceName, healthpb.HealthCheckResponse_NOT_SERVING)
+	if err := probeConn(ctx, conn); err == nil {
+		t.Errorf("probeConn is supposed to fail while computervision is not serving")
+	}
+	healthServer.SetServingStatus(skp.ComputerVisionService_ServiceDesc.ServiceName, healthpb.HealthCheckResponse_SERVING)
+	if err := probeConn(ctx, conn); err != nil {
+		t.Errorf("probeConn had an unexpected error: %s", err.Error())
+	}
+}
+
+func TestBackendErrorIgnoresCallerDeadline(t *testing.T) {
+	deadlineExceeded := status.Error(codes.DeadlineExceeded, "context deadline exceeded")
+	// the per-method timeout expired while the caller was still waiting
+	if err := backendError(context.Background(), deadlineExceeded); !isBackendFailure(err) {
+		t.Errorf("DeadlineExceeded within the caller's deadline is supposed to count against the backend")
+	}
+	// the caller's own deadline expired
+	parent, cancel := context.WithTimeout(context.Background(), time.Nanosecond)
+	defer cancel()
+	<-parent.Done()
+	if err := backendError(parent, deadlineExceeded); isBackendFailure(err) {
+		t.Errorf("DeadlineExceeded after the caller's deadline is not supposed to count against the backend")
+	}
+	unavailable := status.Error(codes.Unavailable, "gpu node is down")
+	if err := backendError(parent, unavailable); !isBackendFailure(err) {
+		t.Errorf("Unavailable is supposed to count against the backend even after the caller's deadline")
+	}
+}
@@ -0,0 +1,144 @@
+package cvclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/sirfrank96/go-server/apierror"
+	"github.com/sirfrank96/go-server/metrics"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrorInfo reason for calls rejected by the open circuit breaker
+const circuitBreakerOpen = "COMPUTERVISION_CIRCUIT_OPEN"
+
+var errCircuitOpen = errors.New("circuit breaker is open")
+
+type circuitState int
+
+const (
+	circuitClosed circuitState = iota
+	circuitOpen
+	circuitHalfOpen
+)
+
+func (s circuitState) String() string {
+	switch s {
+	case circuitOpen:
+		return "open"
+	case circuitHalfOpen:
+		return "half_open"
+	}
+	return "closed"
+}
+
+// Stops sending calls to computervision after failureThreshold calls in a row failed, calls fail fast with Unavailable
+// while it is open. After openDuration (or once a health probe passes) it lets one trial call through, the trial
+// closes it again if it succeeds. A failureThreshold of 0 disables it.
+type circuitBreaker struct {
+	mutex            sync.Mutex
+	state            circuitState
+	failures         int
+	failureThreshold int
+	openDuration     time.Duration
+	openedAt         time.Time
+	trialRunning     bool
+	now              func() time.Time
+}
+
+func newCircuitBreaker(failureThreshold int, openDuration time.Duration) *circuitBreaker {
+	return &circuitBreaker{failureThreshold: failureThreshold, openDuration: openDuration, now: time.Now}
+}
+
+// Returns an Unavailable error if the call can not be sent, otherwise done must be called with the call's result. parent
+// is the caller's context, calls the caller gave up on do not change the breaker.
+func (b *circuitBreaker) allow(parent context.Context) (done func(err error), err error) {
+	if b.failureThreshold == 0 {
+		return func(error) {}, nil
+	}
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	if b.state == circuitOpen && !b.now().Before(b.openedAt.Add(b.openDuration)) {
+		b.setState(circuitHalfOpen)
+	}
+	switch b.state {
+	case circuitOpen:
+		retryIn := b.openedAt.Add(b.openDuration).Sub(b.now()).Round(time.Second)
+		return nil, apierror.Unavailable(errCircuitOpen, "computervision is failing, retry in %s", retryIn).WithReason(circuitBreakerOpen)
+	case circuitHalfOpen:
+		if b.trialRunning {
+			return nil, apierror.Unavailable(errCircuitOpen, "computervision is recovering, retry later").WithReason(circuitBreakerOpen)
+		}
+		b.trialRunning = true
+		return func(err error) { b.record(parent, err, true) }, nil
+	}
+	return func(err error) { b.record(parent, err, false) }, nil
+}
+
+func (b *circuitBreaker) record(parent context.Context, err error, trial bool) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	if trial {
+		b.trialRunning = false
+	}
+	// calls that were sent before the breaker opened do not change it
+	if b.state == circuitOpen || (b.state == circuitHalfOpen && !trial) {
+		return
+	}
+	// a trial the caller gave up on leaves the breaker half open for the next one
+	if code := status.Code(err); code == codes.Canceled || (code == codes.DeadlineExceeded && parent.Err() != nil) {
+		return
+	}
+	if !isBackendFailure(err) {
+		// errors without a status (eg. no backend was free) say nothing about computervision
+		if _, ok := status.FromError(err); ok {
+			b.failures = 0
+			b.setState(circuitClosed)
+		}
+		return
+	}
+	b.failures++
+	if trial || b.failures >= b.failureThreshold {
+		b.openedAt = b.now()
+		b.setState(circuitOpen)
+	}
+}
+
+// A passing health probe ends the wait of an open breaker early
+func (b *circuitBreaker) probeSucceeded() {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	if b.state == circuitOpen {
+		b.setState(circuitHalfOpen)
+	}
+}
+
+// b.mutex must be held
+func (b *circuitBreaker) setState(state circuitState) {
+	if b.state == state {
+		return
+	}
+	if state == circuitOpen {
+		logger.Warn("computervision circuit breaker changed", "state", state.String(), "open_duration", b.openDuration)
+	} else {
+		logger.Info("computervision circuit breaker changed", "state", state.String())
+	}
+	b.state = state
+	b.failures = 0
+	metrics.SetCvCircuitBreakerOpen(state == circuitOpen)
+}
+
+// Health status of the breaker, fails while it is open
+func (b *circuitBreaker) check(ctx context.Context) error {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	if b.state == circuitOpen {
+		return fmt.Errorf("computervision %w since %s", errCircuitOpen, b.openedAt.Format(time.RFC3339))
+	}
+	return nil
+}
@@ -0,0 +1,147 @@
+package cvclient
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/sirfrank96/go-server/apierror"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	b := newCircuitBreaker(2, time.Minute)
+	now := time.Now()
+	b.now = func() time.Time { return now }
+	unavailable := status.Error(codes.Unavailable, "computervision is down")
+	call := func(err error) error {
+		done, allowErr := b.allow(context.Background())
+		if allowErr != nil {
+			return allowErr
+		}
+		done(err)
+		return nil
+	}
+	// a success in between resets the failures, errors without a status do not count
+	call(unavailable)
+	call(nil)
+	call(unavailable)
+	call(errors.New("no healthy computervision backend"))
+	if b.state != circuitClosed {
+		t.Fatalf("circuit breaker is %s, expected closed", b.state)
+	}
+	call(unavailable)
+	if b.state != circuitOpen {
+		t.Fatalf("circuit breaker is %s after 2 failures in a row, expected open", b.state)
+	}
+	// fails fast while open
+	if err := call(nil); apierror.Code(err) != codes.Unavailable || !errors.Is(err, errCircuitOpen) {
+		t.Errorf("call returned %v while open, expected Unavailable", err)
+	}
+	if err := b.check(context.Background()); err == nil {
+		t.Errorf("check is supposed to fail while the circuit breaker is open")
+	}
+	// one trial call once the open duration is over, a failed trial opens it again
+	now = now.Add(time.Minute)
+	done, err := b.allow(context.Background())
+	if err != nil {
+		t.Fatalf("trial call was not allowed: %s", err.Error())
+	}
+	if _, err := b.allow(context.Background()); err == nil {
+		t.Errorf("a second call is not supposed to be allowed during the trial")
+	}
+	done(unavailable)
+	if b.state != circuitOpen {
+		t.Errorf("circuit breaker is %s after a failed trial, expected open", b.state)
+	}
+	// a passing health probe allows the trial early, trials the caller gave up on leave it half open
+	b.probeSucceeded()
+	expired, cancel := context.WithDeadline(context.Background(), now)
+	cancel()
+	done, err = b.allow(expired)
+	if err != nil {
+		t.Fatalf("trial call was not allowed: %s", err.Error())
+	}
+	done(status.Error(codes.DeadlineExceeded, "caller deadline exceeded"))
+	if b.state != circuitHalfOpen || b.trialRunning {
+		t.Errorf("circuit breaker is %s after a trial past the caller's deadline, expected half open without a trial", b.state)
+	}
+	call(status.Error(codes.Canceled, "caller canceled"))
+	if b.state != circuitHalfOpen || b.trialRunning {
+		t.Errorf("circuit breaker is %s after a canceled trial, expected half open without a trial", b.state)
+	}
+	// a successful trial closes it
+	if err := call(nil); err != nil || b.state != circuitClosed {
+		t.Errorf("trial call returned %v and left the circuit breaker %s, expected it to close", err, b.state)
+	}
+}
+
+func TestRetryBackoff(t *testing.T) {
+	c := &CvClientManager{}
+	c.config.Retry.MaxAttempts = 3
+	c.config.Retry.InitialBackoff = time.Millisecond
+	c.config.Retry.MaxBackoff = 4 * time.Millisecond
+	for attempt, maxBackoff := range []time.Duration{time.Millisecond, 2 * time.Millisecond, 4 * time.Millisecond, 4 * time.Millisecond} {
+		if backoff := retryBackoff(c.config.Retry, attempt+1); backoff < maxBackoff/2 || backoff > maxBackoff {
+			t.Errorf("backoff of attempt %d is %s, expected between %s and %s", attempt+1, backoff, maxBackoff/2, maxBackoff)
+		}
+	}
+	// transient errors are retried up to max_attempts
+	calls := 0
+	err := c.withRetries(context.Background(), "GetPoseData", func() error {
+		calls++
+		return status.Error(codes.Unavailable, "computervision is down")
+	})
+	if calls != 3 || status.Code(err) != codes.Unavailable {
+		t.Errorf("withRetries returned %v after %d calls, expected Unavailable after 3", err, calls)
+	}
+	// other errors are not retried
+	calls = 0
+	c.withRetries(context.Background(), "GetPoseData", func() error {
+		calls++
+		return status.Error(codes.InvalidArgument, "not an image")
+	})
+	if calls != 1 {
+		t.Errorf("withRetries sent a call that failed with InvalidArgument %d times, expected once", calls)
+	}
+	// no retry that would start after the deadline
+	c.config.Retry.InitialBackoff = time.Second
+	c.config.Retry.MaxBackoff = time.Second
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	calls = 0
+	c.withRetries(ctx, "GetPoseData", func() error {
+		calls++
+		return status.Error(codes.Unavailable, "computervision is down")
+	})
+	if calls != 1 {
+		t.Errorf("withRetries sent the call %d times, expected no retry after the deadline", calls)
+	}
+}
+
+func TestCvCallError(t *testing.T) {
+	tests := []struct {
+		err  error
+		code codes.Code
+	}{
+		{status.Error(codes.Unavailable, "computervision is down"), codes.Unavailable},
+		{status.Error(codes.DeadlineExceeded, "context deadline exceeded"), codes.Unavailable},
+		{status.Error(codes.ResourceExhausted, "too many requests"), codes.Unavailable},
+		{errors.New("no healthy computervision backend"), codes.Unavailable},
+		{status.Error(codes.InvalidArgument, "not an image"), codes.InvalidArgument},
+		{status.Error(codes.Internal, "openpose failed"), codes.Internal},
+		{status.Error(codes.Unimplemented, "unknown method"), codes.Unimplemented},
+	}
+	for _, test := range tests {
+		err := cvCallError("GetPoseData", test.err)
+		if code := apierror.Code(err); code != test.code {
+			t.Errorf("cvCallError(%v) has code %s, expected %s", test.err, code, test.code)
+		}
+		if st, _ := status.FromError(err); st.Code() != test.code {
+			t.Errorf("cvCallError(%v) reaches callers as %s, expected %s", test.err, st.Code(), test.code)
+		}
+	}
+}
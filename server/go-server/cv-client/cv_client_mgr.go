@@ -2,134 +2,246 @@ package cvclient
 
 import (
 	"context"
-	"flag"
+	"crypto/tls"
 	"fmt"
-	"io"
-	"log"
 	"os"
 	"time"
 
+	"github.com/sirfrank96/go-server/apierror"
+	"github.com/sirfrank96/go-server/config"
+	"github.com/sirfrank96/go-server/logging"
 	skp "github.com/sirfrank96/go-server/sports-keypoints-proto"
+	"github.com/sirfrank96/go-server/util"
 
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/encoding/gzip"
+	"google.golang.org/grpc/status"
 )
 
-var (
-	cvaddr = flag.String("cvaddr", "localhost:50051", "computervision address to connect to")
-)
+var logger = logging.Logger("cv-client")
+
+// ErrorInfo reason for failed computervision calls
+const computervisionUnavailable = "COMPUTERVISION_UNAVAILABLE"
+
+// how long resolving the computervision backends can take at startup
+const resolveTimeout = 5 * time.Second
 
 type CvClientManager struct {
-	conn   *grpc.ClientConn
-	client skp.ComputerVisionServiceClient
+	config config.ComputerVisionConfig
+	pool   *backendPool
+	// per method overrides of image_timeout and video_timeout
+	timeouts map[string]time.Duration
+	breaker  *circuitBreaker
+	// nil if pose results are not cached
+	cache *poseCache
 }
 
-func NewCvClientManager() *CvClientManager {
-	c := &CvClientManager{}
-	log.Printf("New Cv Client Mgr")
+func NewCvClientManager(cvConfig config.ComputerVisionConfig) *CvClientManager {
+	c := &CvClientManager{config: cvConfig}
+	c.breaker = newCircuitBreaker(cvConfig.CircuitBreaker.FailureThreshold, cvConfig.CircuitBreaker.OpenDuration)
+	c.cache = newPoseCache(c, cvConfig.Cache)
+	logger.Info("new cv client mgr")
 	return c
 }
 
 func (c *CvClientManager) StartCvClient() error {
-	log.Printf("Starting CvClient")
-	flag.Parse()
-	cvURI := os.Getenv("COMPUTER_VISION_URI")
-	if cvURI == "" {
-		cvURI = *cvaddr
-	}
-	// Set up a connection to the computervision server.
-	var err error
-	c.conn, err = grpc.NewClient(cvURI, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	logger.Info("starting cv client")
+	timeouts, err := parseMethodTimeouts(c.config.Timeouts)
+	if err != nil {
+		return fmt.Errorf("could not parse computervision.timeouts: %w", err)
+	}
+	c.timeouts = timeouts
+	switch c.config.Mode {
+	case "replay":
+		// every response comes from the fixtures, there is nothing to connect to
+		if _, err := os.Stat(c.config.FixtureDir); err != nil {
+			return fmt.Errorf("could not open computervision fixtures: %w", err)
+		}
+		logger.Warn("replaying computervision responses instead of connecting", "fixture_dir", c.config.FixtureDir)
+		return nil
+	case "record":
+		if err := os.MkdirAll(c.config.FixtureDir, 0o755); err != nil {
+			return fmt.Errorf("could not create computervision fixture dir: %w", err)
+		}
+		logger.Info("recording computervision responses", "fixture_dir", c.config.FixtureDir)
+	}
+	creds, err := getCvClientCredentials(c.config)
 	if err != nil {
-		return fmt.Errorf("could not connect grpc client: %w", err)
+		return fmt.Errorf("could not get computervision client credentials: %w", err)
+	}
+	// Every computervision backend gets its own connection so the pool can pick the backend for each call
+	dial := func(address string) (*grpc.ClientConn, error) {
+		return grpc.NewClient(address,
+			grpc.WithTransportCredentials(creds),
+			// client spans for computervision calls, trace context is sent to computervision in the grpc metadata
+			grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
+			grpc.WithChainUnaryInterceptor(metricsUnaryClientInterceptor),
+			grpc.WithChainStreamInterceptor(metricsStreamClientInterceptor),
+			grpc.WithDefaultCallOptions(getCallOptions(c.config.Messages)...),
+		)
+	}
+	c.pool = newBackendPool(c.config.Address, c.config.MaxConcurrentRequests, c.config.EjectAfterFailures, c.config.EjectionDuration, dial)
+	ctx, cancel := context.WithTimeout(context.Background(), resolveTimeout)
+	defer cancel()
+	// dns names that can not be resolved yet are retried by the health probes
+	if err := c.pool.refresh(ctx); err != nil {
+		logger.Warn("could not add every computervision backend", "error", err)
 	}
-	// Init ComputerVisionService grpc client
-	c.client = skp.NewComputerVisionServiceClient(c.conn)
 	return nil
 }
 
-func (c *CvClientManager) CloseCvClient() error {
-	log.Printf("Closing CvClient")
-	c.conn.Close()
+// Images are sent to and returned from computervision in one message, so the limits have to fit them
+func getCallOptions(messageConfig config.MessageConfig) []grpc.CallOption {
+	callOptions := []grpc.CallOption{grpc.MaxCallRecvMsgSize(messageConfig.MaxRecvSize), grpc.MaxCallSendMsgSize(messageConfig.MaxSendSize)}
+	if messageConfig.Compression == gzip.Name {
+		callOptions = append(callOptions, grpc.UseCompressor(gzip.Name))
+	}
+	return callOptions
+}
+
+// TLS is required unless computervision.insecure is set explicitly
+func getCvClientCredentials(cvConfig config.ComputerVisionConfig) (credentials.TransportCredentials, error) {
+	if cvConfig.Insecure {
+		logger.Warn("connecting to computervision without tls because computervision.insecure is set")
+		return insecure.NewCredentials(), nil
+	}
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ServerName: cvConfig.ServerName,
+	}
+	if cvConfig.CaFile != "" {
+		pool, err := util.LoadCertPool(cvConfig.CaFile)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.RootCAs = pool
+	}
+	if cvConfig.CertFile != "" || cvConfig.KeyFile != "" {
+		reloader, err := util.NewCertReloader(cvConfig.CertFile, cvConfig.KeyFile)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.GetClientCertificate = reloader.GetClientCertificate
+	}
+	return credentials.NewTLS(tlsConfig), nil
+}
+
+// Health probe for computervision (always healthy when replaying fixtures). Resolves the backends again and probes
+// each of them, calls are only sent to the backends that pass. Fails if no backend is healthy.
+func (c *CvClientManager) PingCvClient(ctx context.Context) error {
+	if c.config.Mode == "replay" {
+		return nil
+	}
+	if c.pool == nil {
+		return fmt.Errorf("computervision client is not started")
+	}
+	if err := c.pool.refresh(ctx); err != nil {
+		logger.WarnContext(ctx, "could not refresh computervision backends", "error", err)
+	}
+	if err := c.pool.probe(ctx); err != nil {
+		return err
+	}
+	c.breaker.probeSucceeded()
 	return nil
 }
 
-func (c *CvClientManager) GetPoseImage(img []byte) (*skp.GetPoseImageResponse, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+// Health status of the circuit breaker, reported next to the computervision probe but not needed for readiness
+func (c *CvClientManager) CheckCircuitBreaker(ctx context.Context) error {
+	return c.breaker.check(ctx)
+}
+
+// Sends a unary pose call to the healthy backend with the fewest outstanding calls, transient failures are retried
+// (on whichever backend is least busy then) until the method's timeout or the caller's deadline
+func (c *CvClientManager) callBackend(parent context.Context, method string, call func(ctx context.Context, client skp.ComputerVisionServiceClient) error) error {
+	ctx, cancel := context.WithTimeout(parent, c.timeout(method, c.config.ImageTimeout))
 	defer cancel()
+	done, err := c.breaker.allow(parent)
+	if err != nil {
+		return err
+	}
+	err = c.withRetries(ctx, method, func() error {
+		b, err := c.pool.acquire(ctx)
+		if err != nil {
+			return err
+		}
+		err = call(ctx, b.client)
+		c.pool.release(b, backendError(parent, err))
+		return err
+	})
+	done(err)
+	if err != nil {
+		return cvCallError(method, err)
+	}
+	return nil
+}
+
+// Transient computervision failures, and failures to reach computervision at all, reach callers as Unavailable so they
+// can be retried. Other computervision statuses (eg. InvalidArgument for an image it can not decode) keep their code.
+func cvCallError(method string, err error) error {
+	if st, ok := status.FromError(err); ok {
+		switch st.Code() {
+		case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted, codes.Aborted:
+		default:
+			return fmt.Errorf("computervision client %s failed: %w", method, err)
+		}
+	}
+	return apierror.Unavailable(err, "computervision client %s failed", method).WithReason(computervisionUnavailable)
+}
+
+func (c *CvClientManager) CloseCvClient() error {
+	logger.Info("closing cv client")
+	if c.pool == nil {
+		return nil
+	}
+	c.pool.close()
+	return nil
+}
+
+func (c *CvClientManager) GetPoseImage(ctx context.Context, img []byte) (*skp.GetPoseImageResponse, error) {
 	getPoseImageRequest := &skp.GetPoseImageRequest{Image: img}
-	getPoseImageResponse, err := c.client.GetPoseImage(ctx, getPoseImageRequest)
+	var getPoseImageResponse *skp.GetPoseImageResponse
+	err := c.callBackend(ctx, "GetPoseImage", func(ctx context.Context, client skp.ComputerVisionServiceClient) (err error) {
+		getPoseImageResponse, err = client.GetPoseImage(ctx, getPoseImageRequest)
+		return err
+	})
 	if err != nil {
-		return nil, fmt.Errorf("computervision client GetPoseImage failed: %w", err)
+		return nil, err
 	}
 	return getPoseImageResponse, nil
 }
 
-func (c *CvClientManager) GetPoseData(img []byte) (*skp.GetPoseDataResponse, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
-	defer cancel()
+func (c *CvClientManager) GetPoseData(ctx context.Context, img []byte) (*skp.GetPoseDataResponse, error) {
 	getPoseDataRequest := &skp.GetPoseDataRequest{Image: img}
-	getPoseDataResponse, err := c.client.GetPoseData(ctx, getPoseDataRequest)
+	var getPoseDataResponse *skp.GetPoseDataResponse
+	err := c.callBackend(ctx, "GetPoseData", func(ctx context.Context, client skp.ComputerVisionServiceClient) (err error) {
+		getPoseDataResponse, err = client.GetPoseData(ctx, getPoseDataRequest)
+		return err
+	})
 	if err != nil {
-		return nil, fmt.Errorf("computervision client GetPoseData failed: %w", err)
+		return nil, err
+	}
+	if err := convertPoseDataResponse("GetPoseData", getPoseDataResponse); err != nil {
+		return nil, err
 	}
 	return getPoseDataResponse, nil
 }
 
-func (c *CvClientManager) GetPoseAll(img []byte) (*skp.GetPoseAllResponse, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
-	defer cancel()
+func (c *CvClientManager) GetPoseAll(ctx context.Context, img []byte) (*skp.GetPoseAllResponse, error) {
 	getPoseAllRequest := &skp.GetPoseAllRequest{Image: img}
-	getPoseAllResponse, err := c.client.GetPoseAll(ctx, getPoseAllRequest)
-	if err != nil {
-		return nil, fmt.Errorf("computervision client GetPoseAll failed: %w", err)
-	}
-	return getPoseAllResponse, nil
-}
-
-func (c *CvClientManager) GetPoseImagesFromFromVideo(images [][]byte) ([]*skp.GetPoseImageResponse, error) {
-	// TODO: Get rid of timeout? or configure stream vs nonstream timeouts
-	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Second)
-	defer cancel()
-	stream, err := c.client.GetPoseImagesFromVideo(ctx)
+	var getPoseAllResponse *skp.GetPoseAllResponse
+	err := c.callBackend(ctx, "GetPoseAll", func(ctx context.Context, client skp.ComputerVisionServiceClient) (err error) {
+		getPoseAllResponse, err = client.GetPoseAll(ctx, getPoseAllRequest)
+		return err
+	})
 	if err != nil {
-		return nil, fmt.Errorf("computervision pose client GetPoseImagesFromVideo get stream failed: %w", err)
-	}
-	// Start goroutine that waits for return images from stream
-	waitc := make(chan struct{})
-	errChan := make(chan error)
-	responses := []*skp.GetPoseImageResponse{}
-	go func() {
-		responseIdx := 0
-		for {
-			responseIdx += 1
-			response, err := stream.Recv()
-			if err == io.EOF { // read done
-				close(waitc)
-				close(errChan)
-				return
-			}
-			if err != nil {
-				errChan <- fmt.Errorf("failed to receive stream for response #%d: %w", responseIdx, err)
-				return
-			}
-			log.Printf("Received from stream, response #%d", responseIdx)
-			responses = append(responses, response)
-		}
-	}()
-	// Send all images via stream
-	for idx, img := range images {
-		if err := stream.Send(&skp.GetPoseImageRequest{Image: img}); err != nil {
-			log.Fatalf("getPoseImagesFromVideo for img #%d stream.Send() failed: %v", idx, err)
-		}
+		return nil, err
 	}
-	stream.CloseSend()
-	log.Printf("Sent all images from cv_api_mgr")
-	// Once receive stream is done, goroutine finishes
-	<-waitc
-	if <-errChan != nil {
-		return nil, fmt.Errorf("could not get all responses: %w", err)
+	if err := convertPoseAllResponse("GetPoseAll", getPoseAllResponse); err != nil {
+		return nil, err
 	}
-	log.Printf("Received all images in cv_client_mgr")
-	return responses, nil
+	return getPoseAllResponse, nil
 }
@@ -0,0 +1,53 @@
+package cvclient
+
+import (
+	"context"
+	"io"
+	"path"
+	"sync"
+	"time"
+
+	"github.com/sirfrank96/go-server/metrics"
+
+	"google.golang.org/grpc"
+)
+
+// Records latency and failures of unary computervision calls
+func metricsUnaryClientInterceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	start := time.Now()
+	err := invoker(ctx, method, req, reply, cc, opts...)
+	metrics.ObserveCvCall(path.Base(method), start, err)
+	return err
+}
+
+// Records latency and failures of streaming computervision calls, a stream is done when a receive returns an error (io.EOF on success)
+func metricsStreamClientInterceptor(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	start := time.Now()
+	stream, err := streamer(ctx, desc, cc, method, opts...)
+	if err != nil {
+		metrics.ObserveCvCall(path.Base(method), start, err)
+		return nil, err
+	}
+	return &metricsClientStream{ClientStream: stream, method: path.Base(method), start: start}, nil
+}
+
+type metricsClientStream struct {
+	grpc.ClientStream
+	method string
+	start  time.Time
+	once   sync.Once
+}
+
+func (s *metricsClientStream) RecvMsg(m interface{}) error {
+	err := s.ClientStream.RecvMsg(m)
+	if err != nil {
+		s.once.Do(func() {
+			if err == io.EOF {
+				metrics.ObserveCvCall(s.method, s.start, nil)
+			} else {
+				metrics.ObserveCvCall(s.method, s.start, err)
+			}
+		})
+	}
+	return err
+}
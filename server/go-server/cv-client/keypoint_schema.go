@@ -0,0 +1,39 @@
+package cvclient
+
+import (
+	"github.com/sirfrank96/go-server/apierror"
+	skp "github.com/sirfrank96/go-server/sports-keypoints-proto"
+	"github.com/sirfrank96/go-server/util"
+)
+
+// Responses reach the pose estimator's callers with body25 keypoints whatever model computervision runs, keypoint_schema
+// keeps the model the keypoints were converted from
+func convertPoseDataResponse(method string, response *skp.GetPoseDataResponse) error {
+	schema, keypoints, err := convertToBody25(method, response.KeypointSchema, response.Keypoints, response.Landmarks)
+	if err != nil {
+		return err
+	}
+	response.KeypointSchema, response.Keypoints, response.Landmarks = schema, keypoints, nil
+	return nil
+}
+
+func convertPoseAllResponse(method string, response *skp.GetPoseAllResponse) error {
+	schema, keypoints, err := convertToBody25(method, response.KeypointSchema, response.PoseKeypoints, response.Landmarks)
+	if err != nil {
+		return err
+	}
+	response.KeypointSchema, response.PoseKeypoints, response.Landmarks = schema, keypoints, nil
+	return nil
+}
+
+// Body25 responses are returned as they are, unspecified is an older computervision that only runs body25
+func convertToBody25(method string, schema skp.KeypointSchema, keypoints *skp.Body25PoseKeypoints, landmarks []*skp.Keypoint) (skp.KeypointSchema, *skp.Body25PoseKeypoints, error) {
+	if schema == skp.KeypointSchema_KEYPOINT_SCHEMA_UNSPECIFIED || schema == skp.KeypointSchema_KEYPOINT_SCHEMA_BODY25 {
+		return schema, keypoints, nil
+	}
+	converted, err := util.ConvertLandmarksToBody25(schema, landmarks)
+	if err != nil {
+		return schema, nil, apierror.Internal(err, "computervision %s returned invalid landmarks", method)
+	}
+	return schema, converted, nil
+}
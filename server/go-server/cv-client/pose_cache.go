@@ -0,0 +1,196 @@
+package cvclient
+
+import (
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	"github.com/sirfrank96/go-server/config"
+	"github.com/sirfrank96/go-server/metrics"
+	skp "github.com/sirfrank96/go-server/sports-keypoints-proto"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// results larger than this are only cached in memory, mongodb documents are limited to 16MB
+const maxPersistentPoseResultSize = 15 << 20
+
+// Stores cached computervision responses, implemented by the db manager
+type PoseResultStore interface {
+	// Returns nil if there is no cached result for key
+	ReadPoseResult(ctx context.Context, key string) ([]byte, error)
+	SavePoseResult(ctx context.Context, key string, method string, modelVersion string, response []byte, expiresAt time.Time) error
+}
+
+// Caches successful responses of the wrapped estimator by the content of the image, so the same image (eg. a calibration
+// image used for several input images) is only estimated once. Responses are kept in an in memory lru and optionally
+// in the database. Keys include the model version, changing computervision.cache.model_version invalidates the cache.
+type poseCache struct {
+	estimator    PoseEstimator
+	modelVersion string
+	// nil if the memory tier is disabled
+	memory *lruCache
+	// set if computervision.cache.persistent is
+	store         PoseResultStore
+	persistentTtl time.Duration
+	now           func() time.Time
+}
+
+// nil if both tiers are disabled
+func newPoseCache(estimator PoseEstimator, cacheConfig config.PoseCacheConfig) *poseCache {
+	if cacheConfig.MemorySize == 0 && !cacheConfig.Persistent {
+		return nil
+	}
+	p := &poseCache{estimator: estimator, modelVersion: cacheConfig.ModelVersion, persistentTtl: cacheConfig.PersistentTtl, now: time.Now}
+	if cacheConfig.MemorySize > 0 {
+		p.memory = newLruCache(cacheConfig.MemorySize)
+	}
+	return p
+}
+
+// The store must be set before the cache is used, it is ignored unless computervision.cache.persistent is set
+func (c *CvClientManager) SetPoseResultStore(store PoseResultStore) {
+	if c.cache != nil && c.config.Cache.Persistent {
+		c.cache.store = store
+	}
+}
+
+func (p *poseCache) GetPoseData(ctx context.Context, img []byte) (*skp.GetPoseDataResponse, error) {
+	cached := &skp.GetPoseDataResponse{}
+	if p.get(ctx, "GetPoseData", img, cached) {
+		return cached, nil
+	}
+	response, err := p.estimator.GetPoseData(ctx, img)
+	if err != nil {
+		return nil, err
+	}
+	if response.GetSuccess() {
+		p.put(ctx, "GetPoseData", img, response)
+	}
+	return response, nil
+}
+
+func (p *poseCache) GetPoseAll(ctx context.Context, img []byte) (*skp.GetPoseAllResponse, error) {
+	cached := &skp.GetPoseAllResponse{}
+	if p.get(ctx, "GetPoseAll", img, cached) {
+		return cached, nil
+	}
+	response, err := p.estimator.GetPoseAll(ctx, img)
+	if err != nil {
+		return nil, err
+	}
+	if response.GetSuccess() {
+		p.put(ctx, "GetPoseAll", img, response)
+	}
+	return response, nil
+}
+
+func (p *poseCache) key(method string, img []byte) string {
+	imageSum := sha256.Sum256(img)
+	sum := sha256.Sum256([]byte(p.modelVersion + "|" + method + "|" + hex.EncodeToString(imageSum[:])))
+	return hex.EncodeToString(sum[:])
+}
+
+// Decodes the cached response into response, database hits are added to the memory tier. A broken tier is a miss.
+func (p *poseCache) get(ctx context.Context, method string, img []byte, response proto.Message) bool {
+	key := p.key(method, img)
+	if p.memory != nil {
+		if data, ok := p.memory.get(key); ok && proto.Unmarshal(data, response) == nil {
+			metrics.IncCvCacheRequest(method, "memory_hit")
+			return true
+		}
+	}
+	if p.store != nil {
+		data, err := p.store.ReadPoseResult(ctx, key)
+		if err != nil {
+			logger.WarnContext(ctx, "could not read cached pose result", "method", method, "error", err)
+		}
+		if data != nil && proto.Unmarshal(data, response) == nil {
+			if p.memory != nil {
+				p.memory.add(key, data)
+			}
+			metrics.IncCvCacheRequest(method, "database_hit")
+			return true
+		}
+	}
+	metrics.IncCvCacheRequest(method, "miss")
+	return false
+}
+
+// The response is returned to the caller even if it can not be cached
+func (p *poseCache) put(ctx context.Context, method string, img []byte, response proto.Message) {
+	data, err := proto.Marshal(response)
+	if err != nil {
+		logger.WarnContext(ctx, "could not cache pose result", "method", method, "error", err)
+		return
+	}
+	key := p.key(method, img)
+	if p.memory != nil {
+		p.memory.add(key, data)
+	}
+	if p.store == nil || len(data) > maxPersistentPoseResultSize {
+		return
+	}
+	// cached even if the caller went away, the result is already paid for
+	if err := p.store.SavePoseResult(context.WithoutCancel(ctx), key, method, p.modelVersion, data, p.now().Add(p.persistentTtl)); err != nil {
+		logger.WarnContext(ctx, "could not save cached pose result", "method", method, "error", err)
+	}
+}
+
+type lruEntry struct {
+	key  string
+	data []byte
+}
+
+// Least recently used cache limited by the total size of its values in bytes
+type lruCache struct {
+	mutex   sync.Mutex
+	maxSize int64
+	size    int64
+	// most recently used at the front
+	order   *list.List
+	entries map[string]*list.Element
+}
+
+func newLruCache(maxSize int64) *lruCache {
+	return &lruCache{maxSize: maxSize, order: list.New(), entries: map[string]*list.Element{}}
+}
+
+func (l *lruCache) get(key string) ([]byte, bool) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	element, ok := l.entries[key]
+	if !ok {
+		return nil, false
+	}
+	l.order.MoveToFront(element)
+	return element.Value.(*lruEntry).data, true
+}
+
+// Values larger than the whole cache are not added
+func (l *lruCache) add(key string, data []byte) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	if int64(len(data)) > l.maxSize {
+		return
+	}
+	if element, ok := l.entries[key]; ok {
+		l.size -= int64(len(element.Value.(*lruEntry).data))
+		element.Value.(*lruEntry).data = data
+		l.size += int64(len(data))
+		l.order.MoveToFront(element)
+	} else {
+		l.entries[key] = l.order.PushFront(&lruEntry{key: key, data: data})
+		l.size += int64(len(data))
+	}
+	for l.size > l.maxSize {
+		oldest := l.order.Back()
+		entry := oldest.Value.(*lruEntry)
+		l.order.Remove(oldest)
+		delete(l.entries, entry.key)
+		l.size -= int64(len(entry.data))
+	}
+}
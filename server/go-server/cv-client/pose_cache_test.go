@@ -0,0 +1,94 @@
+package cvclient
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/sirfrank96/go-server/config"
+
+	"google.golang.org/protobuf/proto"
+)
+
+type fakePoseResultStore struct {
+	results map[string][]byte
+}
+
+func (f *fakePoseResultStore) ReadPoseResult(ctx context.Context, key string) ([]byte, error) {
+	return f.results[key], nil
+}
+
+func (f *fakePoseResultStore) SavePoseResult(ctx context.Context, key string, method string, modelVersion string, response []byte, expiresAt time.Time) error {
+	f.results[key] = response
+	return nil
+}
+
+func TestPoseCache(t *testing.T) {
+	ctx := context.Background()
+	estimator := &fakePoseEstimator{}
+	store := &fakePoseResultStore{results: map[string][]byte{}}
+	cache := newPoseCache(estimator, config.PoseCacheConfig{MemorySize: 1 << 20, Persistent: true, PersistentTtl: time.Hour, ModelVersion: "v1"})
+	cache.store = store
+	image := []byte("image1")
+	first, err := cache.GetPoseData(ctx, image)
+	if err != nil {
+		t.Fatalf("GetPoseData had an unexpected error: %s", err.Error())
+	}
+	// memory hit
+	second, err := cache.GetPoseData(ctx, image)
+	if err != nil || !proto.Equal(first, second) || estimator.calls != 1 {
+		t.Errorf("cached GetPoseData returned %v, %v after %d calls, expected %v after 1 call", second, err, estimator.calls, first)
+	}
+	// the methods are cached separately
+	if _, err := cache.GetPoseAll(ctx, image); err != nil || estimator.calls != 2 {
+		t.Errorf("GetPoseAll returned %v after %d calls, expected it to call the estimator", err, estimator.calls)
+	}
+	// database hit after a restart
+	restarted := newPoseCache(estimator, config.PoseCacheConfig{MemorySize: 1 << 20, Persistent: true, PersistentTtl: time.Hour, ModelVersion: "v1"})
+	restarted.store = store
+	if cached, err := restarted.GetPoseData(ctx, image); err != nil || !proto.Equal(first, cached) || estimator.calls != 2 {
+		t.Errorf("GetPoseData returned %v, %v after %d calls, expected the result from the database", cached, err, estimator.calls)
+	}
+	// a new model version does not use the old results
+	upgraded := newPoseCache(estimator, config.PoseCacheConfig{MemorySize: 1 << 20, Persistent: true, PersistentTtl: time.Hour, ModelVersion: "v2"})
+	upgraded.store = store
+	if _, err := upgraded.GetPoseData(ctx, image); err != nil || estimator.calls != 3 {
+		t.Errorf("GetPoseData with a new model version made %d calls, expected 3", estimator.calls)
+	}
+	// failed calls are not cached
+	estimator.err = errors.New("computervision is down")
+	for range 2 {
+		if _, err := cache.GetPoseData(ctx, []byte("image2")); err == nil {
+			t.Errorf("GetPoseData is supposed to return the error of the estimator")
+		}
+	}
+	if estimator.calls != 5 {
+		t.Errorf("estimator was called %d times, expected failed calls to not be cached", estimator.calls)
+	}
+}
+
+func TestLruCache(t *testing.T) {
+	l := newLruCache(10)
+	l.add("a", []byte("1234"))
+	l.add("b", []byte("1234"))
+	l.get("a")
+	// evicts b, the least recently used
+	l.add("c", []byte("1234"))
+	if _, ok := l.get("b"); ok {
+		t.Errorf("b is supposed to be evicted")
+	}
+	for _, key := range []string{"a", "c"} {
+		if _, ok := l.get(key); !ok {
+			t.Errorf("%s is supposed to be cached", key)
+		}
+	}
+	if l.size != 8 {
+		t.Errorf("size is %d, expected 8", l.size)
+	}
+	// too large for the whole cache
+	l.add("d", []byte("12345678901"))
+	if _, ok := l.get("d"); ok || l.size != 8 {
+		t.Errorf("a value larger than the cache is not supposed to be added")
+	}
+}
@@ -0,0 +1,139 @@
+package cvclient
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	skp "github.com/sirfrank96/go-server/sports-keypoints-proto"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// Estimates the pose in an image, implemented by the computervision grpc client and the fixture record/replay backends
+type PoseEstimator interface {
+	GetPoseData(ctx context.Context, img []byte) (*skp.GetPoseDataResponse, error)
+	GetPoseAll(ctx context.Context, img []byte) (*skp.GetPoseAllResponse, error)
+}
+
+// Pose estimator for computervision.mode, record and replay read and write fixtures in computervision.fixture_dir.
+// The grpc client is behind the pose result cache if it is enabled, replayed fixtures are not cached.
+func (c *CvClientManager) PoseEstimator() PoseEstimator {
+	var estimator PoseEstimator = c
+	if c.cache != nil {
+		estimator = c.cache
+	}
+	switch c.config.Mode {
+	case "record":
+		return &fixtureRecorder{estimator: estimator, fixtures: fixtureDir(c.config.FixtureDir)}
+	case "replay":
+		return &fixtureReplayer{fixtures: fixtureDir(c.config.FixtureDir)}
+	}
+	return estimator
+}
+
+// Saves the responses of the wrapped estimator as fixtures, failed calls are not saved
+type fixtureRecorder struct {
+	estimator PoseEstimator
+	fixtures  fixtureDir
+}
+
+func (r *fixtureRecorder) GetPoseData(ctx context.Context, img []byte) (*skp.GetPoseDataResponse, error) {
+	response, err := r.estimator.GetPoseData(ctx, img)
+	if err != nil {
+		return nil, err
+	}
+	r.record(ctx, "GetPoseData", img, response)
+	return response, nil
+}
+
+func (r *fixtureRecorder) GetPoseAll(ctx context.Context, img []byte) (*skp.GetPoseAllResponse, error) {
+	response, err := r.estimator.GetPoseAll(ctx, img)
+	if err != nil {
+		return nil, err
+	}
+	r.record(ctx, "GetPoseAll", img, response)
+	return response, nil
+}
+
+// The response is returned even if it can not be saved
+func (r *fixtureRecorder) record(ctx context.Context, method string, img []byte, response proto.Message) {
+	if err := r.fixtures.save(method, img, response); err != nil {
+		logger.WarnContext(ctx, "could not record computervision fixture", "method", method, "error", err)
+		return
+	}
+	logger.DebugContext(ctx, "recorded computervision fixture", "method", method, "path", r.fixtures.path(method, img))
+}
+
+// Serves recorded responses without computervision, images that were not recorded fail like an unreachable computervision
+type fixtureReplayer struct {
+	fixtures fixtureDir
+}
+
+func (r *fixtureReplayer) GetPoseData(ctx context.Context, img []byte) (*skp.GetPoseDataResponse, error) {
+	response := &skp.GetPoseDataResponse{}
+	if err := r.fixtures.load("GetPoseData", img, response); err != nil {
+		return nil, cvCallError("GetPoseData", err)
+	}
+	// fixtures can hold the landmarks of any pose model, eg. written by hand
+	if err := convertPoseDataResponse("GetPoseData", response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (r *fixtureReplayer) GetPoseAll(ctx context.Context, img []byte) (*skp.GetPoseAllResponse, error) {
+	response := &skp.GetPoseAllResponse{}
+	if err := r.fixtures.load("GetPoseAll", img, response); err != nil {
+		return nil, cvCallError("GetPoseAll", err)
+	}
+	if err := convertPoseAllResponse("GetPoseAll", response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// Fixtures are json responses named <sha256 of the image>.<method>.json so they can be read and edited by hand
+type fixtureDir string
+
+func (f fixtureDir) path(method string, img []byte) string {
+	sum := sha256.Sum256(img)
+	return filepath.Join(string(f), hex.EncodeToString(sum[:])+"."+method+".json")
+}
+
+func (f fixtureDir) save(method string, img []byte, response proto.Message) error {
+	data, err := protojson.MarshalOptions{Multiline: true}.Marshal(response)
+	if err != nil {
+		return fmt.Errorf("could not marshal response: %w", err)
+	}
+	// written to a temporary file first so a replay never reads a partial fixture
+	tmp, err := os.CreateTemp(string(f), ".fixture-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), f.path(method, img))
+}
+
+func (f fixtureDir) load(method string, img []byte, response proto.Message) error {
+	path := f.path(method, img)
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("no recorded response for the image: %w", err)
+	}
+	if err := protojson.Unmarshal(data, response); err != nil {
+		return fmt.Errorf("could not parse fixture %s: %w", path, err)
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+package cvclient
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/sirfrank96/go-server/apierror"
+	skp "github.com/sirfrank96/go-server/sports-keypoints-proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+)
+
+// Returns the same keypoints for every image, fails if err is set
+type fakePoseEstimator struct {
+	calls int
+	err   error
+}
+
+func (f *fakePoseEstimator) GetPoseData(ctx context.Context, img []byte) (*skp.GetPoseDataResponse, error) {
+	f.calls++
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &skp.GetPoseDataResponse{Success: true, Keypoints: &skp.Body25PoseKeypoints{Nose: &skp.Keypoint{X: 10, Y: 20, Confidence: 0.9}}}, nil
+}
+
+func (f *fakePoseEstimator) GetPoseAll(ctx context.Context, img []byte) (*skp.GetPoseAllResponse, error) {
+	f.calls++
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &skp.GetPoseAllResponse{Success: true, Image: []byte("output"), PoseKeypoints: &skp.Body25PoseKeypoints{Neck: &skp.Keypoint{X: 1, Y: 2, Confidence: 0.5}}}, nil
+}
+
+func TestRecordAndReplay(t *testing.T) {
+	ctx := context.Background()
+	fixtures := fixtureDir(t.TempDir())
+	estimator := &fakePoseEstimator{}
+	recorder := &fixtureRecorder{estimator: estimator, fixtures: fixtures}
+	replayer := &fixtureReplayer{fixtures: fixtures}
+	image := []byte("image1")
+	recordedData, err := recorder.GetPoseData(ctx, image)
+	if err != nil {
+		t.Fatalf("GetPoseData had an unexpected error while recording: %s", err.Error())
+	}
+	recordedAll, err := recorder.GetPoseAll(ctx, image)
+	if err != nil {
+		t.Fatalf("GetPoseAll had an unexpected error while recording: %s", err.Error())
+	}
+	replayedData, err := replayer.GetPoseData(ctx, image)
+	if err != nil || !proto.Equal(replayedData, recordedData) {
+		t.Errorf("replayed GetPoseData returned %v, %v, expected the recorded response %v", replayedData, err, recordedData)
+	}
+	replayedAll, err := replayer.GetPoseAll(ctx, image)
+	if err != nil || !proto.Equal(replayedAll, recordedAll) {
+		t.Errorf("replayed GetPoseAll returned %v, %v, expected the recorded response %v", replayedAll, err, recordedAll)
+	}
+	// images that were not recorded fail like computervision being down
+	if _, err := replayer.GetPoseData(ctx, []byte("image2")); apierror.Code(err) != codes.Unavailable {
+		t.Errorf("replayed GetPoseData of an unrecorded image returned %v, expected %s", err, codes.Unavailable)
+	}
+	// failed calls are not recorded
+	estimator.err = errors.New("computervision is down")
+	if _, err := recorder.GetPoseData(ctx, []byte("image3")); err == nil {
+		t.Errorf("GetPoseData is supposed to return the estimator's error while recording")
+	}
+	if _, err := replayer.GetPoseData(ctx, []byte("image3")); err == nil {
+		t.Errorf("a failed call was recorded")
+	}
+}
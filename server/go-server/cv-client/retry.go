@@ -0,0 +1,84 @@
+package cvclient
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"strings"
+	"time"
+
+	"github.com/sirfrank96/go-server/config"
+	"github.com/sirfrank96/go-server/metrics"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Parses computervision.timeouts (method=duration, comma separated)
+func parseMethodTimeouts(timeouts string) (map[string]time.Duration, error) {
+	res := map[string]time.Duration{}
+	if timeouts == "" {
+		return res, nil
+	}
+	for _, methodTimeout := range strings.Split(timeouts, ",") {
+		method, timeout, ok := strings.Cut(strings.TrimSpace(methodTimeout), "=")
+		if !ok || method == "" {
+			return nil, fmt.Errorf("invalid method timeout %s, expected method=duration", methodTimeout)
+		}
+		d, err := time.ParseDuration(timeout)
+		if err != nil || d <= 0 {
+			return nil, fmt.Errorf("invalid timeout %s for method %s", timeout, method)
+		}
+		res[method] = d
+	}
+	return res, nil
+}
+
+// Timeout of a whole call including its retries, the caller's deadline still applies if it is earlier
+func (c *CvClientManager) timeout(method string, defaultTimeout time.Duration) time.Duration {
+	if timeout, ok := c.timeouts[method]; ok {
+		return timeout
+	}
+	return defaultTimeout
+}
+
+// Transient errors where another attempt (likely on another backend) can succeed
+func isRetryable(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.ResourceExhausted:
+		return true
+	}
+	return false
+}
+
+// Exponential backoff with jitter between half and all of the backoff, so retries of many calls do not line up
+func retryBackoff(retryConfig config.RetryConfig, attempt int) time.Duration {
+	backoff := retryConfig.InitialBackoff
+	for i := 1; i < attempt && backoff < retryConfig.MaxBackoff; i++ {
+		backoff *= 2
+	}
+	backoff = min(backoff, retryConfig.MaxBackoff)
+	return backoff/2 + rand.N(backoff/2+1)
+}
+
+// Sends a call until it succeeds, fails with an error that is not retryable, runs out of attempts or the next
+// attempt would start after the deadline. Pose calls do not change anything so they are safe to send again.
+func (c *CvClientManager) withRetries(ctx context.Context, method string, call func() error) error {
+	for attempt := 1; ; attempt++ {
+		err := call()
+		if err == nil || attempt >= c.config.Retry.MaxAttempts || !isRetryable(err) {
+			return err
+		}
+		backoff := retryBackoff(c.config.Retry, attempt)
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < backoff {
+			return err
+		}
+		logger.DebugContext(ctx, "retrying computervision call", "method", method, "attempt", attempt, "backoff", backoff, "error", err)
+		metrics.IncCvRetry(method)
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(backoff):
+		}
+	}
+}
@@ -0,0 +1,231 @@
+package cvclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/sirfrank96/go-server/apierror"
+	skp "github.com/sirfrank96/go-server/sports-keypoints-proto"
+)
+
+// Set on frames computervision answered without success, the stream goes on with the next frame
+var ErrFrameFailed = errors.New("computervision could not estimate the pose in the frame")
+
+// Response for one frame of a video stream
+type Frame[T any] struct {
+	// position of the frame in the input, frames are received in input order
+	Index    int
+	Response T
+	// set if this frame failed (eg. ErrFrameFailed or an empty frame), other frames are not affected
+	Err error
+}
+
+// Responses of a video stream, received like a grpc stream
+type FrameStream[T any] struct {
+	frames chan Frame[T]
+	// the error that ended the stream, set before frames is closed
+	err    error
+	cancel context.CancelFunc
+}
+
+// Returns the next frame in input order, io.EOF once every frame was received, or the error that ended the stream.
+// Responses are only read from computervision as fast as they are received here.
+func (s *FrameStream[T]) Recv() (Frame[T], error) {
+	frame, ok := <-s.frames
+	if !ok {
+		if s.err != nil {
+			return Frame[T]{}, s.err
+		}
+		return Frame[T]{}, io.EOF
+	}
+	return frame, nil
+}
+
+// Cancels the stream, needed if the caller stops receiving before io.EOF
+func (s *FrameStream[T]) Close() {
+	s.cancel()
+}
+
+// Estimates the pose in every frame read from frames until it is closed. Frames are only read while fewer than
+// computervision.video_window frames are waiting for a response, so the producer should stop when ctx is done.
+func (c *CvClientManager) GetPoseImagesFromVideo(ctx context.Context, frames <-chan []byte) *FrameStream[*skp.GetPoseImageResponse] {
+	return streamFrames(c, ctx, "GetPoseImagesFromVideo", frames,
+		func(img []byte) *skp.GetPoseImageRequest { return &skp.GetPoseImageRequest{Image: img} }, nil,
+		func(ctx context.Context, client skp.ComputerVisionServiceClient) (frameClientStream[*skp.GetPoseImageRequest, *skp.GetPoseImageResponse], error) {
+			return client.GetPoseImagesFromVideo(ctx)
+		})
+}
+
+func (c *CvClientManager) GetPoseDataFromVideo(ctx context.Context, frames <-chan []byte) *FrameStream[*skp.GetPoseDataResponse] {
+	return streamFrames(c, ctx, "GetPoseDataFromVideo", frames,
+		func(img []byte) *skp.GetPoseDataRequest { return &skp.GetPoseDataRequest{Image: img} },
+		func(response *skp.GetPoseDataResponse) error {
+			return convertPoseDataResponse("GetPoseDataFromVideo", response)
+		},
+		func(ctx context.Context, client skp.ComputerVisionServiceClient) (frameClientStream[*skp.GetPoseDataRequest, *skp.GetPoseDataResponse], error) {
+			return client.GetPoseDataFromVideo(ctx)
+		})
+}
+
+func (c *CvClientManager) GetPoseAllFromVideo(ctx context.Context, frames <-chan []byte) *FrameStream[*skp.GetPoseAllResponse] {
+	return streamFrames(c, ctx, "GetPoseAllFromVideo", frames,
+		func(img []byte) *skp.GetPoseAllRequest { return &skp.GetPoseAllRequest{Image: img} },
+		func(response *skp.GetPoseAllResponse) error {
+			return convertPoseAllResponse("GetPoseAllFromVideo", response)
+		},
+		func(ctx context.Context, client skp.ComputerVisionServiceClient) (frameClientStream[*skp.GetPoseAllRequest, *skp.GetPoseAllResponse], error) {
+			return client.GetPoseAllFromVideo(ctx)
+		})
+}
+
+// The generated computervision video streams
+type frameClientStream[Req any, Resp any] interface {
+	Send(Req) error
+	Recv() (Resp, error)
+	CloseSend() error
+}
+
+type poseResponse interface {
+	GetSuccess() bool
+}
+
+// Runs a video stream on one backend until every frame is answered, the stream fails or ctx is done. Streams are not
+// retried, frames that were already sent would be estimated twice. convert (if set) converts each successful response
+// to body25 keypoints.
+func streamFrames[Req any, Resp poseResponse](c *CvClientManager, parent context.Context, method string, frames <-chan []byte, newRequest func(img []byte) Req, convert func(response Resp) error, open func(ctx context.Context, client skp.ComputerVisionServiceClient) (frameClientStream[Req, Resp], error)) *FrameStream[Resp] {
+	ctx, cancel := context.WithTimeout(parent, c.timeout(method, c.config.VideoTimeout))
+	s := &FrameStream[Resp]{frames: make(chan Frame[Resp]), cancel: cancel}
+	go func() {
+		defer close(s.frames)
+		defer cancel()
+		if c.config.Mode == "replay" {
+			s.err = cvCallError(method, fmt.Errorf("video streams are not available in replay mode"))
+			return
+		}
+		if c.pool == nil {
+			s.err = cvCallError(method, fmt.Errorf("computervision client is not started"))
+			return
+		}
+		done, err := c.breaker.allow(parent)
+		if err != nil {
+			s.err = err
+			return
+		}
+		b, err := c.pool.acquire(ctx)
+		if err != nil {
+			done(err)
+			s.err = cvCallError(method, err)
+			return
+		}
+		// the backend is released with the result of the whole stream
+		var streamErr error
+		defer func() {
+			c.pool.release(b, backendError(parent, streamErr))
+			done(streamErr)
+			if streamErr != nil {
+				s.err = cvCallError(method, streamErr)
+			}
+		}()
+		stream, err := open(ctx, b.client)
+		if err != nil {
+			streamErr = err
+			return
+		}
+		streamErr = pumpFrames(ctx, stream, frames, c.config.VideoWindow, c.config.Messages.MaxSendSize, newRequest, convert, s.frames)
+		if streamErr != nil {
+			logger.WarnContext(ctx, "computervision video stream failed", "method", method, "error", streamErr)
+		}
+	}()
+	return s
+}
+
+// A frame that was sent (or rejected without sending it) and waits for its turn in the results
+type pendingFrame struct {
+	index int
+	err   error
+}
+
+// Sends frames on stream while at most window frames wait for a response, and delivers the responses to results in
+// frame order. Computervision answers the frames of a stream in the order they were sent. Returns the error that
+// ended the stream, or nil once every frame was delivered.
+func pumpFrames[Req any, Resp poseResponse](ctx context.Context, stream frameClientStream[Req, Resp], frames <-chan []byte, window int, maxFrameSize int, newRequest func(img []byte) Req, convert func(response Resp) error, results chan<- Frame[Resp]) error {
+	ctx, cancel := context.WithCancel(ctx)
+	// stops the sender if the stream ends early
+	defer cancel()
+	pending := make(chan pendingFrame, window)
+	sendErr := make(chan error, 1)
+	go func() {
+		defer close(pending)
+		for index := 0; ; index++ {
+			var img []byte
+			var ok bool
+			select {
+			case <-ctx.Done():
+				sendErr <- ctx.Err()
+				return
+			case img, ok = <-frames:
+			}
+			if !ok {
+				sendErr <- stream.CloseSend()
+				return
+			}
+			frame := pendingFrame{index: index}
+			if len(img) == 0 {
+				frame.err = apierror.InvalidArgument("image", "frame %d is empty", index)
+			} else if len(img) > maxFrameSize {
+				frame.err = apierror.InvalidArgument("image", "frame %d is larger than %d bytes", index, maxFrameSize)
+			}
+			// blocks while the window is full
+			select {
+			case <-ctx.Done():
+				sendErr <- ctx.Err()
+				return
+			case pending <- frame:
+			}
+			if frame.err != nil {
+				continue
+			}
+			// io.EOF means the stream failed, the receiver gets the actual error from Recv
+			if err := stream.Send(newRequest(img)); err != nil {
+				sendErr <- err
+				return
+			}
+		}
+	}()
+	for frame := range pending {
+		result := Frame[Resp]{Index: frame.index, Err: frame.err}
+		if frame.err == nil {
+			response, err := stream.Recv()
+			if err == io.EOF {
+				return fmt.Errorf("computervision ended the stream before answering frame %d", frame.index)
+			}
+			if err != nil {
+				return fmt.Errorf("could not receive frame %d: %w", frame.index, err)
+			}
+			result.Response = response
+			if !response.GetSuccess() {
+				result.Err = fmt.Errorf("frame %d: %w", frame.index, ErrFrameFailed)
+			} else if convert != nil {
+				result.Err = convert(response)
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case results <- result:
+		}
+	}
+	// pending is closed after the sender's result is set
+	if err := <-sendErr; err != nil && err != io.EOF {
+		return fmt.Errorf("could not send frame: %w", err)
+	}
+	if _, err := stream.Recv(); err != io.EOF {
+		if err == nil {
+			return fmt.Errorf("computervision sent more responses than frames")
+		}
+		return fmt.Errorf("could not end the stream: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,188 @@
+package cvclient
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/sirfrank96/go-server/apierror"
+	skp "github.com/sirfrank96/go-server/sports-keypoints-proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Answers every frame in the order it was sent, frames containing "bad" are answered without success
+type fakeFrameStream struct {
+	answers chan *skp.GetPoseDataResponse
+	// Recv fails with this error after failAfter responses
+	failAfter int
+	failErr   error
+	received  int
+}
+
+func newFakeFrameStream() *fakeFrameStream {
+	return &fakeFrameStream{answers: make(chan *skp.GetPoseDataResponse, 100), failAfter: -1}
+}
+
+func (f *fakeFrameStream) Send(req *skp.GetPoseDataRequest) error {
+	f.answers <- &skp.GetPoseDataResponse{Success: string(req.Image) != "bad"}
+	return nil
+}
+
+func (f *fakeFrameStream) Recv() (*skp.GetPoseDataResponse, error) {
+	if f.received == f.failAfter {
+		return nil, f.failErr
+	}
+	answer, ok := <-f.answers
+	if !ok {
+		return nil, io.EOF
+	}
+	f.received++
+	return answer, nil
+}
+
+func (f *fakeFrameStream) CloseSend() error {
+	close(f.answers)
+	return nil
+}
+
+func newPoseDataRequest(img []byte) *skp.GetPoseDataRequest {
+	return &skp.GetPoseDataRequest{Image: img}
+}
+
+// Sends frames on an unbuffered channel and counts the frames that were read
+func produceFrames(ctx context.Context, images [][]byte, read *atomic.Int32) <-chan []byte {
+	frames := make(chan []byte)
+	go func() {
+		defer close(frames)
+		for _, img := range images {
+			select {
+			case <-ctx.Done():
+				return
+			case frames <- img:
+				read.Add(1)
+			}
+		}
+	}()
+	return frames
+}
+
+func TestPumpFramesKeepsOrderAndFrameErrors(t *testing.T) {
+	ctx := context.Background()
+	images := [][]byte{[]byte("frame0"), []byte("bad"), {}, []byte("frame3"), []byte("too large frame"), []byte("frame5")}
+	var read atomic.Int32
+	results := make(chan Frame[*skp.GetPoseDataResponse])
+	errc := make(chan error, 1)
+	go func() {
+		errc <- pumpFrames(ctx, newFakeFrameStream(), produceFrames(ctx, images, &read), 2, 10, newPoseDataRequest, nil, results)
+		close(results)
+	}()
+	var frames []Frame[*skp.GetPoseDataResponse]
+	for frame := range results {
+		frames = append(frames, frame)
+	}
+	if err := <-errc; err != nil {
+		t.Fatalf("pumpFrames had an unexpected error: %s", err.Error())
+	}
+	if len(frames) != len(images) {
+		t.Fatalf("received %d frames, expected %d", len(frames), len(images))
+	}
+	for i, frame := range frames {
+		if frame.Index != i {
+			t.Errorf("frame %d was received at position %d", frame.Index, i)
+		}
+	}
+	if !errors.Is(frames[1].Err, ErrFrameFailed) || frames[1].Response == nil {
+		t.Errorf("frame without success had error %v, expected ErrFrameFailed with its response", frames[1].Err)
+	}
+	for _, i := range []int{2, 4} {
+		if apierror.Code(frames[i].Err) != codes.InvalidArgument || frames[i].Response != nil {
+			t.Errorf("frame %d had error %v, expected InvalidArgument without sending it", i, frames[i].Err)
+		}
+	}
+	for _, i := range []int{0, 3, 5} {
+		if frames[i].Err != nil || !frames[i].Response.GetSuccess() {
+			t.Errorf("frame %d had error %v, expected success", i, frames[i].Err)
+		}
+	}
+}
+
+func TestPumpFramesBackpressure(t *testing.T) {
+	ctx := context.Background()
+	images := make([][]byte, 20)
+	for i := range images {
+		images[i] = []byte("frame")
+	}
+	var read atomic.Int32
+	results := make(chan Frame[*skp.GetPoseDataResponse])
+	errc := make(chan error, 1)
+	go func() {
+		errc <- pumpFrames(ctx, newFakeFrameStream(), produceFrames(ctx, images, &read), 3, 1<<20, newPoseDataRequest, nil, results)
+		close(results)
+	}()
+	// nothing is received yet, so only the window and the frames held by the sender and receiver are read
+	time.Sleep(100 * time.Millisecond)
+	if n := read.Load(); n > 3+2 {
+		t.Errorf("%d frames were read before any response was received, expected at most %d", n, 3+2)
+	}
+	count := 0
+	for range results {
+		count++
+	}
+	if err := <-errc; err != nil || count != len(images) {
+		t.Errorf("received %d frames with error %v, expected %d frames", count, err, len(images))
+	}
+}
+
+func TestPumpFramesCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	// the producer never closes frames
+	frames := make(chan []byte)
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case frames <- []byte("frame"):
+			}
+		}
+	}()
+	results := make(chan Frame[*skp.GetPoseDataResponse])
+	errc := make(chan error, 1)
+	go func() {
+		errc <- pumpFrames(ctx, newFakeFrameStream(), frames, 2, 1<<20, newPoseDataRequest, nil, results)
+	}()
+	if frame := <-results; frame.Index != 0 || frame.Err != nil {
+		t.Errorf("first frame was %d with error %v, expected frame 0", frame.Index, frame.Err)
+	}
+	cancel()
+	select {
+	case err := <-errc:
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("cancelled stream ended with %v, expected context.Canceled", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("pumpFrames did not return after its context was cancelled")
+	}
+}
+
+func TestPumpFramesStreamError(t *testing.T) {
+	ctx := context.Background()
+	stream := newFakeFrameStream()
+	stream.failAfter = 1
+	stream.failErr = status.Error(codes.Unavailable, "connection lost")
+	images := [][]byte{[]byte("frame0"), []byte("frame1"), []byte("frame2")}
+	var read atomic.Int32
+	results := make(chan Frame[*skp.GetPoseDataResponse], len(images))
+	err := pumpFrames(ctx, stream, produceFrames(ctx, images, &read), 2, 1<<20, newPoseDataRequest, nil, results)
+	if status.Code(err) != codes.Unavailable {
+		t.Errorf("stream ended with %v, expected the Unavailable error of Recv", err)
+	}
+	if len(results) != 1 {
+		t.Errorf("%d frames were received before the stream failed, expected 1", len(results))
+	}
+}
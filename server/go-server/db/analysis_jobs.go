@@ -0,0 +1,211 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	mongodb "go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/sirfrank96/go-server/apierror"
+	skp "github.com/sirfrank96/go-server/sports-keypoints-proto"
+)
+
+// A queued CalculateGolfKeypoints, the golf keypoints of a done job are stored for its input image
+type AnalysisJob struct {
+	Id           primitive.ObjectID   `bson:"_id,omitempty"`
+	UserId       string               `bson:"user_id,omitempty"`
+	OrgId        string               `bson:"org_id,omitempty"`
+	InputImageId string               `bson:"input_image_id,omitempty"`
+	State        skp.AnalysisJobState `bson:"state"`
+	Attempts     int32                `bson:"attempts"`
+	Error        string               `bson:"error,omitempty"`
+	ErrorCode    int32                `bson:"error_code,omitempty"`
+	// queued jobs are not claimed before run_after, set when a job is queued again after a transient error
+	RunAfter time.Time `bson:"run_after"`
+	// set while running, a running job whose lease expired (eg. its server stopped) is claimed again
+	LeaseExpiresAt time.Time `bson:"lease_expires_at,omitempty"`
+	CreatedAt      time.Time `bson:"created_at"`
+	UpdatedAt      time.Time `bson:"updated_at"`
+	// set once the job is done or failed, removed by a ttl index
+	ExpiresAt time.Time `bson:"expires_at,omitempty"`
+}
+
+func (d *DbManager) CreateAnalysisJob(ctx context.Context, job *AnalysisJob) (*AnalysisJob, error) {
+	ctx, endOperation := startOperation(ctx, "CreateAnalysisJob")
+	defer endOperation()
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	logger.DebugContext(ctx, "creating analysis job", "input_image_id", job.InputImageId)
+	now := time.Now()
+	job.State = skp.AnalysisJobState_ANALYSIS_JOB_QUEUED
+	job.CreatedAt = now
+	job.RunAfter = now
+	job.UpdatedAt = now
+	res, err := d.analysisJobCollection.InsertOne(ctx, job)
+	if err != nil {
+		return nil, fmt.Errorf("could not create analysis job: %w", err)
+	}
+	job.Id = res.InsertedID.(primitive.ObjectID)
+	logger.DebugContext(ctx, "created analysis job", "job_id", job.Id.Hex(), "input_image_id", job.InputImageId)
+	return job, nil
+}
+
+func (d *DbManager) ReadAnalysisJob(ctx context.Context, orgId string, jobId string) (*AnalysisJob, error) {
+	ctx, endOperation := startOperation(ctx, "ReadAnalysisJob")
+	defer endOperation()
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	objectId, err := primitive.ObjectIDFromHex(jobId)
+	if err != nil {
+		return nil, apierror.InvalidArgument("job_id", "could not convert id %s to object id", jobId)
+	}
+	filter := withOrgFilter(orgId, bson.M{"_id": objectId})
+	var job AnalysisJob
+	if err := d.analysisJobCollection.FindOne(ctx, filter).Decode(&job); err != nil {
+		if err == mongodb.ErrNoDocuments {
+			return nil, apierror.NotFound("no analysis job with id: %s", jobId)
+		}
+		return nil, fmt.Errorf("could not read analysis job: %w", err)
+	}
+	return &job, nil
+}
+
+// Marks the oldest queued job (or running job whose lease expired) as running until leaseExpiresAt and counts the
+// attempt. A job whose lease expired after maxAttempts is not claimed again, so a job that crashes its server can not
+// crash every server it is claimed by (see FailAbandonedAnalysisJobs). Returns nil if there is no job to run.
+func (d *DbManager) ClaimAnalysisJob(ctx context.Context, leaseExpiresAt time.Time, maxAttempts int32) (*AnalysisJob, error) {
+	ctx, endOperation := startOperation(ctx, "ClaimAnalysisJob")
+	defer endOperation()
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	now := time.Now()
+	filter := bson.M{
+		"$or": bson.A{
+			bson.M{"state": skp.AnalysisJobState_ANALYSIS_JOB_QUEUED, "run_after": bson.M{"$lte": now}},
+			bson.M{"state": skp.AnalysisJobState_ANALYSIS_JOB_RUNNING, "lease_expires_at": bson.M{"$lt": now}, "attempts": bson.M{"$lt": maxAttempts}},
+		},
+	}
+	update := bson.M{
+		"$set": bson.M{
+			"state":            skp.AnalysisJobState_ANALYSIS_JOB_RUNNING,
+			"lease_expires_at": leaseExpiresAt,
+			"updated_at":       now,
+		},
+		"$inc": bson.M{"attempts": 1},
+	}
+	opts := options.FindOneAndUpdate().SetSort(bson.D{{Key: "created_at", Value: 1}}).SetReturnDocument(options.After)
+	var job AnalysisJob
+	if err := d.analysisJobCollection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&job); err != nil {
+		if err == mongodb.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("could not claim analysis job: %w", err)
+	}
+	logger.DebugContext(ctx, "claimed analysis job", "job_id", job.Id.Hex(), "attempts", job.Attempts)
+	return &job, nil
+}
+
+// Fails running jobs whose lease expired after maxAttempts, eg. a job that crashed the server every time it ran. They
+// are kept until expiresAt. Returns the number of failed jobs.
+func (d *DbManager) FailAbandonedAnalysisJobs(ctx context.Context, maxAttempts int32, expiresAt time.Time) (int64, error) {
+	ctx, endOperation := startOperation(ctx, "FailAbandonedAnalysisJobs")
+	defer endOperation()
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	now := time.Now()
+	filter := bson.M{
+		"state":            skp.AnalysisJobState_ANALYSIS_JOB_RUNNING,
+		"lease_expires_at": bson.M{"$lt": now},
+		"attempts":         bson.M{"$gte": maxAttempts},
+	}
+	update := bson.M{
+		"$set": bson.M{
+			"state":      skp.AnalysisJobState_ANALYSIS_JOB_FAILED,
+			"error":      fmt.Sprintf("analysis job was abandoned by its server %d times", maxAttempts),
+			"error_code": int32(codes.Internal),
+			"updated_at": now,
+			"expires_at": expiresAt,
+		},
+		"$unset": bson.M{"lease_expires_at": ""},
+	}
+	res, err := d.analysisJobCollection.UpdateMany(ctx, filter, update)
+	if err != nil {
+		return 0, fmt.Errorf("could not fail abandoned analysis jobs: %w", err)
+	}
+	return res.ModifiedCount, nil
+}
+
+// Queues a claimed job again after it failed with a transient error (or its server stopped), it is not claimed before
+// runAfter. Returns NotFound if the job was claimed again in the meantime (its lease expired) so the newer attempt wins.
+func (d *DbManager) RequeueAnalysisJob(ctx context.Context, job *AnalysisJob, runAfter time.Time, errorCode int32, errorMessage string) error {
+	ctx, endOperation := startOperation(ctx, "RequeueAnalysisJob")
+	defer endOperation()
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	logger.DebugContext(ctx, "requeueing analysis job", "job_id", job.Id.Hex(), "run_after", runAfter)
+	update := bson.M{
+		"$set": bson.M{
+			"state":      skp.AnalysisJobState_ANALYSIS_JOB_QUEUED,
+			"run_after":  runAfter,
+			"error":      errorMessage,
+			"error_code": errorCode,
+			"updated_at": time.Now(),
+		},
+		"$unset": bson.M{"lease_expires_at": ""},
+	}
+	return d.updateClaimedAnalysisJob(ctx, job, update)
+}
+
+// Moves a claimed job to done or failed (with its error), it is kept until expiresAt. Returns NotFound if the job was
+// claimed again in the meantime.
+func (d *DbManager) FinishAnalysisJob(ctx context.Context, job *AnalysisJob, state skp.AnalysisJobState, errorCode int32, errorMessage string, expiresAt time.Time) error {
+	ctx, endOperation := startOperation(ctx, "FinishAnalysisJob")
+	defer endOperation()
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	logger.DebugContext(ctx, "finishing analysis job", "job_id", job.Id.Hex(), "state", state.String())
+	update := bson.M{
+		"$set": bson.M{
+			"state":      state,
+			"error":      errorMessage,
+			"error_code": errorCode,
+			"updated_at": time.Now(),
+			"expires_at": expiresAt,
+		},
+		"$unset": bson.M{"lease_expires_at": ""},
+	}
+	return d.updateClaimedAnalysisJob(ctx, job, update)
+}
+
+// d.mutex must be held, only the attempt that claimed the job last can update it
+func (d *DbManager) updateClaimedAnalysisJob(ctx context.Context, job *AnalysisJob, update bson.M) error {
+	filter := bson.M{"_id": job.Id, "attempts": job.Attempts, "state": skp.AnalysisJobState_ANALYSIS_JOB_RUNNING}
+	res, err := d.analysisJobCollection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return fmt.Errorf("could not update analysis job: %w", err)
+	}
+	if res.MatchedCount == 0 {
+		return apierror.NotFound("analysis job %s is no longer claimed by this attempt", job.Id.Hex())
+	}
+	return nil
+}
+
+func ConvertAnalysisJobToCVAnalysisJob(job *AnalysisJob, golfKeypoints *skp.GolfKeypoints) *skp.AnalysisJob {
+	return &skp.AnalysisJob{
+		JobId:         job.Id.Hex(),
+		InputImageId:  job.InputImageId,
+		State:         job.State,
+		Attempts:      job.Attempts,
+		Error:         job.Error,
+		ErrorCode:     job.ErrorCode,
+		GolfKeypoints: golfKeypoints,
+		CreatedAt:     timestamppb.New(job.CreatedAt),
+		UpdatedAt:     timestamppb.New(job.UpdatedAt),
+	}
+}
@@ -2,21 +2,28 @@ package db
 
 import (
 	"context"
-	"flag"
 	"fmt"
-	"log"
-	"os"
 	"sync"
+	"time"
 
+	"go.mongodb.org/mongo-driver/bson"
 	mongodb "go.mongodb.org/mongo-driver/mongo"
 	mongoopts "go.mongodb.org/mongo-driver/mongo/options"
-)
 
-var (
-	dbaddr = flag.String("dbaddr", "mongodb://localhost:27017", "the address to connect to")
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/sirfrank96/go-server/config"
+	"github.com/sirfrank96/go-server/logging"
+	"github.com/sirfrank96/go-server/metrics"
 )
 
+var tracer = otel.Tracer("github.com/sirfrank96/go-server/db")
+
+var logger = logging.Logger("db")
+
 type DbManager struct {
+	config                 config.DatabaseConfig
 	mutex                  sync.Mutex
 	clientOptions          *mongoopts.ClientOptions
 	client                 *mongodb.Client
@@ -24,40 +31,101 @@ type DbManager struct {
 	userCollection         *mongodb.Collection
 	inputImageCollection   *mongodb.Collection
 	golfKeypointCollection *mongodb.Collection
+	organizationCollection *mongodb.Collection
+	// responses of rpcs sent with an idempotency key
+	idempotencyKeyCollection *mongodb.Collection
+	// cached computervision responses
+	poseResultCollection *mongodb.Collection
+	// queued SubmitAnalysis jobs
+	analysisJobCollection *mongodb.Collection
+	// set once the indexes exist, guarded by indexMutex so a slow index build does not hold up other operations
+	indexMutex     sync.Mutex
+	indexesCreated bool
 }
 
-func NewDbManager() *DbManager {
-	d := &DbManager{}
-	log.Printf("New Database Mgr")
+func NewDbManager(databaseConfig config.DatabaseConfig) *DbManager {
+	d := &DbManager{config: databaseConfig}
+	logger.Info("new database mgr")
 	return d
 }
 
 func (d *DbManager) StartMongoDBClient(ctx context.Context) error {
-	log.Printf("Starting MongoDB Client")
-	flag.Parse()
-	mongoURI := os.Getenv("MONGO_URI")
-	if mongoURI == "" {
-		mongoURI = *dbaddr
-	}
+	logger.InfoContext(ctx, "starting mongodb client")
 	// Set client options
-	d.clientOptions = mongoopts.Client().ApplyURI(mongoURI)
+	d.clientOptions = mongoopts.Client().ApplyURI(d.config.Uri)
 	// Connect to MongoDB
 	var err error
 	d.client, err = mongodb.Connect(ctx, d.clientOptions)
 	if err != nil {
 		return fmt.Errorf("could not connect to mongodb %w", err)
 	}
-	// Check the connection
-	err = d.client.Ping(ctx, nil)
-	if err != nil {
-		return fmt.Errorf("could not ping mongodb %w", err)
-	}
 	// Create Database
-	d.db = d.client.Database("golfkeypointsdatabase")
+	d.db = d.client.Database(d.config.Name)
 	// Create Collections
 	d.userCollection = d.db.Collection("users")
 	d.inputImageCollection = d.db.Collection("inputimages")
 	d.golfKeypointCollection = d.db.Collection("golfkeypoints")
+	d.organizationCollection = d.db.Collection("organizations")
+	d.idempotencyKeyCollection = d.db.Collection("idempotencykeys")
+	d.poseResultCollection = d.db.Collection("poseresults")
+	d.analysisJobCollection = d.db.Collection("analysisjobs")
+	// Check the connection, not fatal since the health probe reports mongodb as not serving (and creates the indexes)
+	// until it is reachable
+	if err := d.PingMongoDB(ctx); err != nil {
+		logger.WarnContext(ctx, "could not ping mongodb yet", "error", err)
+	}
+	return nil
+}
+
+// Creates the indexes the first time mongodb is reachable, the ttl indexes keep idempotency keys, pose results and
+// analysis jobs from growing without bound. Creating an index that already exists does nothing.
+func (d *DbManager) ensureIndexes(ctx context.Context) error {
+	d.indexMutex.Lock()
+	defer d.indexMutex.Unlock()
+	if d.indexesCreated {
+		return nil
+	}
+	if err := d.createIndexes(ctx); err != nil {
+		return err
+	}
+	d.indexesCreated = true
+	logger.InfoContext(ctx, "created mongodb indexes")
+	return nil
+}
+
+func (d *DbManager) createIndexes(ctx context.Context) error {
+	expiresAt := mongodb.IndexModel{
+		Keys:    bson.M{"expires_at": 1},
+		Options: mongoopts.Index().SetExpireAfterSeconds(0),
+	}
+	if _, err := d.idempotencyKeyCollection.Indexes().CreateOne(ctx, expiresAt); err != nil {
+		return fmt.Errorf("could not create idempotency key ttl index: %w", err)
+	}
+	if _, err := d.poseResultCollection.Indexes().CreateOne(ctx, expiresAt); err != nil {
+		return fmt.Errorf("could not create pose result ttl index: %w", err)
+	}
+	if _, err := d.analysisJobCollection.Indexes().CreateOne(ctx, expiresAt); err != nil {
+		return fmt.Errorf("could not create analysis job ttl index: %w", err)
+	}
+	// workers claim the oldest queued job
+	queue := mongodb.IndexModel{Keys: bson.D{{Key: "state", Value: 1}, {Key: "created_at", Value: 1}}}
+	if _, err := d.analysisJobCollection.Indexes().CreateOne(ctx, queue); err != nil {
+		return fmt.Errorf("could not create analysis job queue index: %w", err)
+	}
+	return nil
+}
+
+// Health probe for mongodb, mongodb is not serving until its indexes exist
+func (d *DbManager) PingMongoDB(ctx context.Context) error {
+	if d.client == nil {
+		return fmt.Errorf("mongodb client is not started")
+	}
+	if err := d.client.Ping(ctx, nil); err != nil {
+		return fmt.Errorf("could not ping mongodb: %w", err)
+	}
+	if err := d.ensureIndexes(ctx); err != nil {
+		return fmt.Errorf("could not create mongodb indexes: %w", err)
+	}
 	return nil
 }
 
@@ -66,3 +134,13 @@ func (d *DbManager) CloseMongoDBClient(ctx context.Context) error {
 	defer d.mutex.Unlock()
 	return d.client.Disconnect(ctx)
 }
+
+// Starts a span and a latency measurement for a DbManager operation, the returned func ends both
+func startOperation(ctx context.Context, operation string) (context.Context, func()) {
+	start := time.Now()
+	ctx, span := tracer.Start(ctx, "DbManager."+operation, trace.WithSpanKind(trace.SpanKindClient))
+	return ctx, func() {
+		span.End()
+		metrics.ObserveDbOperation(operation, start)
+	}
+}
@@ -10,6 +10,7 @@ import (
 	mongodb "go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 
+	"github.com/sirfrank96/go-server/apierror"
 	skp "github.com/sirfrank96/go-server/sports-keypoints-proto"
 	"github.com/sirfrank96/go-server/util"
 )
@@ -17,18 +18,27 @@ import (
 type GolfKeypoints struct {
 	Id                    primitive.ObjectID        `bson:"_id,omitempty"`
 	UserId                string                    `bson:"user_id,omitempty"`
+	OrgId                 string                    `bson:"org_id,omitempty"`
 	InputImageId          string                    `bson:"input_image_id,omitempty"`
 	OutputImg             []byte                    `bson:"output_img,omitempty"`
 	OutputKeypoints       skp.Body25PoseKeypoints   `bson:"output_keypoints,omitempty"`
 	DtlGolfSetupPoints    skp.DTLGolfSetupPoints    `bson:"dtl_golf_setup_points,omitempty"`
 	FaceonGolfSetupPoints skp.FaceOnGolfSetupPoints `bson:"faceon_golf_setup_points,omitempty"`
+	// pose model the output keypoints were converted from, unspecified is body25
+	KeypointSchema skp.KeypointSchema `bson:"keypoint_schema,omitempty"`
 }
 
 func ConvertGolfKeypointsToCVGolfKeypoints(golfKeypoints *GolfKeypoints) *skp.GolfKeypoints {
+	// golf keypoints stored before schemas were recorded and computervision servers without a schema are body25
+	keypointSchema := golfKeypoints.KeypointSchema
+	if keypointSchema == skp.KeypointSchema_KEYPOINT_SCHEMA_UNSPECIFIED {
+		keypointSchema = skp.KeypointSchema_KEYPOINT_SCHEMA_BODY25
+	}
 	return &skp.GolfKeypoints{
 		DtlGolfSetupPoints:    &golfKeypoints.DtlGolfSetupPoints,
 		FaceonGolfSetupPoints: &golfKeypoints.FaceonGolfSetupPoints,
 		BodyKeypoints:         &golfKeypoints.OutputKeypoints,
+		KeypointSchema:        keypointSchema,
 	}
 }
 
@@ -46,47 +56,53 @@ func UpdateOutputKeypointsFields(oldKeypoints *skp.Body25PoseKeypoints, newKeypo
 	return oldKeypoints
 }
 
+// An input image has at most one golf keypoints, calculating them again replaces the existing ones
 func (d *DbManager) CreateGolfKeypoints(ctx context.Context, golfKeypoints *GolfKeypoints) (*GolfKeypoints, error) {
+	ctx, endOperation := startOperation(ctx, "CreateGolfKeypoints")
+	defer endOperation()
 	d.mutex.Lock()
 	defer d.mutex.Unlock()
-	fmt.Printf("Creating golf keypoint...\n")
-	res, err := d.golfKeypointCollection.InsertOne(ctx, golfKeypoints)
-	if err != nil {
+	logger.DebugContext(ctx, "creating golf keypoints", "input_image_id", golfKeypoints.InputImageId)
+	filter := withOrgFilter(golfKeypoints.OrgId, bson.M{"input_image_id": golfKeypoints.InputImageId})
+	opts := options.FindOneAndReplace().SetUpsert(true).SetReturnDocument(options.After).SetProjection(bson.M{"_id": 1})
+	var created GolfKeypoints
+	if err := d.golfKeypointCollection.FindOneAndReplace(ctx, filter, golfKeypoints, opts).Decode(&created); err != nil {
 		return nil, fmt.Errorf("could not create golf keypoint: %w", err)
 	}
-	objectId, ok := res.InsertedID.(primitive.ObjectID)
-	if !ok {
-		return nil, fmt.Errorf("could create object id")
-	}
-	golfKeypoints.Id = objectId
-	fmt.Printf("Create golf keypoints result: id: %s, userid: %s, inputimgid: %s\n", golfKeypoints.Id, golfKeypoints.UserId, golfKeypoints.InputImageId)
+	golfKeypoints.Id = created.Id
+	logger.DebugContext(ctx, "created golf keypoints", "golf_keypoints_id", golfKeypoints.Id.Hex(), "input_image_id", golfKeypoints.InputImageId)
 	return golfKeypoints, nil
 }
 
-func (d *DbManager) ReadGolfKeypointsForInputImage(ctx context.Context, inputImgId string) (*GolfKeypoints, error) {
+func (d *DbManager) ReadGolfKeypointsForInputImage(ctx context.Context, orgId string, inputImgId string) (*GolfKeypoints, error) {
+	ctx, endOperation := startOperation(ctx, "ReadGolfKeypointsForInputImage")
+	defer endOperation()
 	d.mutex.Lock()
 	defer d.mutex.Unlock()
-	fmt.Printf("Reading golf keypoints for input img id: %s...\n", inputImgId)
-	filter := bson.M{"input_image_id": inputImgId}
+	logger.DebugContext(ctx, "reading golf keypoints", "input_image_id", inputImgId)
+	filter := withOrgFilter(orgId, bson.M{"input_image_id": inputImgId})
 	var golfKeypoints GolfKeypoints
 	if err := d.golfKeypointCollection.FindOne(ctx, filter).Decode(&golfKeypoints); err != nil {
 		if err == mongodb.ErrNoDocuments {
-			return nil, fmt.Errorf("no golf keypoints with imgid: %s", inputImgId)
+			return nil, apierror.NotFound("no golf keypoints with imgid: %s", inputImgId)
 		}
 		return nil, fmt.Errorf("could not read golf keypoints: %w", err)
 	}
-	fmt.Printf("Read golf keypoints result: id: %s, userid: %s, inputimgid: %s\n", golfKeypoints.Id, golfKeypoints.UserId, golfKeypoints.InputImageId)
+	logger.DebugContext(ctx, "read golf keypoints", "golf_keypoints_id", golfKeypoints.Id.Hex(), "input_image_id", golfKeypoints.InputImageId)
 	return &golfKeypoints, nil
 }
 
-func (d *DbManager) UpdateGolfKeypointsForInputImage(ctx context.Context, inputImgId string, newGolfKeypoints *GolfKeypoints) (*GolfKeypoints, error) {
+func (d *DbManager) UpdateGolfKeypointsForInputImage(ctx context.Context, orgId string, inputImgId string, newGolfKeypoints *GolfKeypoints) (*GolfKeypoints, error) {
+	ctx, endOperation := startOperation(ctx, "UpdateGolfKeypointsForInputImage")
+	defer endOperation()
 	d.mutex.Lock()
 	defer d.mutex.Unlock()
-	fmt.Printf("Updating golfkeypoints for inputimgid: %s\n", inputImgId)
-	filter := bson.M{"input_image_id": inputImgId}
+	logger.DebugContext(ctx, "updating golf keypoints", "input_image_id", inputImgId)
+	filter := withOrgFilter(orgId, bson.M{"input_image_id": inputImgId})
 	update := bson.M{
 		"$set": bson.M{
 			"user_id":                  newGolfKeypoints.UserId,
+			"org_id":                   newGolfKeypoints.OrgId,
 			"input_img_id":             newGolfKeypoints.InputImageId,
 			"output_img":               newGolfKeypoints.OutputImg,
 			"output_keypoints":         newGolfKeypoints.OutputKeypoints,
@@ -97,27 +113,29 @@ func (d *DbManager) UpdateGolfKeypointsForInputImage(ctx context.Context, inputI
 	var updatedGolfKeypoints GolfKeypoints
 	if err := d.golfKeypointCollection.FindOneAndUpdate(ctx, filter, update, options.FindOneAndUpdate().SetReturnDocument(options.After)).Decode(&updatedGolfKeypoints); err != nil {
 		if err == mongodb.ErrNoDocuments {
-			return nil, fmt.Errorf("no golfkeypoints with inputimgid: %s", inputImgId)
+			return nil, apierror.NotFound("no golfkeypoints with inputimgid: %s", inputImgId)
 		}
 		return nil, fmt.Errorf("could not update golfkeypoints: %w", err)
 	}
-	fmt.Printf("Updated golf keypoints result: id: %s, userid: %s, inputimgid: %s\n", updatedGolfKeypoints.Id, updatedGolfKeypoints.UserId, updatedGolfKeypoints.InputImageId)
+	logger.DebugContext(ctx, "updated golf keypoints", "golf_keypoints_id", updatedGolfKeypoints.Id.Hex(), "input_image_id", updatedGolfKeypoints.InputImageId)
 	return &updatedGolfKeypoints, nil
 }
 
-func (d *DbManager) DeleteGolfKeypointsForInputImage(ctx context.Context, inputImgId string) error {
+func (d *DbManager) DeleteGolfKeypointsForInputImage(ctx context.Context, orgId string, inputImgId string) error {
+	ctx, endOperation := startOperation(ctx, "DeleteGolfKeypointsForInputImage")
+	defer endOperation()
 	d.mutex.Lock()
 	defer d.mutex.Unlock()
-	warning := d.deleteGolfKeypointsForInputImageHelper(ctx, inputImgId)
+	warning := d.deleteGolfKeypointsForInputImageHelper(ctx, orgId, inputImgId)
 	if warning != nil {
 		return warning
 	}
 	return nil
 }
 
-func (d *DbManager) deleteGolfKeypointsForInputImageHelper(ctx context.Context, inputImgId string) util.Warning {
-	fmt.Printf("Deleting golfkeypoints for inputimgid: %s...\n", inputImgId)
-	filter := bson.M{"input_image_id": inputImgId}
+func (d *DbManager) deleteGolfKeypointsForInputImageHelper(ctx context.Context, orgId string, inputImgId string) util.Warning {
+	logger.DebugContext(ctx, "deleting golf keypoints", "input_image_id", inputImgId)
+	filter := withOrgFilter(orgId, bson.M{"input_image_id": inputImgId})
 	res, err := d.golfKeypointCollection.DeleteOne(ctx, filter)
 	if err != nil {
 		return util.WarningImpl{
@@ -131,6 +149,6 @@ func (d *DbManager) deleteGolfKeypointsForInputImageHelper(ctx context.Context,
 			Message:  fmt.Sprintf("did not delete any golfkeypoints, inputimgid %s may not exist", inputImgId),
 		}
 	}
-	fmt.Printf("Delete golfkeypoints result: inputimgid: %s\n", inputImgId)
+	logger.DebugContext(ctx, "deleted golf keypoints", "input_image_id", inputImgId)
 	return nil
 }
@@ -0,0 +1,116 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	mongodb "go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/sirfrank96/go-server/apierror"
+)
+
+// PreconditionFailure type when an idempotency key is sent again with a different request
+const idempotencyKeyReused = "IDEMPOTENCY_KEY_REUSED"
+
+// Response of an rpc sent with an idempotency key, the record is pending until the rpc completes
+type IdempotencyKey struct {
+	Id string `bson:"_id"`
+	// hash of the request, a key can only be used for one request
+	Fingerprint string `bson:"fingerprint"`
+	Completed   bool   `bson:"completed,omitempty"`
+	Response    []byte `bson:"response,omitempty"`
+	// removed by a ttl index, expired keys are also ignored in case the index has not caught up yet
+	ExpiresAt time.Time `bson:"expires_at"`
+}
+
+// Reserves the key for the request with fingerprint until expiresAt. Returns the stored response if the request
+// already completed, or nil if the caller should execute the request and then complete or release the key.
+func (d *DbManager) ReserveIdempotencyKey(ctx context.Context, key string, fingerprint string, expiresAt time.Time) ([]byte, error) {
+	ctx, endOperation := startOperation(ctx, "ReserveIdempotencyKey")
+	defer endOperation()
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	// mongodb stores milliseconds, the reservation is matched by its expiry when it is completed or released
+	record := &IdempotencyKey{Id: key, Fingerprint: fingerprint, ExpiresAt: expiresAt.Truncate(time.Millisecond)}
+	_, err := d.idempotencyKeyCollection.InsertOne(ctx, record)
+	if err == nil {
+		return nil, nil
+	}
+	if !mongodb.IsDuplicateKeyError(err) {
+		return nil, fmt.Errorf("could not reserve idempotency key: %w", err)
+	}
+	var existing IdempotencyKey
+	if err := d.idempotencyKeyCollection.FindOne(ctx, bson.M{"_id": key}).Decode(&existing); err != nil {
+		return nil, fmt.Errorf("could not read idempotency key: %w", err)
+	}
+	if existing.ExpiresAt.Before(time.Now()) {
+		// only take over the expired key if no other request did in the meantime
+		filter := bson.M{"_id": key, "expires_at": existing.ExpiresAt}
+		res, err := d.idempotencyKeyCollection.ReplaceOne(ctx, filter, record)
+		if err != nil {
+			return nil, fmt.Errorf("could not reserve expired idempotency key: %w", err)
+		}
+		if res.MatchedCount == 1 {
+			return nil, nil
+		}
+		return nil, apierror.Aborted("a request with this idempotency key is in progress, retry later")
+	}
+	if existing.Fingerprint != fingerprint {
+		return nil, apierror.FailedPrecondition(idempotencyKeyReused, "idempotency-key", "idempotency key was already used for a different request")
+	}
+	if !existing.Completed {
+		return nil, apierror.Aborted("a request with this idempotency key is in progress, retry later")
+	}
+	logger.DebugContext(ctx, "found completed idempotency key")
+	return existing.Response, nil
+}
+
+// Stores the response of the request the key was reserved for until expiresAt. reservedUntil is the expiry the key was
+// reserved with, so a request whose reservation was taken over (after it ran past the pending timeout) can not overwrite
+// the new reservation.
+func (d *DbManager) CompleteIdempotencyKey(ctx context.Context, key string, fingerprint string, reservedUntil time.Time, response []byte, expiresAt time.Time) error {
+	ctx, endOperation := startOperation(ctx, "CompleteIdempotencyKey")
+	defer endOperation()
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	update := bson.M{
+		"$set": bson.M{
+			"completed":  true,
+			"response":   response,
+			"expires_at": expiresAt,
+		},
+	}
+	res, err := d.idempotencyKeyCollection.UpdateOne(ctx, reservationFilter(key, fingerprint, reservedUntil), update)
+	if err != nil {
+		return fmt.Errorf("could not complete idempotency key: %w", err)
+	}
+	if res.MatchedCount == 0 {
+		return apierror.NotFound("idempotency key is not reserved by this request")
+	}
+	return nil
+}
+
+// Removes a pending key after its request failed so a retry executes the request again, only if the key is still
+// reserved with reservedUntil (see CompleteIdempotencyKey)
+func (d *DbManager) ReleaseIdempotencyKey(ctx context.Context, key string, fingerprint string, reservedUntil time.Time) error {
+	ctx, endOperation := startOperation(ctx, "ReleaseIdempotencyKey")
+	defer endOperation()
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	if _, err := d.idempotencyKeyCollection.DeleteOne(ctx, reservationFilter(key, fingerprint, reservedUntil)); err != nil {
+		return fmt.Errorf("could not release idempotency key: %w", err)
+	}
+	return nil
+}
+
+// Matches the pending reservation of one request
+func reservationFilter(key string, fingerprint string, reservedUntil time.Time) bson.M {
+	return bson.M{
+		"_id":         key,
+		"fingerprint": fingerprint,
+		"expires_at":  reservedUntil.Truncate(time.Millisecond),
+		"completed":   bson.M{"$ne": true},
+	}
+}
@@ -10,6 +10,7 @@ import (
 	mongodb "go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 
+	"github.com/sirfrank96/go-server/apierror"
 	skp "github.com/sirfrank96/go-server/sports-keypoints-proto"
 	"github.com/sirfrank96/go-server/util"
 )
@@ -17,6 +18,7 @@ import (
 type InputImage struct {
 	Id                           primitive.ObjectID   `bson:"_id,omitempty"`
 	UserId                       string               `bson:"user_id,omitempty"`
+	OrgId                        string               `bson:"org_id,omitempty"`
 	ImageType                    skp.ImageType        `bson:"image_type,omitempty"`
 	InputImg                     []byte               `bson:"input_img,omitempty"`
 	Description                  string               `bson:"description,omitempty"`
@@ -27,9 +29,11 @@ type InputImage struct {
 }
 
 func (d *DbManager) CreateInputImage(ctx context.Context, inputImg *InputImage) (*InputImage, error) {
+	ctx, endOperation := startOperation(ctx, "CreateInputImage")
+	defer endOperation()
 	d.mutex.Lock()
 	defer d.mutex.Unlock()
-	fmt.Printf("Creating input image...\n")
+	logger.DebugContext(ctx, "creating input image", "image_type", inputImg.ImageType.String())
 	res, err := d.inputImageCollection.InsertOne(ctx, inputImg)
 	if err != nil {
 		return nil, fmt.Errorf("could not create input image: %w", err)
@@ -39,23 +43,25 @@ func (d *DbManager) CreateInputImage(ctx context.Context, inputImg *InputImage)
 		return nil, fmt.Errorf("could create object id")
 	}
 	inputImg.Id = objectId
-	fmt.Printf("Create input image result: imgId: %s, userId: %s, imageType: %s\n", inputImg.Id, inputImg.UserId, inputImg.ImageType)
+	logger.DebugContext(ctx, "created input image", "input_image_id", inputImg.Id.Hex(), "image_type", inputImg.ImageType.String())
 	return inputImg, nil
 }
 
-func (d *DbManager) ReadInputImagesForUser(ctx context.Context, userId string) ([]*InputImage, error) {
+func (d *DbManager) ReadInputImagesForUser(ctx context.Context, orgId string, userId string) ([]*InputImage, error) {
+	ctx, endOperation := startOperation(ctx, "ReadInputImagesForUser")
+	defer endOperation()
 	d.mutex.Lock()
 	defer d.mutex.Unlock()
-	return d.readInputImagesForUserHelper(ctx, userId)
+	return d.readInputImagesForUserHelper(ctx, orgId, userId)
 }
 
-func (d *DbManager) readInputImagesForUserHelper(ctx context.Context, userId string) ([]*InputImage, error) {
-	fmt.Printf("Reading input images for user...\n")
-	filter := bson.M{"user_id": userId}
+func (d *DbManager) readInputImagesForUserHelper(ctx context.Context, orgId string, userId string) ([]*InputImage, error) {
+	logger.DebugContext(ctx, "reading input images for user", "user_id", userId)
+	filter := withOrgFilter(orgId, bson.M{"user_id": userId})
 	cursor, err := d.inputImageCollection.Find(ctx, filter)
 	if err != nil {
 		if err == mongodb.ErrNoDocuments {
-			return nil, fmt.Errorf("no input images for user")
+			return nil, apierror.NotFound("no input images for user")
 		}
 		return nil, fmt.Errorf("could not read input images for user: %w", err)
 	}
@@ -71,38 +77,43 @@ func (d *DbManager) readInputImagesForUserHelper(ctx context.Context, userId str
 	return res, nil
 }
 
-func (d *DbManager) ReadInputImage(ctx context.Context, inputImgId string) (*InputImage, error) {
+func (d *DbManager) ReadInputImage(ctx context.Context, orgId string, inputImgId string) (*InputImage, error) {
+	ctx, endOperation := startOperation(ctx, "ReadInputImage")
+	defer endOperation()
 	d.mutex.Lock()
 	defer d.mutex.Unlock()
-	fmt.Printf("Reading input image id: %s...\n", inputImgId)
+	logger.DebugContext(ctx, "reading input image", "input_image_id", inputImgId)
 	objectId, err := primitive.ObjectIDFromHex(inputImgId)
 	if err != nil {
-		return nil, fmt.Errorf("could not convert id to object id %w", err)
+		return nil, apierror.InvalidArgument("input_image_id", "could not convert id %s to object id", inputImgId)
 	}
-	filter := bson.M{"_id": objectId}
+	filter := withOrgFilter(orgId, bson.M{"_id": objectId})
 	var inputImg InputImage
 	if err := d.inputImageCollection.FindOne(ctx, filter).Decode(&inputImg); err != nil {
 		if err == mongodb.ErrNoDocuments {
-			return nil, fmt.Errorf("no input images with id: %s", inputImgId)
+			return nil, apierror.NotFound("no input images with id: %s", inputImgId)
 		}
 		return nil, fmt.Errorf("could not read input image: %w", err)
 	}
-	fmt.Printf("Read input image result: imgId: %s, userId: %s, imageType: %s\n", inputImg.Id, inputImg.UserId, inputImg.ImageType)
+	logger.DebugContext(ctx, "read input image", "input_image_id", inputImg.Id.Hex(), "image_type", inputImg.ImageType.String())
 	return &inputImg, nil
 }
 
-func (d *DbManager) UpdateInputImage(ctx context.Context, inputImgId string, newInputImage *InputImage) (*InputImage, error) {
+func (d *DbManager) UpdateInputImage(ctx context.Context, orgId string, inputImgId string, newInputImage *InputImage) (*InputImage, error) {
+	ctx, endOperation := startOperation(ctx, "UpdateInputImage")
+	defer endOperation()
 	d.mutex.Lock()
 	defer d.mutex.Unlock()
-	fmt.Printf("Updating input image imgid: %s\n", inputImgId)
+	logger.DebugContext(ctx, "updating input image", "input_image_id", inputImgId)
 	objectId, err := primitive.ObjectIDFromHex(inputImgId)
 	if err != nil {
-		return nil, fmt.Errorf("could not convert id to object id %w", err)
+		return nil, apierror.InvalidArgument("input_image_id", "could not convert id %s to object id", inputImgId)
 	}
-	filter := bson.M{"_id": objectId}
+	filter := withOrgFilter(orgId, bson.M{"_id": objectId})
 	update := bson.M{
 		"$set": bson.M{
 			"user_id":                         newInputImage.UserId,
+			"org_id":                          newInputImage.OrgId,
 			"image_type":                      newInputImage.ImageType,
 			"input_img":                       newInputImage.InputImg,
 			"description":                     newInputImage.Description,
@@ -115,45 +126,47 @@ func (d *DbManager) UpdateInputImage(ctx context.Context, inputImgId string, new
 	var updatedInputImage InputImage
 	if err := d.inputImageCollection.FindOneAndUpdate(ctx, filter, update, options.FindOneAndUpdate().SetReturnDocument(options.After)).Decode(&updatedInputImage); err != nil {
 		if err == mongodb.ErrNoDocuments {
-			return nil, fmt.Errorf("no input images with imgid: %s", inputImgId)
+			return nil, apierror.NotFound("no input images with imgid: %s", inputImgId)
 		}
 		return nil, fmt.Errorf("could not update input image: %w", err)
 	}
-	fmt.Printf("Update input image result: imgId: %s, userId: %s, imageType: %s\n", updatedInputImage.Id, updatedInputImage.UserId, updatedInputImage.ImageType)
+	logger.DebugContext(ctx, "updated input image", "input_image_id", updatedInputImage.Id.Hex(), "image_type", updatedInputImage.ImageType.String())
 	return &updatedInputImage, nil
 }
 
-func (d *DbManager) DeleteInputImage(ctx context.Context, inputImgId string) error {
+func (d *DbManager) DeleteInputImage(ctx context.Context, orgId string, inputImgId string) error {
+	ctx, endOperation := startOperation(ctx, "DeleteInputImage")
+	defer endOperation()
 	d.mutex.Lock()
 	defer d.mutex.Unlock()
-	return d.deleteInputImageHelper(ctx, inputImgId)
+	return d.deleteInputImageHelper(ctx, orgId, inputImgId)
 }
 
 // Deletes golfkeypoints associated with input image, then delete input image
-func (d *DbManager) deleteInputImageHelper(ctx context.Context, inputImgId string) error {
-	fmt.Printf("Deleting input image id: %s...\n", inputImgId)
+func (d *DbManager) deleteInputImageHelper(ctx context.Context, orgId string, inputImgId string) error {
+	logger.DebugContext(ctx, "deleting input image", "input_image_id", inputImgId)
 	// first delete keypoints associated with input image
-	warning := d.deleteGolfKeypointsForInputImageHelper(ctx, inputImgId)
+	warning := d.deleteGolfKeypointsForInputImageHelper(ctx, orgId, inputImgId)
 	if warning != nil {
 		if warning.GetSeverity() == util.SEVERE {
 			return fmt.Errorf("could not delete keypoints associated with input img %s: %w", inputImgId, warning.Error())
 		} else {
-			fmt.Printf("Minor warning: %s", warning.Error())
+			logger.WarnContext(ctx, "minor warning while deleting input image", "input_image_id", inputImgId, "warning", warning.Error())
 		}
 	}
 	// delete input image
 	objectId, err := primitive.ObjectIDFromHex(inputImgId)
 	if err != nil {
-		return fmt.Errorf("could not convert id to object id %w", err)
+		return apierror.InvalidArgument("input_image_id", "could not convert id %s to object id", inputImgId)
 	}
-	filter := bson.M{"_id": objectId}
+	filter := withOrgFilter(orgId, bson.M{"_id": objectId})
 	res, err := d.inputImageCollection.DeleteOne(ctx, filter)
 	if err != nil {
 		return fmt.Errorf("could not delete input image %w", err)
 	}
 	if res.DeletedCount == 0 {
-		return fmt.Errorf("did not delete any images, imdId %s may not exist", inputImgId)
+		return apierror.NotFound("did not delete any images, imdId %s may not exist", inputImgId)
 	}
-	fmt.Printf("Delete input image result: imgId: %s\n", inputImgId)
+	logger.DebugContext(ctx, "deleted input image", "input_image_id", inputImgId)
 	return nil
 }
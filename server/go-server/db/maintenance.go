@@ -0,0 +1,188 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	mongodb "go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/sirfrank96/go-server/apierror"
+	skp "github.com/sirfrank96/go-server/sports-keypoints-proto"
+)
+
+// Documents of the maintenance jobs are read and deleted in batches of this many
+const maintenanceBatchSize = 500
+
+// Deletes input images whose user no longer exists and golf keypoints whose input image no longer exists (or is
+// deleted here). Returns how many documents were looked at and how many were (or with dryRun would be) deleted.
+// Orphans are found with $lookup and deleted in batches without holding d.mutex, other operations keep running.
+func (d *DbManager) CleanupOrphans(ctx context.Context, dryRun bool) (int64, int64, error) {
+	ctx, endOperation := startOperation(ctx, "CleanupOrphans")
+	defer endOperation()
+	logger.DebugContext(ctx, "cleaning up orphans", "dry_run", dryRun)
+	numInputImages, err := d.inputImageCollection.EstimatedDocumentCount(ctx)
+	if err != nil {
+		return 0, 0, fmt.Errorf("could not count input images: %w", err)
+	}
+	numGolfKeypoints, err := d.golfKeypointCollection.EstimatedDocumentCount(ctx)
+	if err != nil {
+		return 0, 0, fmt.Errorf("could not count golf keypoints: %w", err)
+	}
+	inputImagePipeline := mongodb.Pipeline{
+		lookupByHexId(d.userCollection.Name(), "user_id", "user", bson.M{"_id": 1}),
+		{{Key: "$match", Value: bson.M{"user": bson.M{"$size": 0}}}},
+		{{Key: "$project", Value: bson.M{"_id": 1}}},
+	}
+	orphanInputImages, err := deleteMatches(ctx, d.inputImageCollection, inputImagePipeline, dryRun)
+	if err != nil {
+		return 0, 0, fmt.Errorf("could not clean up orphaned input images: %w", err)
+	}
+	// golf keypoints without an input image have no user either, so this also finds the golf keypoints of the input
+	// images above when they are not deleted (dryRun)
+	golfKeypointsPipeline := mongodb.Pipeline{
+		lookupByHexId(d.inputImageCollection.Name(), "input_image_id", "input_image", bson.M{"user_id": 1}),
+		{{Key: "$unwind", Value: bson.M{"path": "$input_image", "preserveNullAndEmptyArrays": true}}},
+		lookupByHexId(d.userCollection.Name(), "input_image.user_id", "user", bson.M{"_id": 1}),
+		{{Key: "$match", Value: bson.M{"user": bson.M{"$size": 0}}}},
+		{{Key: "$project", Value: bson.M{"_id": 1}}},
+	}
+	orphanGolfKeypoints, err := deleteMatches(ctx, d.golfKeypointCollection, golfKeypointsPipeline, dryRun)
+	if err != nil {
+		return 0, 0, fmt.Errorf("could not clean up orphaned golf keypoints: %w", err)
+	}
+	scanned := numInputImages + numGolfKeypoints
+	changed := orphanInputImages + orphanGolfKeypoints
+	logger.DebugContext(ctx, "cleaned up orphans", "scanned", scanned, "changed", changed, "dry_run", dryRun)
+	return scanned, changed, nil
+}
+
+// $lookup of the document in another collection that localField references by hex id, as is an empty array if there is
+// none. Only the fields in projection are looked up.
+func lookupByHexId(from string, localField string, as string, projection bson.M) bson.D {
+	return bson.D{{Key: "$lookup", Value: bson.M{
+		"from": from,
+		"let":  bson.M{"id": bson.M{"$convert": bson.M{"input": "$" + localField, "to": "objectId", "onError": nil, "onNull": nil}}},
+		"pipeline": bson.A{
+			bson.M{"$match": bson.M{"$expr": bson.M{"$eq": bson.A{"$_id", "$$id"}}}},
+			bson.M{"$project": projection},
+		},
+		"as": as,
+	}}}
+}
+
+// Deletes the documents pipeline returns from collection in batches, with dryRun they are only counted. Returns how
+// many were (or would be) deleted.
+func deleteMatches(ctx context.Context, collection *mongodb.Collection, pipeline mongodb.Pipeline, dryRun bool) (int64, error) {
+	cursor, err := collection.Aggregate(ctx, pipeline, options.Aggregate().SetBatchSize(maintenanceBatchSize))
+	if err != nil {
+		return 0, err
+	}
+	defer cursor.Close(ctx)
+	var res int64
+	var batch []primitive.ObjectID
+	deleteBatch := func() error {
+		deleted, err := collection.DeleteMany(ctx, bson.M{"_id": bson.M{"$in": batch}})
+		if err != nil {
+			return err
+		}
+		res += deleted.DeletedCount
+		batch = batch[:0]
+		return nil
+	}
+	for cursor.Next(ctx) {
+		var doc struct {
+			Id primitive.ObjectID `bson:"_id"`
+		}
+		if err := cursor.Decode(&doc); err != nil {
+			return res, err
+		}
+		if dryRun {
+			res++
+			continue
+		}
+		batch = append(batch, doc.Id)
+		if len(batch) == maintenanceBatchSize {
+			if err := deleteBatch(); err != nil {
+				return res, err
+			}
+		}
+	}
+	if err := cursor.Err(); err != nil {
+		return res, err
+	}
+	if len(batch) > 0 {
+		if err := deleteBatch(); err != nil {
+			return res, err
+		}
+	}
+	return res, nil
+}
+
+// Calls fn for every golf keypoints document (without its output image) with the image type and calibration of its
+// input image, which is nil if the input image does not exist. Documents are read in batches without holding d.mutex,
+// so fn can call other DbManager operations. Stops at the first error of fn.
+func (d *DbManager) ForEachGolfKeypoints(ctx context.Context, fn func(golfKeypoints *GolfKeypoints, inputImage *InputImage) error) error {
+	ctx, endOperation := startOperation(ctx, "ForEachGolfKeypoints")
+	defer endOperation()
+	logger.DebugContext(ctx, "reading golf keypoints with their input images")
+	pipeline := mongodb.Pipeline{
+		{{Key: "$project", Value: bson.M{"output_img": 0}}},
+		lookupByHexId(d.inputImageCollection.Name(), "input_image_id", "input_image", bson.M{"org_id": 1, "image_type": 1, "calibration_info": 1}),
+	}
+	cursor, err := d.golfKeypointCollection.Aggregate(ctx, pipeline, options.Aggregate().SetBatchSize(maintenanceBatchSize))
+	if err != nil {
+		return fmt.Errorf("could not read golf keypoints: %w", err)
+	}
+	defer cursor.Close(ctx)
+	for cursor.Next(ctx) {
+		var doc struct {
+			GolfKeypoints `bson:",inline"`
+			InputImage    []*InputImage `bson:"input_image"`
+		}
+		if err := cursor.Decode(&doc); err != nil {
+			return fmt.Errorf("could not decode golf keypoints: %w", err)
+		}
+		// input images of another organization are not the ones the golf keypoints were calculated from
+		var inputImage *InputImage
+		if len(doc.InputImage) > 0 && doc.InputImage[0].OrgId == doc.OrgId {
+			inputImage = doc.InputImage[0]
+		}
+		if err := fn(&doc.GolfKeypoints, inputImage); err != nil {
+			return err
+		}
+	}
+	if err := cursor.Err(); err != nil {
+		return fmt.Errorf("could not read golf keypoints: %w", err)
+	}
+	return nil
+}
+
+// Only replaces the setup points so the output image and body keypoints are left as they are
+func (d *DbManager) UpdateGolfSetupPoints(ctx context.Context, golfKeypointsId string, dtl *skp.DTLGolfSetupPoints, faceon *skp.FaceOnGolfSetupPoints) error {
+	ctx, endOperation := startOperation(ctx, "UpdateGolfSetupPoints")
+	defer endOperation()
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	logger.DebugContext(ctx, "updating golf setup points", "golf_keypoints_id", golfKeypointsId)
+	objectId, err := primitive.ObjectIDFromHex(golfKeypointsId)
+	if err != nil {
+		return apierror.InvalidArgument("", "could not convert id %s to object id", golfKeypointsId)
+	}
+	update := bson.M{
+		"$set": bson.M{
+			"dtl_golf_setup_points":    dtl,
+			"faceon_golf_setup_points": faceon,
+		},
+	}
+	res, err := d.golfKeypointCollection.UpdateOne(ctx, bson.M{"_id": objectId}, update)
+	if err != nil {
+		return fmt.Errorf("could not update golf setup points: %w", err)
+	}
+	if res.MatchedCount == 0 {
+		return apierror.NotFound("no golf keypoints with id: %s", golfKeypointsId)
+	}
+	return nil
+}
@@ -0,0 +1,262 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	mongodb "go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/sirfrank96/go-server/apierror"
+	skp "github.com/sirfrank96/go-server/sports-keypoints-proto"
+)
+
+// An academy sharing the deployment. Users, input images and golf keypoints are scoped to an organization.
+// Users that are not in an organization (empty org id) are in the default tenant.
+type Organization struct {
+	Id   primitive.ObjectID `bson:"_id,omitempty"`
+	Name string             `bson:"name,omitempty"`
+}
+
+// An organization admin invited the user to join with the given role
+type OrganizationInvitation struct {
+	OrgId     string               `bson:"org_id,omitempty"`
+	OrgRole   skp.OrganizationRole `bson:"org_role,omitempty"`
+	InvitedAt time.Time            `bson:"invited_at,omitempty"`
+}
+
+// Filter to match documents in an organization, documents without an org id (missing or empty) are in the default tenant
+func getOrgFilter(orgId string) bson.M {
+	if orgId == "" {
+		return bson.M{"org_id": bson.M{"$in": bson.A{nil, ""}}}
+	}
+	return bson.M{"org_id": orgId}
+}
+
+// Adds the org filter to an existing filter
+func withOrgFilter(orgId string, filter bson.M) bson.M {
+	for key, val := range getOrgFilter(orgId) {
+		filter[key] = val
+	}
+	return filter
+}
+
+func (d *DbManager) CreateOrganization(ctx context.Context, org *Organization) (*Organization, error) {
+	ctx, endOperation := startOperation(ctx, "CreateOrganization")
+	defer endOperation()
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	logger.DebugContext(ctx, "creating organization", "name", org.Name)
+	res, err := d.organizationCollection.InsertOne(ctx, org)
+	if err != nil {
+		return nil, fmt.Errorf("could not create organization: %w", err)
+	}
+	objectId, ok := res.InsertedID.(primitive.ObjectID)
+	if !ok {
+		return nil, fmt.Errorf("could create object id")
+	}
+	org.Id = objectId
+	logger.DebugContext(ctx, "created organization", "org_id", org.Id.Hex(), "name", org.Name)
+	return org, nil
+}
+
+func (d *DbManager) ReadOrganization(ctx context.Context, orgId string) (*Organization, error) {
+	ctx, endOperation := startOperation(ctx, "ReadOrganization")
+	defer endOperation()
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	logger.DebugContext(ctx, "reading organization", "org_id", orgId)
+	objectId, err := primitive.ObjectIDFromHex(orgId)
+	if err != nil {
+		return nil, apierror.InvalidArgument("organization_id", "could not convert id %s to object id", orgId)
+	}
+	filter := bson.M{"_id": objectId}
+	var org Organization
+	if err := d.organizationCollection.FindOne(ctx, filter).Decode(&org); err != nil {
+		if err == mongodb.ErrNoDocuments {
+			return nil, apierror.NotFound("no organizations with id: %s", orgId)
+		}
+		return nil, fmt.Errorf("could not read organization: %w", err)
+	}
+	logger.DebugContext(ctx, "read organization", "org_id", org.Id.Hex(), "name", org.Name)
+	return &org, nil
+}
+
+func (d *DbManager) ReadUsersForOrganization(ctx context.Context, orgId string) ([]*User, error) {
+	ctx, endOperation := startOperation(ctx, "ReadUsersForOrganization")
+	defer endOperation()
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	logger.DebugContext(ctx, "reading users for organization", "org_id", orgId)
+	cursor, err := d.userCollection.Find(ctx, getOrgFilter(orgId))
+	if err != nil {
+		return nil, fmt.Errorf("could not read users for organization: %w", err)
+	}
+	defer cursor.Close(ctx)
+	var res []*User
+	for cursor.Next(ctx) {
+		var user User
+		if err := cursor.Decode(&user); err != nil {
+			return nil, fmt.Errorf("could not decode user: %w", err)
+		}
+		res = append(res, &user)
+	}
+	return res, nil
+}
+
+func (d *DbManager) CountOrganizationAdmins(ctx context.Context, orgId string) (int64, error) {
+	ctx, endOperation := startOperation(ctx, "CountOrganizationAdmins")
+	defer endOperation()
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	filter := withOrgFilter(orgId, bson.M{"org_role": skp.OrganizationRole_ORGANIZATION_ADMIN})
+	count, err := d.userCollection.CountDocuments(ctx, filter)
+	if err != nil {
+		return 0, fmt.Errorf("could not count organization admins: %w", err)
+	}
+	return count, nil
+}
+
+// Moves a user into an organization (or back to the default tenant if orgId is empty) along with their input images and golf keypoints
+func (d *DbManager) SetUserOrganization(ctx context.Context, userId string, orgId string, role skp.OrganizationRole) error {
+	ctx, endOperation := startOperation(ctx, "SetUserOrganization")
+	defer endOperation()
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	logger.DebugContext(ctx, "setting user organization", "user_id", userId, "org_id", orgId, "org_role", role.String())
+	objectId, err := primitive.ObjectIDFromHex(userId)
+	if err != nil {
+		return apierror.InvalidArgument("", "could not convert id %s to object id", userId)
+	}
+	var update bson.M
+	if orgId == "" {
+		update = bson.M{"$unset": bson.M{"org_id": "", "org_role": ""}}
+	} else {
+		update = bson.M{"$set": bson.M{"org_id": orgId, "org_role": role}}
+	}
+	res, err := d.userCollection.UpdateOne(ctx, bson.M{"_id": objectId}, update)
+	if err != nil {
+		return fmt.Errorf("could not set organization for user: %w", err)
+	}
+	if res.MatchedCount == 0 {
+		return apierror.NotFound("no users with id: %s", userId)
+	}
+	if err := d.moveUserData(ctx, userId, orgId); err != nil {
+		return err
+	}
+	logger.DebugContext(ctx, "set user organization", "user_id", userId, "org_id", orgId)
+	return nil
+}
+
+// Moves the input images and golf keypoints of a user into an organization, or back to the default tenant if orgId is empty.
+// The caller holds the mutex.
+func (d *DbManager) moveUserData(ctx context.Context, userId string, orgId string) error {
+	var update bson.M
+	if orgId == "" {
+		update = bson.M{"$unset": bson.M{"org_id": ""}}
+	} else {
+		update = bson.M{"$set": bson.M{"org_id": orgId}}
+	}
+	if _, err := d.inputImageCollection.UpdateMany(ctx, bson.M{"user_id": userId}, update); err != nil {
+		return fmt.Errorf("could not set organization for input images of user: %w", err)
+	}
+	if _, err := d.golfKeypointCollection.UpdateMany(ctx, bson.M{"user_id": userId}, update); err != nil {
+		return fmt.Errorf("could not set organization for golf keypoints of user: %w", err)
+	}
+	return nil
+}
+
+// Invites a user to an organization, replacing an earlier invitation from the same organization. Nothing is moved
+// until the user accepts.
+func (d *DbManager) InviteUserToOrganization(ctx context.Context, userId string, orgId string, role skp.OrganizationRole) error {
+	ctx, endOperation := startOperation(ctx, "InviteUserToOrganization")
+	defer endOperation()
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	logger.DebugContext(ctx, "inviting user to organization", "user_id", userId, "org_id", orgId, "org_role", role.String())
+	objectId, err := primitive.ObjectIDFromHex(userId)
+	if err != nil {
+		return apierror.InvalidArgument("", "could not convert id %s to object id", userId)
+	}
+	filter := withOrgFilter("", bson.M{"_id": objectId})
+	if _, err := d.userCollection.UpdateOne(ctx, filter, bson.M{"$pull": bson.M{"org_invitations": bson.M{"org_id": orgId}}}); err != nil {
+		return fmt.Errorf("could not replace organization invitation: %w", err)
+	}
+	invitation := OrganizationInvitation{OrgId: orgId, OrgRole: role, InvitedAt: time.Now()}
+	res, err := d.userCollection.UpdateOne(ctx, filter, bson.M{"$push": bson.M{"org_invitations": invitation}})
+	if err != nil {
+		return fmt.Errorf("could not invite user to organization: %w", err)
+	}
+	if res.MatchedCount == 0 {
+		return apierror.NotFound("no users with id %s outside of an organization", userId)
+	}
+	return nil
+}
+
+// Moves a user into the organization that invited them, along with their input images and golf keypoints. The user
+// must not have joined another organization in the meantime. Returns the role from the invitation.
+func (d *DbManager) AcceptOrganizationInvitation(ctx context.Context, userId string, orgId string) (skp.OrganizationRole, error) {
+	ctx, endOperation := startOperation(ctx, "AcceptOrganizationInvitation")
+	defer endOperation()
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	logger.DebugContext(ctx, "accepting organization invitation", "user_id", userId, "org_id", orgId)
+	objectId, err := primitive.ObjectIDFromHex(userId)
+	if err != nil {
+		return 0, apierror.InvalidArgument("", "could not convert id %s to object id", userId)
+	}
+	filter := withOrgFilter("", bson.M{"_id": objectId, "org_invitations.org_id": orgId})
+	var user User
+	if err := d.userCollection.FindOne(ctx, filter).Decode(&user); err != nil {
+		if err == mongodb.ErrNoDocuments {
+			return 0, apierror.NotFound("no invitation from organization %s for user %s", orgId, userId)
+		}
+		return 0, fmt.Errorf("could not read organization invitation: %w", err)
+	}
+	var role skp.OrganizationRole
+	for _, invitation := range user.OrgInvitations {
+		if invitation.OrgId == orgId {
+			role = invitation.OrgRole
+		}
+	}
+	// the user leaves every other invitation behind once they are in an organization
+	update := bson.M{
+		"$set":   bson.M{"org_id": orgId, "org_role": role},
+		"$unset": bson.M{"org_invitations": ""},
+	}
+	res, err := d.userCollection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return 0, fmt.Errorf("could not accept organization invitation: %w", err)
+	}
+	if res.MatchedCount == 0 {
+		return 0, apierror.NotFound("no invitation from organization %s for user %s", orgId, userId)
+	}
+	if err := d.moveUserData(ctx, userId, orgId); err != nil {
+		return 0, err
+	}
+	logger.DebugContext(ctx, "accepted organization invitation", "user_id", userId, "org_id", orgId, "org_role", role.String())
+	return role, nil
+}
+
+func (d *DbManager) DeleteOrganizationInvitation(ctx context.Context, userId string, orgId string) error {
+	ctx, endOperation := startOperation(ctx, "DeleteOrganizationInvitation")
+	defer endOperation()
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	logger.DebugContext(ctx, "deleting organization invitation", "user_id", userId, "org_id", orgId)
+	objectId, err := primitive.ObjectIDFromHex(userId)
+	if err != nil {
+		return apierror.InvalidArgument("", "could not convert id %s to object id", userId)
+	}
+	filter := bson.M{"_id": objectId, "org_invitations.org_id": orgId}
+	res, err := d.userCollection.UpdateOne(ctx, filter, bson.M{"$pull": bson.M{"org_invitations": bson.M{"org_id": orgId}}})
+	if err != nil {
+		return fmt.Errorf("could not delete organization invitation: %w", err)
+	}
+	if res.MatchedCount == 0 {
+		return apierror.NotFound("no invitation from organization %s for user %s", orgId, userId)
+	}
+	return nil
+}
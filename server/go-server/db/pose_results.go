@@ -0,0 +1,52 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	mongodb "go.mongodb.org/mongo-driver/mongo"
+	mongoopts "go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Cached computervision response for an image, the id is a hash of the image, the computervision method and the model
+// version so results of an older model are never read
+type PoseResult struct {
+	Id           string `bson:"_id"`
+	Method       string `bson:"method"`
+	ModelVersion string `bson:"model_version"`
+	Response     []byte `bson:"response"`
+	// removed by a ttl index
+	ExpiresAt time.Time `bson:"expires_at"`
+}
+
+// Returns nil if there is no cached result for key
+func (d *DbManager) ReadPoseResult(ctx context.Context, key string) ([]byte, error) {
+	ctx, endOperation := startOperation(ctx, "ReadPoseResult")
+	defer endOperation()
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	var result PoseResult
+	err := d.poseResultCollection.FindOne(ctx, bson.M{"_id": key, "expires_at": bson.M{"$gt": time.Now()}}).Decode(&result)
+	if errors.Is(err, mongodb.ErrNoDocuments) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not read pose result: %w", err)
+	}
+	return result.Response, nil
+}
+
+func (d *DbManager) SavePoseResult(ctx context.Context, key string, method string, modelVersion string, response []byte, expiresAt time.Time) error {
+	ctx, endOperation := startOperation(ctx, "SavePoseResult")
+	defer endOperation()
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	result := &PoseResult{Id: key, Method: method, ModelVersion: modelVersion, Response: response, ExpiresAt: expiresAt}
+	if _, err := d.poseResultCollection.ReplaceOne(ctx, bson.M{"_id": key}, result, mongoopts.Replace().SetUpsert(true)); err != nil {
+		return fmt.Errorf("could not save pose result: %w", err)
+	}
+	return nil
+}
@@ -2,8 +2,13 @@ package db
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"log/slog"
 	"reflect"
+	"regexp"
+	"time"
 
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
@@ -11,6 +16,9 @@ import (
 	"go.mongodb.org/mongo-driver/mongo/options"
 
 	"golang.org/x/crypto/bcrypt"
+
+	"github.com/sirfrank96/go-server/apierror"
+	skp "github.com/sirfrank96/go-server/sports-keypoints-proto"
 )
 
 type User struct {
@@ -18,6 +26,56 @@ type User struct {
 	Username string             `bson:"username,omitempty"`
 	Password string             `bson:"password,omitempty"`
 	Email    string             `bson:"email,omitempty"`
+	// empty if user is in the default tenant
+	OrgId   string               `bson:"org_id,omitempty"`
+	OrgRole skp.OrganizationRole `bson:"org_role,omitempty"`
+	// pending invitations, the user only joins an organization by accepting one
+	OrgInvitations []OrganizationInvitation `bson:"org_invitations,omitempty"`
+	Totp           UserTotp                 `bson:"totp,omitempty"`
+	// identities from external openid connect providers that can be used to log in as this user
+	ExternalIdentities []ExternalIdentity `bson:"external_identities,omitempty"`
+	Role               skp.UserRole       `bson:"role,omitempty"`
+	// disabled users cannot log in and their sessions are revoked
+	Disabled bool `bson:"disabled,omitempty"`
+	// set when an admin resets the password, cleared once the user sets a new one
+	PasswordResetRequired bool `bson:"password_reset_required,omitempty"`
+	// session tokens issued before this are revoked
+	SessionsValidAfter time.Time `bson:"sessions_valid_after,omitempty"`
+}
+
+// An issuer and subject pair uniquely identifies a user at an openid connect provider
+type ExternalIdentity struct {
+	Provider string `bson:"provider,omitempty"`
+	Issuer   string `bson:"issuer,omitempty"`
+	Subject  string `bson:"subject,omitempty"`
+}
+
+// Two-factor authentication state for a user, secret is set on enrollment and enabled is set once confirmed
+type UserTotp struct {
+	Secret        string   `bson:"secret,omitempty"`
+	Enabled       bool     `bson:"enabled,omitempty"`
+	RecoveryCodes []string `bson:"recovery_codes,omitempty"` // sha256 hashes, each one is removed once used
+	LastUsedStep  int64    `bson:"last_used_step,omitempty"` // prevents a totp code from being replayed
+	// wrong codes since the last lockout or successful code
+	FailedAttempts int `bson:"failed_attempts,omitempty"`
+	// codes are rejected until then, challenge tokens issued before the lockout stay invalid
+	LockedUntil time.Time `bson:"locked_until,omitempty"`
+}
+
+// Only logs identifying fields, the password hash, totp secret and recovery codes are never logged
+func (u User) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("id", u.Id.Hex()),
+		slog.String("username", u.Username),
+		slog.String("org_id", u.OrgId),
+		slog.String("org_role", u.OrgRole.String()),
+		slog.Int("num_org_invitations", len(u.OrgInvitations)),
+		slog.Bool("totp_enabled", u.Totp.Enabled),
+		slog.Int("num_external_identities", len(u.ExternalIdentities)),
+		slog.String("role", u.Role.String()),
+		slog.Bool("disabled", u.Disabled),
+		slog.Bool("password_reset_required", u.PasswordResetRequired),
+	)
 }
 
 func HashPassword(password string) (string, error) {
@@ -35,6 +93,12 @@ func VerifyPasswordHash(hash string, password string) bool {
 	return true
 }
 
+// Recovery codes are long random strings so a fast hash is enough (unlike passwords)
+func HashRecoveryCode(code string) string {
+	sum := sha256.Sum256([]byte(code))
+	return hex.EncodeToString(sum[:])
+}
+
 func UpdateUserFields(oldUser *User, newUser *User) *User {
 	oldReflectVal := reflect.ValueOf(oldUser).Elem()
 	newReflectVal := reflect.ValueOf(newUser).Elem()
@@ -50,9 +114,11 @@ func UpdateUserFields(oldUser *User, newUser *User) *User {
 }
 
 func (d *DbManager) CreateUser(ctx context.Context, user *User) (*User, error) {
+	ctx, endOperation := startOperation(ctx, "CreateUser")
+	defer endOperation()
 	d.mutex.Lock()
 	defer d.mutex.Unlock()
-	fmt.Printf("Creating user with name: %s...\n", user.Username)
+	logger.DebugContext(ctx, "creating user", "username", user.Username)
 	res, err := d.userCollection.InsertOne(ctx, user)
 	if err != nil {
 		return nil, fmt.Errorf("could not create user: %w", err)
@@ -62,89 +128,266 @@ func (d *DbManager) CreateUser(ctx context.Context, user *User) (*User, error) {
 		return nil, fmt.Errorf("could create object id")
 	}
 	user.Id = objectId
-	fmt.Printf("Create user result: %+v\n", user)
+	logger.DebugContext(ctx, "created user", "user", user)
 	return user, nil
 }
 
 func (d *DbManager) ReadUser(ctx context.Context, userId string) (*User, error) {
+	ctx, endOperation := startOperation(ctx, "ReadUser")
+	defer endOperation()
 	d.mutex.Lock()
 	defer d.mutex.Unlock()
-	fmt.Printf("Reading user id: %s...\n", userId)
+	logger.DebugContext(ctx, "reading user", "user_id", userId)
 	objectId, err := primitive.ObjectIDFromHex(userId)
 	if err != nil {
-		return nil, fmt.Errorf("could not convert id to object id %w", err)
+		return nil, apierror.InvalidArgument("", "could not convert id %s to object id", userId)
 	}
 	filter := bson.M{"_id": objectId}
 	var user User
 	if err := d.userCollection.FindOne(ctx, filter).Decode(&user); err != nil {
 		if err == mongodb.ErrNoDocuments {
-			return nil, fmt.Errorf("no users with id: %s", userId)
+			return nil, apierror.NotFound("no users with id: %s", userId)
 		}
 		return nil, fmt.Errorf("could not read user: %w", err)
 	}
 	user.Id = objectId
-	fmt.Printf("Read user result: %+v\n", user)
+	logger.DebugContext(ctx, "read user", "user", user)
 	return &user, nil
 }
 
 func (d *DbManager) ReadUserFromUsername(ctx context.Context, userName string) (*User, error) {
+	ctx, endOperation := startOperation(ctx, "ReadUserFromUsername")
+	defer endOperation()
 	d.mutex.Lock()
 	defer d.mutex.Unlock()
-	fmt.Printf("Reading user username: %s...\n", userName)
+	logger.DebugContext(ctx, "reading user from username", "username", userName)
 	filter := bson.M{"username": userName}
 	var user User
 	if err := d.userCollection.FindOne(ctx, filter).Decode(&user); err != nil {
 		if err == mongodb.ErrNoDocuments {
-			return nil, fmt.Errorf("no users with name: %s", userName)
+			return nil, apierror.NotFound("no users with name: %s", userName)
+		}
+		return nil, fmt.Errorf("could not read user: %w", err)
+	}
+	logger.DebugContext(ctx, "read user from username", "user", user)
+	return &user, nil
+}
+
+func (d *DbManager) ReadUserFromExternalIdentity(ctx context.Context, issuer string, subject string) (*User, error) {
+	ctx, endOperation := startOperation(ctx, "ReadUserFromExternalIdentity")
+	defer endOperation()
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	logger.DebugContext(ctx, "reading user from external identity", "issuer", issuer)
+	filter := bson.M{"external_identities": bson.M{"$elemMatch": bson.M{"issuer": issuer, "subject": subject}}}
+	var user User
+	if err := d.userCollection.FindOne(ctx, filter).Decode(&user); err != nil {
+		if err == mongodb.ErrNoDocuments {
+			return nil, apierror.NotFound("no users with external identity from issuer: %s", issuer)
 		}
 		return nil, fmt.Errorf("could not read user: %w", err)
 	}
-	fmt.Printf("Read user from username result: %+v\n", user)
+	logger.DebugContext(ctx, "read user from external identity", "user", user)
 	return &user, nil
 }
 
 func (d *DbManager) UpdateUser(ctx context.Context, userId string, user *User) (*User, error) {
+	ctx, endOperation := startOperation(ctx, "UpdateUser")
+	defer endOperation()
 	d.mutex.Lock()
 	defer d.mutex.Unlock()
-	fmt.Printf("Updating user id: %s, username is %s...\n", userId, user.Username)
+	logger.DebugContext(ctx, "updating user", "user_id", userId, "username", user.Username)
 	objectId, err := primitive.ObjectIDFromHex(userId)
 	if err != nil {
-		return nil, fmt.Errorf("could not convert id to object id %w", err)
+		return nil, apierror.InvalidArgument("", "could not convert id %s to object id", userId)
 	}
 	filter := bson.M{"_id": objectId}
 	update := bson.M{
 		"$set": bson.M{
-			"username": user.Username,
-			"password": user.Password,
-			"email":    user.Email,
+			"username":                user.Username,
+			"password":                user.Password,
+			"email":                   user.Email,
+			"password_reset_required": user.PasswordResetRequired,
 		},
 	}
 	var updatedUser User
 	if err := d.userCollection.FindOneAndUpdate(ctx, filter, update, options.FindOneAndUpdate().SetReturnDocument(options.After)).Decode(&updatedUser); err != nil {
 		if err == mongodb.ErrNoDocuments {
-			return nil, fmt.Errorf("no users with id: %s", userId)
+			return nil, apierror.NotFound("no users with id: %s", userId)
 		}
 		return nil, fmt.Errorf("could not update user: %w", err)
 	}
 	updatedUser.Id = objectId
-	fmt.Printf("Update user result: %+v...\n", updatedUser)
+	logger.DebugContext(ctx, "updated user", "user", updatedUser)
+	return &updatedUser, nil
+}
+
+func (d *DbManager) UpdateUserTotp(ctx context.Context, userId string, totp *UserTotp) (*User, error) {
+	ctx, endOperation := startOperation(ctx, "UpdateUserTotp")
+	defer endOperation()
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	logger.DebugContext(ctx, "updating user totp", "user_id", userId, "totp_enabled", totp.Enabled)
+	objectId, err := primitive.ObjectIDFromHex(userId)
+	if err != nil {
+		return nil, apierror.InvalidArgument("", "could not convert id %s to object id", userId)
+	}
+	filter := bson.M{"_id": objectId}
+	update := bson.M{
+		"$set": bson.M{
+			"totp": totp,
+		},
+	}
+	var updatedUser User
+	if err := d.userCollection.FindOneAndUpdate(ctx, filter, update, options.FindOneAndUpdate().SetReturnDocument(options.After)).Decode(&updatedUser); err != nil {
+		if err == mongodb.ErrNoDocuments {
+			return nil, apierror.NotFound("no users with id: %s", userId)
+		}
+		return nil, fmt.Errorf("could not update user totp: %w", err)
+	}
+	updatedUser.Id = objectId
+	logger.DebugContext(ctx, "updated user totp", "user", updatedUser)
+	return &updatedUser, nil
+}
+
+// Stores step as the last used totp step, only if it is newer than the stored one. Returns false if the step was
+// already used (eg. by a concurrent request with the same code).
+func (d *DbManager) UseTotpStep(ctx context.Context, userId string, step int64) (bool, error) {
+	ctx, endOperation := startOperation(ctx, "UseTotpStep")
+	defer endOperation()
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	objectId, err := primitive.ObjectIDFromHex(userId)
+	if err != nil {
+		return false, apierror.InvalidArgument("", "could not convert id %s to object id", userId)
+	}
+	filter := bson.M{
+		"_id":          objectId,
+		"totp.enabled": true,
+		"$or": bson.A{
+			bson.M{"totp.last_used_step": bson.M{"$lt": step}},
+			bson.M{"totp.last_used_step": bson.M{"$exists": false}},
+		},
+	}
+	update := bson.M{
+		"$set":   bson.M{"totp.last_used_step": step},
+		"$unset": bson.M{"totp.failed_attempts": ""},
+	}
+	res, err := d.userCollection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return false, fmt.Errorf("could not use totp step: %w", err)
+	}
+	return res.MatchedCount == 1, nil
+}
+
+// Removes a recovery code by its hash. Returns false if the user does not have the code (anymore).
+func (d *DbManager) UseTotpRecoveryCode(ctx context.Context, userId string, hashedCode string) (bool, error) {
+	ctx, endOperation := startOperation(ctx, "UseTotpRecoveryCode")
+	defer endOperation()
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	objectId, err := primitive.ObjectIDFromHex(userId)
+	if err != nil {
+		return false, apierror.InvalidArgument("", "could not convert id %s to object id", userId)
+	}
+	filter := bson.M{"_id": objectId, "totp.enabled": true, "totp.recovery_codes": hashedCode}
+	update := bson.M{
+		"$pull":  bson.M{"totp.recovery_codes": hashedCode},
+		"$unset": bson.M{"totp.failed_attempts": ""},
+	}
+	res, err := d.userCollection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return false, fmt.Errorf("could not use totp recovery code: %w", err)
+	}
+	return res.MatchedCount == 1, nil
+}
+
+// Counts a wrong totp or recovery code. Once maxAttempts are reached the user is locked out for lockout and the count
+// starts over. Returns the time the user is locked until, zero if they are not locked out.
+func (d *DbManager) RecordTotpFailure(ctx context.Context, userId string, maxAttempts int, lockout time.Duration) (time.Time, error) {
+	ctx, endOperation := startOperation(ctx, "RecordTotpFailure")
+	defer endOperation()
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	objectId, err := primitive.ObjectIDFromHex(userId)
+	if err != nil {
+		return time.Time{}, apierror.InvalidArgument("", "could not convert id %s to object id", userId)
+	}
+	var user User
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	if err := d.userCollection.FindOneAndUpdate(ctx, bson.M{"_id": objectId}, bson.M{"$inc": bson.M{"totp.failed_attempts": 1}}, opts).Decode(&user); err != nil {
+		if err == mongodb.ErrNoDocuments {
+			return time.Time{}, apierror.NotFound("no users with id: %s", userId)
+		}
+		return time.Time{}, fmt.Errorf("could not record totp failure: %w", err)
+	}
+	if user.Totp.FailedAttempts < maxAttempts {
+		return time.Time{}, nil
+	}
+	lockedUntil := time.Now().Add(lockout)
+	update := bson.M{
+		"$set":   bson.M{"totp.locked_until": lockedUntil},
+		"$unset": bson.M{"totp.failed_attempts": ""},
+	}
+	if _, err := d.userCollection.UpdateOne(ctx, bson.M{"_id": objectId}, update); err != nil {
+		return time.Time{}, fmt.Errorf("could not lock out totp: %w", err)
+	}
+	logger.WarnContext(ctx, "locked out totp after too many wrong codes", "user_id", userId, "locked_until", lockedUntil)
+	return lockedUntil, nil
+}
+
+// Only adds the identity if it is not already linked to any user
+func (d *DbManager) AddUserExternalIdentity(ctx context.Context, userId string, identity *ExternalIdentity) (*User, error) {
+	ctx, endOperation := startOperation(ctx, "AddUserExternalIdentity")
+	defer endOperation()
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	logger.DebugContext(ctx, "adding user external identity", "user_id", userId, "issuer", identity.Issuer)
+	objectId, err := primitive.ObjectIDFromHex(userId)
+	if err != nil {
+		return nil, apierror.InvalidArgument("", "could not convert id %s to object id", userId)
+	}
+	linkedFilter := bson.M{"external_identities": bson.M{"$elemMatch": bson.M{"issuer": identity.Issuer, "subject": identity.Subject}}}
+	count, err := d.userCollection.CountDocuments(ctx, linkedFilter)
+	if err != nil {
+		return nil, fmt.Errorf("could not check if external identity is linked: %w", err)
+	}
+	if count > 0 {
+		return nil, apierror.AlreadyExists("external identity from issuer: %s is already linked to a user", identity.Issuer)
+	}
+	filter := bson.M{"_id": objectId}
+	update := bson.M{
+		"$push": bson.M{
+			"external_identities": identity,
+		},
+	}
+	var updatedUser User
+	if err := d.userCollection.FindOneAndUpdate(ctx, filter, update, options.FindOneAndUpdate().SetReturnDocument(options.After)).Decode(&updatedUser); err != nil {
+		if err == mongodb.ErrNoDocuments {
+			return nil, apierror.NotFound("no users with id: %s", userId)
+		}
+		return nil, fmt.Errorf("could not add external identity: %w", err)
+	}
+	logger.DebugContext(ctx, "added user external identity", "user", updatedUser)
 	return &updatedUser, nil
 }
 
 // Deletes all input images associated with user (deleteInputImageHelper will also delete golf keypoint associated with each input image)
 // Then deletes the user
-func (d *DbManager) DeleteUser(ctx context.Context, userId string) error {
+func (d *DbManager) DeleteUser(ctx context.Context, orgId string, userId string) error {
+	ctx, endOperation := startOperation(ctx, "DeleteUser")
+	defer endOperation()
 	d.mutex.Lock()
 	defer d.mutex.Unlock()
-	fmt.Printf("Deleting user id: %s...\n", userId)
+	logger.DebugContext(ctx, "deleting user", "user_id", userId)
 	// read input img ids associated with user
-	inputImages, err := d.readInputImagesForUserHelper(ctx, userId)
+	inputImages, err := d.readInputImagesForUserHelper(ctx, orgId, userId)
 	if err != nil {
 		return fmt.Errorf("could not read input images associated with user %s: %w", userId, err)
 	}
 	// delete input imgs associated with user
 	for _, inputImg := range inputImages {
-		err = d.deleteInputImageHelper(ctx, inputImg.Id.Hex())
+		err = d.deleteInputImageHelper(ctx, orgId, inputImg.Id.Hex())
 		if err != nil {
 			return fmt.Errorf("could not delete input image %s associated with user %s: %w", inputImg.Id.Hex(), userId, err)
 		}
@@ -152,7 +395,7 @@ func (d *DbManager) DeleteUser(ctx context.Context, userId string) error {
 	// delete user
 	objectId, err := primitive.ObjectIDFromHex(userId)
 	if err != nil {
-		return fmt.Errorf("could not convert id to object id %w", err)
+		return apierror.InvalidArgument("", "could not convert id %s to object id", userId)
 	}
 	filter := bson.M{"_id": objectId}
 	res, err := d.userCollection.DeleteOne(ctx, filter)
@@ -160,8 +403,186 @@ func (d *DbManager) DeleteUser(ctx context.Context, userId string) error {
 		return fmt.Errorf("could not delete user %w", err)
 	}
 	if res.DeletedCount == 0 {
-		return fmt.Errorf("did not delete any users, userid %s may not exist", userId)
+		return apierror.NotFound("did not delete any users, userid %s may not exist", userId)
 	}
-	fmt.Printf("Delete user result: %+v...\n", res)
+	logger.DebugContext(ctx, "deleted user", "user_id", userId, "deleted_count", res.DeletedCount)
 	return nil
 }
+
+// Users across all organizations whose username or email contains query (case insensitive), ordered by id.
+// pageToken is the id of the last user of the previous page.
+func (d *DbManager) ListUsers(ctx context.Context, query string, pageSize int64, pageToken string) ([]*User, error) {
+	ctx, endOperation := startOperation(ctx, "ListUsers")
+	defer endOperation()
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	logger.DebugContext(ctx, "listing users", "query", query, "page_size", pageSize)
+	filter := bson.M{}
+	if query != "" {
+		pattern := primitive.Regex{Pattern: regexp.QuoteMeta(query), Options: "i"}
+		filter["$or"] = bson.A{bson.M{"username": pattern}, bson.M{"email": pattern}}
+	}
+	if pageToken != "" {
+		afterId, err := primitive.ObjectIDFromHex(pageToken)
+		if err != nil {
+			return nil, apierror.InvalidArgument("page_token", "invalid page token")
+		}
+		filter["_id"] = bson.M{"$gt": afterId}
+	}
+	opts := options.Find().SetSort(bson.M{"_id": 1}).SetLimit(pageSize)
+	cursor, err := d.userCollection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("could not list users: %w", err)
+	}
+	defer cursor.Close(ctx)
+	var res []*User
+	for cursor.Next(ctx) {
+		var user User
+		if err := cursor.Decode(&user); err != nil {
+			return nil, fmt.Errorf("could not decode user: %w", err)
+		}
+		res = append(res, &user)
+	}
+	return res, nil
+}
+
+// Disabling a user also revokes their sessions
+func (d *DbManager) SetUserDisabled(ctx context.Context, userId string, disabled bool) error {
+	ctx, endOperation := startOperation(ctx, "SetUserDisabled")
+	defer endOperation()
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	logger.DebugContext(ctx, "setting user disabled", "user_id", userId, "disabled", disabled)
+	set := bson.M{"disabled": disabled}
+	if disabled {
+		set["sessions_valid_after"] = time.Now()
+	}
+	return d.updateUserHelper(ctx, userId, bson.M{"$set": set})
+}
+
+// Replaces the password with passwordHash, revokes the user's sessions and makes them set a new password after logging in
+func (d *DbManager) ResetUserPassword(ctx context.Context, userId string, passwordHash string) error {
+	ctx, endOperation := startOperation(ctx, "ResetUserPassword")
+	defer endOperation()
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	logger.DebugContext(ctx, "resetting user password", "user_id", userId)
+	update := bson.M{
+		"$set": bson.M{
+			"password":                passwordHash,
+			"password_reset_required": true,
+			"sessions_valid_after":    time.Now(),
+		},
+	}
+	return d.updateUserHelper(ctx, userId, update)
+}
+
+func (d *DbManager) SetUserRole(ctx context.Context, userId string, role skp.UserRole) error {
+	ctx, endOperation := startOperation(ctx, "SetUserRole")
+	defer endOperation()
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	logger.DebugContext(ctx, "setting user role", "user_id", userId, "role", role.String())
+	var update bson.M
+	if role == skp.UserRole_USER_ROLE_UNSPECIFIED {
+		update = bson.M{"$unset": bson.M{"role": ""}}
+	} else {
+		update = bson.M{"$set": bson.M{"role": role}}
+	}
+	return d.updateUserHelper(ctx, userId, update)
+}
+
+func (d *DbManager) updateUserHelper(ctx context.Context, userId string, update bson.M) error {
+	objectId, err := primitive.ObjectIDFromHex(userId)
+	if err != nil {
+		return apierror.InvalidArgument("", "could not convert id %s to object id", userId)
+	}
+	res, err := d.userCollection.UpdateOne(ctx, bson.M{"_id": objectId}, update)
+	if err != nil {
+		return fmt.Errorf("could not update user: %w", err)
+	}
+	if res.MatchedCount == 0 {
+		return apierror.NotFound("no users with id: %s", userId)
+	}
+	return nil
+}
+
+// Storage used by a user's input images and golf keypoints
+type UserStats struct {
+	InputImages int64
+	// input images with a calibration type other than NO_CALIBRATION
+	CalibratedImages int64
+	GolfKeypoints    int64
+	// input, calibration and output images
+	ImageBytes int64
+	// zero if the user has not uploaded any images
+	LastUpload time.Time
+}
+
+func (d *DbManager) GetUserStats(ctx context.Context, orgId string, userId string) (*UserStats, error) {
+	ctx, endOperation := startOperation(ctx, "GetUserStats")
+	defer endOperation()
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	logger.DebugContext(ctx, "getting user stats", "user_id", userId)
+	match := bson.D{{Key: "$match", Value: withOrgFilter(orgId, bson.M{"user_id": userId})}}
+	binarySize := func(field string) bson.M {
+		return bson.M{"$ifNull": bson.A{bson.M{"$binarySize": field}, 0}}
+	}
+	var inputStats struct {
+		Count      int64     `bson:"count"`
+		Calibrated int64     `bson:"calibrated"`
+		Bytes      int64     `bson:"bytes"`
+		LastUpload time.Time `bson:"last_upload"`
+	}
+	inputPipeline := mongodb.Pipeline{
+		match,
+		{{Key: "$group", Value: bson.M{
+			"_id":        nil,
+			"count":      bson.M{"$sum": 1},
+			"calibrated": bson.M{"$sum": bson.M{"$cond": bson.A{bson.M{"$gt": bson.A{"$calibration_info.calibration_type", skp.CalibrationType_NO_CALIBRATION}}, 1, 0}}},
+			"bytes": bson.M{"$sum": bson.M{"$add": bson.A{
+				binarySize("$input_img"), binarySize("$calibration_img_axes"), binarySize("$calibration_img_vanishing_point"),
+			}}},
+			"last_upload": bson.M{"$max": "$timestamp"},
+		}}},
+	}
+	if err := d.aggregateOne(ctx, d.inputImageCollection, inputPipeline, &inputStats); err != nil {
+		return nil, fmt.Errorf("could not aggregate input images for user: %w", err)
+	}
+	var keypointStats struct {
+		Count int64 `bson:"count"`
+		Bytes int64 `bson:"bytes"`
+	}
+	keypointPipeline := mongodb.Pipeline{
+		match,
+		{{Key: "$group", Value: bson.M{
+			"_id":   nil,
+			"count": bson.M{"$sum": 1},
+			"bytes": bson.M{"$sum": binarySize("$output_img")},
+		}}},
+	}
+	if err := d.aggregateOne(ctx, d.golfKeypointCollection, keypointPipeline, &keypointStats); err != nil {
+		return nil, fmt.Errorf("could not aggregate golf keypoints for user: %w", err)
+	}
+	return &UserStats{
+		InputImages:      inputStats.Count,
+		CalibratedImages: inputStats.Calibrated,
+		GolfKeypoints:    keypointStats.Count,
+		ImageBytes:       inputStats.Bytes + keypointStats.Bytes,
+		LastUpload:       inputStats.LastUpload,
+	}, nil
+}
+
+// Decodes the only result of a pipeline, res is left as is if there are no results
+func (d *DbManager) aggregateOne(ctx context.Context, collection *mongodb.Collection, pipeline mongodb.Pipeline, res interface{}) error {
+	cursor, err := collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return err
+	}
+	defer cursor.Close(ctx)
+	if cursor.Next(ctx) {
+		return cursor.Decode(res)
+	}
+	return cursor.Err()
+}
@@ -0,0 +1,82 @@
+// Package keypointsclient has the client helpers shared by the command line tools and the test client
+package keypointsclient
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	skp "github.com/sirfrank96/go-server/sports-keypoints-proto"
+	"github.com/sirfrank96/go-server/util"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// Plain TCP if useInsecure, else TLS verified with caFile (system roots if empty) and an optional client cert for mutual TLS
+func GetTransportCredentials(useInsecure bool, caFile string, certFile string, keyFile string) (credentials.TransportCredentials, error) {
+	if useInsecure {
+		return insecure.NewCredentials(), nil
+	}
+	config := &tls.Config{MinVersion: tls.VersionTLS12}
+	if caFile != "" {
+		pool, err := util.LoadCertPool(caFile)
+		if err != nil {
+			return nil, err
+		}
+		config.RootCAs = pool
+	}
+	if certFile != "" || keyFile != "" {
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client key pair: %w", err)
+		}
+		config.Certificates = []tls.Certificate{cert}
+	}
+	return credentials.NewTLS(config), nil
+}
+
+// Middle arg is a close function, should be called by calling function
+func InitGolfKeypointsServiceGrpcClient(serveraddr string, creds credentials.TransportCredentials) (skp.GolfKeypointsServiceClient, func() error, error) {
+	conn, err := grpc.NewClient(serveraddr, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return nil, nil, err
+	}
+	return skp.NewGolfKeypointsServiceClient(conn), conn.Close, nil
+}
+
+// Imports keypoints from a json file, either openpose *_keypoints.json output or a Body25PoseKeypoints in protojson
+// form, eg. {"nose": {"x": 1, "y": 2, "confidence": 0.9}, ...}
+func ImportGolfKeypoints(ctx context.Context, gclient skp.GolfKeypointsServiceClient, sessionToken string, inputImgId string, keypointsPath string) (*skp.ImportGolfKeypointsResponse, error) {
+	data, err := os.ReadFile(keypointsPath)
+	if err != nil {
+		return nil, fmt.Errorf("could not read keypoints file: %w", err)
+	}
+	request := &skp.ImportGolfKeypointsRequest{
+		SessionToken: sessionToken,
+		InputImageId: inputImgId,
+	}
+	// openpose output always has a people list
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, fmt.Errorf("could not parse keypoints file %s: %w", keypointsPath, err)
+	}
+	if _, ok := fields["people"]; ok {
+		request.Keypoints = &skp.ImportGolfKeypointsRequest_OpenposeJson{OpenposeJson: data}
+	} else {
+		bodyKeypoints := &skp.Body25PoseKeypoints{}
+		if err := protojson.Unmarshal(data, bodyKeypoints); err != nil {
+			return nil, fmt.Errorf("could not parse body keypoints in %s: %w", keypointsPath, err)
+		}
+		request.Keypoints = &skp.ImportGolfKeypointsRequest_BodyKeypoints{BodyKeypoints: bodyKeypoints}
+	}
+	response, err := gclient.ImportGolfKeypoints(ctx, request)
+	if err != nil {
+		return nil, fmt.Errorf("could not import golf keypoints: %w", err)
+	}
+	return response, nil
+}
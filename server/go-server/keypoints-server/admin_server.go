@@ -0,0 +1,67 @@
+package keypointsserver
+
+import (
+	"context"
+
+	skp "github.com/sirfrank96/go-server/sports-keypoints-proto"
+)
+
+type adminServer struct {
+	skp.UnimplementedAdminServiceServer
+	handler skp.AdminServiceServer
+}
+
+func createNewAdminServer(handler skp.AdminServiceServer) *adminServer {
+	a := &adminServer{}
+	a.handler = handler
+	return a
+}
+
+func (a *adminServer) ListUsers(ctx context.Context, request *skp.ListUsersRequest) (*skp.ListUsersResponse, error) {
+	if err := verifyListUsersRequest(request); err != nil {
+		return nil, err
+	}
+	return a.handler.ListUsers(ctx, request)
+}
+
+func (a *adminServer) SetUserDisabled(ctx context.Context, request *skp.SetUserDisabledRequest) (*skp.SetUserDisabledResponse, error) {
+	if err := verifySetUserDisabledRequest(request); err != nil {
+		return nil, err
+	}
+	return a.handler.SetUserDisabled(ctx, request)
+}
+
+func (a *adminServer) ForcePasswordReset(ctx context.Context, request *skp.ForcePasswordResetRequest) (*skp.ForcePasswordResetResponse, error) {
+	if err := verifyForcePasswordResetRequest(request); err != nil {
+		return nil, err
+	}
+	return a.handler.ForcePasswordReset(ctx, request)
+}
+
+func (a *adminServer) SetUserRole(ctx context.Context, request *skp.SetUserRoleRequest) (*skp.SetUserRoleResponse, error) {
+	if err := verifySetUserRoleRequest(request); err != nil {
+		return nil, err
+	}
+	return a.handler.SetUserRole(ctx, request)
+}
+
+func (a *adminServer) GetUserStats(ctx context.Context, request *skp.GetUserStatsRequest) (*skp.GetUserStatsResponse, error) {
+	if err := verifyGetUserStatsRequest(request); err != nil {
+		return nil, err
+	}
+	return a.handler.GetUserStats(ctx, request)
+}
+
+func (a *adminServer) AdminDeleteUser(ctx context.Context, request *skp.AdminDeleteUserRequest) (*skp.AdminDeleteUserResponse, error) {
+	if err := verifyAdminDeleteUserRequest(request); err != nil {
+		return nil, err
+	}
+	return a.handler.AdminDeleteUser(ctx, request)
+}
+
+func (a *adminServer) RunMaintenanceJob(ctx context.Context, request *skp.RunMaintenanceJobRequest) (*skp.RunMaintenanceJobResponse, error) {
+	if err := verifyRunMaintenanceJobRequest(request); err != nil {
+		return nil, err
+	}
+	return a.handler.RunMaintenanceJob(ctx, request)
+}
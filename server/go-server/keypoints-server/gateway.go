@@ -0,0 +1,227 @@
+package keypointsserver
+
+import (
+	"context"
+	"crypto/tls"
+	_ "embed"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/sirfrank96/go-server/apierror"
+	skp "github.com/sirfrank96/go-server/sports-keypoints-proto"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// how long in-flight rest requests have to finish when the gateway stops
+const gatewayStopTimeout = 10 * time.Second
+
+// Generated from protos/gateway.yaml and protos/gateway_openapi.yaml, served at /v1/openapi.json
+//
+//go:embed openapi/keypoints.swagger.json
+var openapiDocument []byte
+
+const uploadInputImageMethod = "/sports_keypoints_proto.GolfKeypointsService/UploadInputImage"
+
+// REST/JSON gateway for the UserService and GolfKeypointsService (routes in protos/gateway.yaml).
+// Requests are translated to grpc and sent to an in-process grpc server over an in-memory listener, so they go through the
+// same interceptors (request ids, metrics, readiness, sessions) as grpc clients without a second tls handshake.
+type gateway struct {
+	maxUploadSize       int64
+	mux                 *runtime.ServeMux
+	httpServer          *http.Server
+	inProcessServer     *grpc.Server
+	inProcessListener   *bufconn.Listener
+	conn                *grpc.ClientConn
+	golfKeypointsClient skp.GolfKeypointsServiceClient
+}
+
+// Serves tls with the keypoints server's certificate unless tlsConfig is nil (keypoints_server.insecure)
+func (k *KeypointsServerManager) startGateway(tlsConfig *tls.Config) error {
+	g := &gateway{maxUploadSize: k.config.Gateway.MaxUploadSize}
+	g.inProcessListener = bufconn.Listen(1 << 20)
+	g.inProcessServer = k.newGrpcServer(insecure.NewCredentials())
+	go g.inProcessServer.Serve(g.inProcessListener)
+	conn, err := grpc.NewClient("passthrough:///keypoints-server",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return g.inProcessListener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		// the in-process server's limits, so uploads up to gateway.max_upload_size are not rejected on the way
+		grpc.WithDefaultCallOptions(grpc.MaxCallSendMsgSize(k.config.Messages.MaxRecvSize), grpc.MaxCallRecvMsgSize(k.config.Messages.MaxSendSize)),
+	)
+	if err != nil {
+		return fmt.Errorf("could not connect to in-process grpc server: %w", err)
+	}
+	g.conn = conn
+	g.golfKeypointsClient = skp.NewGolfKeypointsServiceClient(conn)
+	g.mux = runtime.NewServeMux(
+		// proto field names in json, the same names as in field violations and the openapi document
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{
+			MarshalOptions:   protojson.MarshalOptions{UseProtoNames: true, EmitUnpopulated: true},
+			UnmarshalOptions: protojson.UnmarshalOptions{DiscardUnknown: true},
+		}),
+		runtime.WithIncomingHeaderMatcher(incomingHeaderMatcher),
+		runtime.WithOutgoingHeaderMatcher(outgoingHeaderMatcher),
+	)
+	ctx := context.Background()
+	if err := skp.RegisterUserServiceHandler(ctx, g.mux, conn); err != nil {
+		return fmt.Errorf("could not register user service handler: %w", err)
+	}
+	if err := skp.RegisterGolfKeypointsServiceHandler(ctx, g.mux, conn); err != nil {
+		return fmt.Errorf("could not register golf keypoints service handler: %w", err)
+	}
+	if err := g.mux.HandlePath(http.MethodGet, "/v1/openapi.json", serveOpenapiDocument); err != nil {
+		return fmt.Errorf("could not register openapi handler: %w", err)
+	}
+	lis, err := net.Listen("tcp", fmt.Sprintf("0.0.0.0:%d", k.config.Gateway.Port))
+	if err != nil {
+		return fmt.Errorf("failed to listen: %w", err)
+	}
+	g.httpServer = &http.Server{Handler: g, ReadHeaderTimeout: 10 * time.Second}
+	k.gateway = g
+	go func() {
+		var err error
+		if tlsConfig != nil {
+			g.httpServer.TLSConfig = tlsConfig.Clone()
+			err = g.httpServer.ServeTLS(lis, "", "")
+		} else {
+			err = g.httpServer.Serve(lis)
+		}
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logger.Error("rest gateway stopped", "error", err)
+		}
+	}()
+	logger.Info("started rest gateway", "port", k.config.Gateway.Port, "tls", tlsConfig != nil)
+	return nil
+}
+
+func (k *KeypointsServerManager) stopGateway() error {
+	if k.gateway == nil {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), gatewayStopTimeout)
+	defer cancel()
+	if err := k.gateway.httpServer.Shutdown(ctx); err != nil {
+		return fmt.Errorf("could not stop rest gateway: %w", err)
+	}
+	k.gateway.conn.Close()
+	k.gateway.inProcessServer.GracefulStop()
+	return nil
+}
+
+// Multipart uploads to POST /v1/images are handled here, everything else goes to the generated routes
+func (g *gateway) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method == http.MethodPost && r.URL.Path == "/v1/images" && isMultipartForm(r) {
+		g.uploadMultipartImage(w, r)
+		return
+	}
+	g.mux.ServeHTTP(w, r)
+}
+
+func (g *gateway) uploadMultipartImage(w http.ResponseWriter, r *http.Request) {
+	_, marshaler := runtime.MarshalerForRequest(g.mux, r)
+	ctx, err := runtime.AnnotateContext(r.Context(), g.mux, r, uploadInputImageMethod, runtime.WithHTTPPathPattern("/v1/images"))
+	if err != nil {
+		runtime.HTTPError(r.Context(), g.mux, marshaler, w, r, err)
+		return
+	}
+	request, err := parseMultipartUploadRequest(w, r, g.maxUploadSize)
+	if err != nil {
+		runtime.HTTPError(ctx, g.mux, marshaler, w, r, err)
+		return
+	}
+	var header metadata.MD
+	response, err := g.golfKeypointsClient.UploadInputImage(ctx, request, grpc.Header(&header))
+	ctx = runtime.NewServerMetadataContext(ctx, runtime.ServerMetadata{HeaderMD: header})
+	if err != nil {
+		runtime.HTTPError(ctx, g.mux, marshaler, w, r, err)
+		return
+	}
+	runtime.ForwardResponseMessage(ctx, g.mux, marshaler, w, r, response)
+}
+
+func isMultipartForm(r *http.Request) bool {
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	return err == nil && mediaType == "multipart/form-data"
+}
+
+// Reads the image file part and the image_type, description and timestamp (RFC 3339, defaults to now) fields.
+// The request is verified by the keypoints server like any other upload.
+func parseMultipartUploadRequest(w http.ResponseWriter, r *http.Request, maxUploadSize int64) (*skp.UploadInputImageRequest, error) {
+	r.Body = http.MaxBytesReader(w, r.Body, maxUploadSize)
+	if err := r.ParseMultipartForm(maxUploadSize); err != nil {
+		return nil, apierror.InvalidArgument("", "could not parse multipart form: %s", err.Error())
+	}
+	file, _, err := r.FormFile("image")
+	if err != nil {
+		return nil, apierror.InvalidArgument("image", "please upload an input image as the image file part")
+	}
+	defer file.Close()
+	image, err := io.ReadAll(file)
+	if err != nil {
+		return nil, apierror.InvalidArgument("image", "could not read image: %s", err.Error())
+	}
+	request := &skp.UploadInputImageRequest{
+		Image:       image,
+		Description: r.FormValue("description"),
+		Timestamp:   timestamppb.Now(),
+	}
+	if imageType := r.FormValue("image_type"); imageType != "" {
+		value, ok := skp.ImageType_value[strings.ToUpper(imageType)]
+		if !ok {
+			return nil, apierror.InvalidArgument("image_type", "unknown image type %s", imageType)
+		}
+		request.ImageType = skp.ImageType(value)
+	}
+	if timestamp := r.FormValue("timestamp"); timestamp != "" {
+		parsed, err := time.Parse(time.RFC3339, timestamp)
+		if err != nil {
+			return nil, apierror.InvalidArgument("timestamp", "invalid timestamp, expected RFC 3339: %s", err.Error())
+		}
+		request.Timestamp = timestamppb.New(parsed)
+	}
+	return request, nil
+}
+
+func serveOpenapiDocument(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(openapiDocument)
+}
+
+// The Authorization header is always forwarded (as authorization metadata), x-request-id is forwarded so callers can
+// correlate requests with the server logs and Idempotency-Key so retried uploads are only executed once
+func incomingHeaderMatcher(key string) (string, bool) {
+	if strings.EqualFold(key, requestIdHeader) {
+		return requestIdHeader, true
+	}
+	if strings.EqualFold(key, idempotencyKeyHeader) {
+		return idempotencyKeyHeader, true
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
+
+// x-request-id, retry-after and idempotent-replayed are returned as plain http headers, other metadata with the Grpc-Metadata- prefix
+func outgoingHeaderMatcher(key string) (string, bool) {
+	switch key {
+	case requestIdHeader:
+		return "X-Request-Id", true
+	case retryAfterHeader:
+		return "Retry-After", true
+	case idempotentReplayedHeader:
+		return "Idempotent-Replayed", true
+	}
+	return fmt.Sprintf("%s%s", runtime.MetadataHeaderPrefix, key), true
+}
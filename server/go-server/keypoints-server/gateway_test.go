@@ -0,0 +1,90 @@
+package keypointsserver
+
+import (
+	"bytes"
+	"context"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"google.golang.org/grpc/metadata"
+
+	"github.com/sirfrank96/go-server/apierror"
+	skp "github.com/sirfrank96/go-server/sports-keypoints-proto"
+)
+
+func newMultipartRequest(t *testing.T, fields map[string]string, image []byte) *http.Request {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	for key, value := range fields {
+		if err := writer.WriteField(key, value); err != nil {
+			t.Fatalf("could not write field %s: %s", key, err.Error())
+		}
+	}
+	if image != nil {
+		part, err := writer.CreateFormFile("image", "swing.jpg")
+		if err != nil {
+			t.Fatalf("could not create image part: %s", err.Error())
+		}
+		part.Write(image)
+	}
+	writer.Close()
+	r := httptest.NewRequest(http.MethodPost, "/v1/images", body)
+	r.Header.Set("Content-Type", writer.FormDataContentType())
+	return r
+}
+
+func TestParseMultipartUploadRequest(t *testing.T) {
+	// good request
+	r := newMultipartRequest(t, map[string]string{"image_type": "dtl", "description": "driver", "timestamp": "2025-06-01T10:00:00Z"}, []byte("image bytes"))
+	if !isMultipartForm(r) {
+		t.Errorf("isMultipartForm is false for %s", r.Header.Get("Content-Type"))
+	}
+	request, err := parseMultipartUploadRequest(httptest.NewRecorder(), r, 1<<20)
+	if err != nil {
+		t.Fatalf("parseMultipartUploadRequest had an unexpected error: %s", err.Error())
+	}
+	if request.ImageType != skp.ImageType_DTL || string(request.Image) != "image bytes" || request.Description != "driver" || request.Timestamp.AsTime().Year() != 2025 {
+		t.Errorf("parseMultipartUploadRequest returned unexpected request %+v", request)
+	}
+	// no image
+	r = newMultipartRequest(t, map[string]string{"image_type": "dtl"}, nil)
+	_, err = parseMultipartUploadRequest(httptest.NewRecorder(), r, 1<<20)
+	if violations := apierror.FieldViolations(err); len(violations) != 1 || violations[0].Field != "image" {
+		t.Errorf("parseMultipartUploadRequest without an image returned %v, expected an image field violation", err)
+	}
+	// bad image type
+	r = newMultipartRequest(t, map[string]string{"image_type": "sideways"}, []byte("image bytes"))
+	_, err = parseMultipartUploadRequest(httptest.NewRecorder(), r, 1<<20)
+	if violations := apierror.FieldViolations(err); len(violations) != 1 || violations[0].Field != "image_type" {
+		t.Errorf("parseMultipartUploadRequest with a bad image type returned %v, expected an image_type field violation", err)
+	}
+	// bad timestamp
+	r = newMultipartRequest(t, map[string]string{"timestamp": "yesterday"}, []byte("image bytes"))
+	_, err = parseMultipartUploadRequest(httptest.NewRecorder(), r, 1<<20)
+	if violations := apierror.FieldViolations(err); len(violations) != 1 || violations[0].Field != "timestamp" {
+		t.Errorf("parseMultipartUploadRequest with a bad timestamp returned %v, expected a timestamp field violation", err)
+	}
+}
+
+func TestGetBearerToken(t *testing.T) {
+	tests := []struct {
+		authorization string
+		token         string
+	}{
+		{"Bearer abc.def.ghi", "abc.def.ghi"},
+		{"bearer abc.def.ghi", "abc.def.ghi"},
+		{"Basic dXNlcjpwYXNz", ""},
+		{"abc.def.ghi", ""},
+	}
+	for _, test := range tests {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", test.authorization))
+		if token := getBearerToken(ctx); token != test.token {
+			t.Errorf("getBearerToken(%s) is %s, expected %s", test.authorization, token, test.token)
+		}
+	}
+	if token := getBearerToken(context.Background()); token != "" {
+		t.Errorf("getBearerToken without metadata is %s, expected empty", token)
+	}
+}
@@ -9,11 +9,16 @@ import (
 type golfKeypointsServer struct {
 	skp.UnimplementedGolfKeypointsServiceServer
 	handler skp.GolfKeypointsServiceServer
+	// largest image of an upload, streaming uploads are checked while they are assembled
+	maxImageSize int64
+	// set with the idempotency store, streaming uploads are not covered by the unary interceptor
+	idempotency *idempotency
 }
 
-func createNewGolfKeypointsServer(handler skp.GolfKeypointsServiceServer) *golfKeypointsServer {
+func createNewGolfKeypointsServer(handler skp.GolfKeypointsServiceServer, maxImageSize int64) *golfKeypointsServer {
 	g := &golfKeypointsServer{}
 	g.handler = handler
+	g.maxImageSize = maxImageSize
 	return g
 }
 
@@ -21,6 +26,9 @@ func (g *golfKeypointsServer) UploadInputImage(ctx context.Context, request *skp
 	if err := verifyUploadInputImageRequest(request); err != nil {
 		return nil, err
 	}
+	if err := verifyImageSize("image", request.Image, g.maxImageSize); err != nil {
+		return nil, err
+	}
 	return g.handler.UploadInputImage(ctx, request)
 }
 
@@ -49,6 +57,12 @@ func (g *golfKeypointsServer) CalibrateInputImage(ctx context.Context, request *
 	if err := verifyCalibrateInputImageRequest(request); err != nil {
 		return nil, err
 	}
+	if err := verifyImageSize("calibration_image_axes", request.CalibrationImageAxes, g.maxImageSize); err != nil {
+		return nil, err
+	}
+	if err := verifyImageSize("calibration_image_vanishing_point", request.CalibrationImageVanishingPoint, g.maxImageSize); err != nil {
+		return nil, err
+	}
 	return g.handler.CalibrateInputImage(ctx, request)
 }
 
@@ -73,6 +87,13 @@ func (g *golfKeypointsServer) UpdateBodyKeypoints(ctx context.Context, request *
 	return g.handler.UpdateBodyKeypoints(ctx, request)
 }
 
+func (g *golfKeypointsServer) ImportGolfKeypoints(ctx context.Context, request *skp.ImportGolfKeypointsRequest) (*skp.ImportGolfKeypointsResponse, error) {
+	if err := verifyImportGolfKeypointsRequest(request); err != nil {
+		return nil, err
+	}
+	return g.handler.ImportGolfKeypoints(ctx, request)
+}
+
 func (g *golfKeypointsServer) DeleteGolfKeypoints(ctx context.Context, request *skp.DeleteGolfKeypointsRequest) (*skp.DeleteGolfKeypointsResponse, error) {
 	if err := verifyDeleteGolfKeypointsRequest(request); err != nil {
 		return nil, err
@@ -80,6 +101,48 @@ func (g *golfKeypointsServer) DeleteGolfKeypoints(ctx context.Context, request *
 	return g.handler.DeleteGolfKeypoints(ctx, request)
 }
 
+func (g *golfKeypointsServer) DownloadInputImage(request *skp.DownloadInputImageRequest, stream skp.GolfKeypointsService_DownloadInputImageServer) error {
+	if err := verifyDownloadInputImageRequest(request); err != nil {
+		return err
+	}
+	return g.handler.DownloadInputImage(request, stream)
+}
+
+func (g *golfKeypointsServer) DownloadCalibrationImage(request *skp.DownloadCalibrationImageRequest, stream skp.GolfKeypointsService_DownloadCalibrationImageServer) error {
+	if err := verifyDownloadCalibrationImageRequest(request); err != nil {
+		return err
+	}
+	return g.handler.DownloadCalibrationImage(request, stream)
+}
+
+func (g *golfKeypointsServer) DownloadOutputImage(request *skp.DownloadOutputImageRequest, stream skp.GolfKeypointsService_DownloadOutputImageServer) error {
+	if err := verifyDownloadOutputImageRequest(request); err != nil {
+		return err
+	}
+	return g.handler.DownloadOutputImage(request, stream)
+}
+
+func (g *golfKeypointsServer) SubmitAnalysis(ctx context.Context, request *skp.SubmitAnalysisRequest) (*skp.SubmitAnalysisResponse, error) {
+	if err := verifySubmitAnalysisRequest(request); err != nil {
+		return nil, err
+	}
+	return g.handler.SubmitAnalysis(ctx, request)
+}
+
+func (g *golfKeypointsServer) GetAnalysisJob(ctx context.Context, request *skp.GetAnalysisJobRequest) (*skp.GetAnalysisJobResponse, error) {
+	if err := verifyGetAnalysisJobRequest(request); err != nil {
+		return nil, err
+	}
+	return g.handler.GetAnalysisJob(ctx, request)
+}
+
+func (g *golfKeypointsServer) WatchAnalysisJob(request *skp.WatchAnalysisJobRequest, stream skp.GolfKeypointsService_WatchAnalysisJobServer) error {
+	if err := verifyWatchAnalysisJobRequest(request); err != nil {
+		return err
+	}
+	return g.handler.WatchAnalysisJob(request, stream)
+}
+
 /*func (g *golfKeypointsServer) CreateGolfKeypointsFromVideo(stream skp.GolfKeypointsService_CreateGolfKeypointsFromVideoServer) error {
 	requests := []*cv.CreateGolfKeypointsRequest{}
 	for {
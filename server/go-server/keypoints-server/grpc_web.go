@@ -0,0 +1,110 @@
+package keypointsserver
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/improbable-eng/grpc-web/go/grpcweb"
+)
+
+// how long in-flight gRPC-Web requests have to finish when the server stops
+const grpcWebStopTimeout = 10 * time.Second
+
+// Serves gRPC-Web requests (application/grpc-web and application/grpc-web-text, incl. CORS preflights) with the keypoints grpc
+// server, so they go through the same interceptors. Unary and server-streaming rpcs are supported.
+// If serveNativeGrpc is set, every other request is handed to the grpc server as native grpc over http2.
+func (k *KeypointsServerManager) newGrpcWebHandler(serveNativeGrpc bool) http.Handler {
+	allowedOrigins := parseAllowedOrigins(k.config.GrpcWeb.AllowedOrigins)
+	wrappedServer := grpcweb.WrapServer(k.grpcServer,
+		grpcweb.WithOriginFunc(func(origin string) bool {
+			return isAllowedOrigin(allowedOrigins, origin)
+		}),
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if wrappedServer.IsGrpcWebRequest(r) || wrappedServer.IsAcceptableGrpcCorsRequest(r) {
+			wrappedServer.ServeHTTP(w, r)
+			return
+		}
+		if serveNativeGrpc {
+			k.grpcServer.ServeHTTP(w, r)
+			return
+		}
+		http.NotFound(w, r)
+	})
+}
+
+// Serves on lis with tls unless tlsConfig is nil (keypoints_server.insecure), cleartext http2 is allowed so native grpc clients work without tls
+func (k *KeypointsServerManager) serveGrpcWeb(lis net.Listener, tlsConfig *tls.Config, serveNativeGrpc bool) error {
+	protocols := &http.Protocols{}
+	protocols.SetHTTP1(true)
+	protocols.SetHTTP2(true)
+	protocols.SetUnencryptedHTTP2(true)
+	k.grpcWebServer = &http.Server{
+		Handler:           k.newGrpcWebHandler(serveNativeGrpc),
+		Protocols:         protocols,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	var err error
+	if tlsConfig != nil {
+		k.grpcWebServer.TLSConfig = tlsConfig.Clone()
+		err = k.grpcWebServer.ServeTLS(lis, "", "")
+	} else {
+		err = k.grpcWebServer.Serve(lis)
+	}
+	if err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+// Serves gRPC-Web on grpc_web.port in the background, used when gRPC-Web is not served on the grpc port
+func (k *KeypointsServerManager) startGrpcWebServer(tlsConfig *tls.Config) error {
+	lis, err := net.Listen("tcp", fmt.Sprintf("0.0.0.0:%d", k.config.GrpcWeb.Port))
+	if err != nil {
+		return fmt.Errorf("failed to listen: %w", err)
+	}
+	go func() {
+		if err := k.serveGrpcWeb(lis, tlsConfig, false); err != nil {
+			logger.Error("grpc-web server stopped", "error", err)
+		}
+	}()
+	logger.Info("started grpc-web server", "port", k.config.GrpcWeb.Port, "tls", tlsConfig != nil)
+	return nil
+}
+
+func (k *KeypointsServerManager) stopGrpcWebServer() error {
+	if k.grpcWebServer == nil {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), grpcWebStopTimeout)
+	defer cancel()
+	if err := k.grpcWebServer.Shutdown(ctx); err != nil {
+		return fmt.Errorf("could not stop grpc-web server: %w", err)
+	}
+	return nil
+}
+
+func parseAllowedOrigins(origins string) []string {
+	var allowedOrigins []string
+	for _, origin := range strings.Split(origins, ",") {
+		if origin = strings.TrimSpace(origin); origin != "" {
+			allowedOrigins = append(allowedOrigins, strings.TrimSuffix(origin, "/"))
+		}
+	}
+	return allowedOrigins
+}
+
+func isAllowedOrigin(allowedOrigins []string, origin string) bool {
+	for _, allowedOrigin := range allowedOrigins {
+		if allowedOrigin == "*" || strings.EqualFold(allowedOrigin, origin) {
+			return true
+		}
+	}
+	return false
+}
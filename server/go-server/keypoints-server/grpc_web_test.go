@@ -0,0 +1,134 @@
+package keypointsserver
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/sirfrank96/go-server/config"
+
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// Frames a message like a gRPC-Web client: flag byte, 4 byte big endian length, message
+func grpcWebFrame(t *testing.T, message proto.Message) []byte {
+	data, err := proto.Marshal(message)
+	if err != nil {
+		t.Fatalf("could not marshal %v: %s", message, err.Error())
+	}
+	frame := make([]byte, 5, 5+len(data))
+	binary.BigEndian.PutUint32(frame[1:], uint32(len(data)))
+	return append(frame, data...)
+}
+
+// Returns the first data frame and the trailer frame of a gRPC-Web response body
+func readGrpcWebFrames(t *testing.T, body []byte) ([]byte, string) {
+	var data []byte
+	var trailer string
+	for len(body) >= 5 {
+		length := binary.BigEndian.Uint32(body[1:5])
+		if body[0]&0x80 != 0 {
+			trailer = string(body[5 : 5+length])
+		} else if data == nil {
+			data = body[5 : 5+length]
+		}
+		body = body[5+length:]
+	}
+	return data, trailer
+}
+
+// Every frame of a grpc-web-text response is base64 encoded on its own, padded chunks are concatenated
+func decodeGrpcWebText(t *testing.T, text string) []byte {
+	var decoded []byte
+	chunkStart := 0
+	for idx := 0; idx+4 <= len(text); idx += 4 {
+		if strings.Contains(text[idx:idx+4], "=") || idx+4 == len(text) {
+			chunk, err := base64.StdEncoding.DecodeString(text[chunkStart : idx+4])
+			if err != nil {
+				t.Fatalf("could not decode grpc-web-text response: %s", err.Error())
+			}
+			decoded = append(decoded, chunk...)
+			chunkStart = idx + 4
+		}
+	}
+	return decoded
+}
+
+func newTestGrpcWebServer(t *testing.T) *httptest.Server {
+	serverConfig := config.Default().KeypointsServer
+	serverConfig.GrpcWeb.AllowedOrigins = "https://app.example.com"
+	k := NewKeypointsServerManager(serverConfig, nil, nil, nil, nil)
+	k.grpcServer = k.newGrpcServer(insecure.NewCredentials())
+	server := httptest.NewServer(k.newGrpcWebHandler(false))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestGrpcWebUnary(t *testing.T) {
+	server := newTestGrpcWebServer(t)
+	request := grpcWebFrame(t, &healthpb.HealthCheckRequest{})
+	for _, contentType := range []string{"application/grpc-web+proto", "application/grpc-web-text"} {
+		body := request
+		if strings.HasPrefix(contentType, "application/grpc-web-text") {
+			body = []byte(base64.StdEncoding.EncodeToString(request))
+		}
+		resp, err := http.Post(server.URL+"/grpc.health.v1.Health/Check", contentType, bytes.NewReader(body))
+		if err != nil {
+			t.Fatalf("%s request failed: %s", contentType, err.Error())
+		}
+		respBody, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("%s request returned %d: %s", contentType, resp.StatusCode, respBody)
+		}
+		if strings.HasPrefix(contentType, "application/grpc-web-text") {
+			respBody = decodeGrpcWebText(t, string(respBody))
+		}
+		data, trailer := readGrpcWebFrames(t, respBody)
+		if !strings.Contains(trailer, "grpc-status: 0") {
+			t.Errorf("%s trailer is %q, expected grpc-status 0", contentType, trailer)
+		}
+		response := &healthpb.HealthCheckResponse{}
+		if err := proto.Unmarshal(data, response); err != nil {
+			t.Fatalf("could not unmarshal %s response: %s", contentType, err.Error())
+		}
+		// probes have not run, so the server is not serving yet
+		if response.Status != healthpb.HealthCheckResponse_NOT_SERVING {
+			t.Errorf("%s health status is %s, expected %s", contentType, response.Status, healthpb.HealthCheckResponse_NOT_SERVING)
+		}
+	}
+}
+
+func TestGrpcWebCors(t *testing.T) {
+	server := newTestGrpcWebServer(t)
+	for origin, allowed := range map[string]bool{"https://app.example.com": true, "https://evil.example.com": false} {
+		request, _ := http.NewRequest(http.MethodOptions, server.URL+"/grpc.health.v1.Health/Check", nil)
+		request.Header.Set("Origin", origin)
+		request.Header.Set("Access-Control-Request-Method", http.MethodPost)
+		request.Header.Set("Access-Control-Request-Headers", "content-type,x-grpc-web")
+		resp, err := http.DefaultClient.Do(request)
+		if err != nil {
+			t.Fatalf("preflight request failed: %s", err.Error())
+		}
+		resp.Body.Close()
+		if got := resp.Header.Get("Access-Control-Allow-Origin") == origin; got != allowed {
+			t.Errorf("preflight from %s allowed is %t, expected %t", origin, got, allowed)
+		}
+	}
+	// native grpc is not served on a grpc-web only port
+	resp, err := http.Get(server.URL + "/grpc.health.v1.Health/Check")
+	if err != nil {
+		t.Fatalf("request failed: %s", err.Error())
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusNotFound {
+		t.Errorf("non grpc-web request returned %d, expected %d", resp.StatusCode, http.StatusNotFound)
+	}
+}
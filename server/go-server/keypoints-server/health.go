@@ -0,0 +1,160 @@
+package keypointsserver
+
+import (
+	"context"
+	"maps"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// how long a single dependency probe can take before the dependency is reported as not serving
+const healthProbeTimeout = 5 * time.Second
+
+// Returns an error if a dependency (eg. mongodb, computervision) is not usable
+type HealthProbe func(ctx context.Context) error
+
+// Runs the dependency probes in the background and reports the results through the standard grpc.health.v1 service.
+// Each dependency is reported under its own name, the overall status ("") and the keypoints services are only SERVING when all dependencies are.
+type healthChecker struct {
+	mutex      sync.Mutex
+	server     *health.Server
+	probeNames []string
+	probes     map[string]HealthProbe
+	// reported under their name but not needed for readiness
+	monitors     map[string]bool
+	serviceNames []string
+	statuses     map[string]healthpb.HealthCheckResponse_ServingStatus
+	ready        bool
+	stopChan     chan struct{}
+	wg           sync.WaitGroup
+}
+
+func newHealthChecker(serviceNames []string) *healthChecker {
+	h := &healthChecker{
+		server:       health.NewServer(),
+		probes:       map[string]HealthProbe{},
+		monitors:     map[string]bool{},
+		serviceNames: serviceNames,
+		statuses:     map[string]healthpb.HealthCheckResponse_ServingStatus{},
+		stopChan:     make(chan struct{}),
+	}
+	// health.NewServer starts with the overall status SERVING, not serving until the first probes pass
+	h.setOverallStatus(healthpb.HealthCheckResponse_NOT_SERVING)
+	return h
+}
+
+func (h *healthChecker) addProbe(name string, probe HealthProbe) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	h.probeNames = append(h.probeNames, name)
+	h.probes[name] = probe
+	h.statuses[name] = healthpb.HealthCheckResponse_NOT_SERVING
+	h.server.SetServingStatus(name, healthpb.HealthCheckResponse_NOT_SERVING)
+}
+
+// A monitor is probed and reported like a dependency, but the keypoints server stays ready while it fails
+func (h *healthChecker) addMonitor(name string, probe HealthProbe) {
+	h.addProbe(name, probe)
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	h.monitors[name] = true
+}
+
+func (h *healthChecker) start(interval time.Duration) {
+	h.wg.Add(1)
+	go func() {
+		defer h.wg.Done()
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			h.probeAll()
+			select {
+			case <-h.stopChan:
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Reports NOT_SERVING for everything so load balancers stop sending requests before the server drains
+func (h *healthChecker) stop() {
+	close(h.stopChan)
+	h.wg.Wait()
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	h.ready = false
+	h.server.Shutdown()
+}
+
+func (h *healthChecker) isReady() bool {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	return h.ready
+}
+
+func (h *healthChecker) probeAll() {
+	h.mutex.Lock()
+	probeNames := append([]string{}, h.probeNames...)
+	monitors := maps.Clone(h.monitors)
+	h.mutex.Unlock()
+	ready := true
+	for _, name := range probeNames {
+		status := h.probe(name)
+		if status != healthpb.HealthCheckResponse_SERVING && !monitors[name] {
+			ready = false
+		}
+	}
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	select {
+	case <-h.stopChan:
+		// stopping, keep reporting not serving
+		return
+	default:
+	}
+	if ready != h.ready {
+		logger.Info("keypoints server ready changed", "ready", ready)
+	}
+	h.ready = ready
+	if ready {
+		h.setOverallStatus(healthpb.HealthCheckResponse_SERVING)
+	} else {
+		h.setOverallStatus(healthpb.HealthCheckResponse_NOT_SERVING)
+	}
+}
+
+func (h *healthChecker) probe(name string) healthpb.HealthCheckResponse_ServingStatus {
+	h.mutex.Lock()
+	probe := h.probes[name]
+	h.mutex.Unlock()
+	ctx, cancel := context.WithTimeout(context.Background(), healthProbeTimeout)
+	defer cancel()
+	status := healthpb.HealthCheckResponse_SERVING
+	err := probe(ctx)
+	if err != nil {
+		status = healthpb.HealthCheckResponse_NOT_SERVING
+	}
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	if h.statuses[name] != status {
+		if err != nil {
+			logger.Warn("dependency health changed", "dependency", name, "status", status.String(), "error", err)
+		} else {
+			logger.Info("dependency health changed", "dependency", name, "status", status.String())
+		}
+	}
+	h.statuses[name] = status
+	h.server.SetServingStatus(name, status)
+	return status
+}
+
+func (h *healthChecker) setOverallStatus(status healthpb.HealthCheckResponse_ServingStatus) {
+	h.server.SetServingStatus("", status)
+	for _, serviceName := range h.serviceNames {
+		h.server.SetServingStatus(serviceName, status)
+	}
+}
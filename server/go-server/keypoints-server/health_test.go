@@ -0,0 +1,68 @@
+package keypointsserver
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func getHealthStatus(t *testing.T, h *healthChecker, service string) healthpb.HealthCheckResponse_ServingStatus {
+	response, err := h.server.Check(context.Background(), &healthpb.HealthCheckRequest{Service: service})
+	if err != nil {
+		t.Fatalf("Check(%s) had an unexpected error: %s", service, err.Error())
+	}
+	return response.Status
+}
+
+func TestHealthChecker(t *testing.T) {
+	h := newHealthChecker([]string{"sports_keypoints_proto.UserService"})
+	var mongoErr error
+	h.addProbe("mongodb", func(ctx context.Context) error { return mongoErr })
+	h.addProbe("computervision", func(ctx context.Context) error { return nil })
+	breakerErr := fmt.Errorf("circuit breaker is open")
+	h.addMonitor("computervision_circuit_breaker", func(ctx context.Context) error { return breakerErr })
+	// not serving before the first probes
+	if h.isReady() || getHealthStatus(t, h, "") != healthpb.HealthCheckResponse_NOT_SERVING {
+		t.Errorf("health checker is supposed to be not serving before probing")
+	}
+	// one dependency down
+	mongoErr = fmt.Errorf("connection refused")
+	h.probeAll()
+	if h.isReady() {
+		t.Errorf("health checker is not supposed to be ready with mongodb down")
+	}
+	if status := getHealthStatus(t, h, "mongodb"); status != healthpb.HealthCheckResponse_NOT_SERVING {
+		t.Errorf("mongodb status is %s, expected NOT_SERVING", status)
+	}
+	if status := getHealthStatus(t, h, "computervision"); status != healthpb.HealthCheckResponse_SERVING {
+		t.Errorf("computervision status is %s, expected SERVING", status)
+	}
+	if status := getHealthStatus(t, h, "sports_keypoints_proto.UserService"); status != healthpb.HealthCheckResponse_NOT_SERVING {
+		t.Errorf("UserService status is %s, expected NOT_SERVING", status)
+	}
+	// all dependencies up
+	mongoErr = nil
+	h.probeAll()
+	if !h.isReady() {
+		t.Errorf("health checker is supposed to be ready with all dependencies up")
+	}
+	if status := getHealthStatus(t, h, ""); status != healthpb.HealthCheckResponse_SERVING {
+		t.Errorf("overall status is %s, expected SERVING", status)
+	}
+	// failing monitors are reported without making the server unready
+	if status := getHealthStatus(t, h, "computervision_circuit_breaker"); status != healthpb.HealthCheckResponse_NOT_SERVING {
+		t.Errorf("computervision_circuit_breaker status is %s, expected NOT_SERVING", status)
+	}
+	breakerErr = nil
+	h.probeAll()
+	if status := getHealthStatus(t, h, "computervision_circuit_breaker"); status != healthpb.HealthCheckResponse_SERVING {
+		t.Errorf("computervision_circuit_breaker status is %s, expected SERVING", status)
+	}
+	// stopping
+	h.stop()
+	if h.isReady() || getHealthStatus(t, h, "") != healthpb.HealthCheckResponse_NOT_SERVING {
+		t.Errorf("health checker is supposed to be not serving after stopping")
+	}
+}
@@ -0,0 +1,188 @@
+package keypointsserver
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/sirfrank96/go-server/apierror"
+	"github.com/sirfrank96/go-server/config"
+	"github.com/sirfrank96/go-server/metrics"
+	"github.com/sirfrank96/go-server/util"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+const (
+	idempotencyKeyHeader = "idempotency-key"
+	// set on responses that were stored by an earlier request with the same key
+	idempotentReplayedHeader = "idempotent-replayed"
+	maxIdempotencyKeyLength  = 255
+	// a key stays reserved this long while its request runs, a retry can take it over afterwards (eg. if the server
+	// stopped in the middle of the request)
+	idempotencyPendingTimeout = 5 * time.Minute
+)
+
+// Stores the responses of rpcs sent with an idempotency key, implemented by the db manager
+type IdempotencyStore interface {
+	// Returns the stored response if the request already completed, nil if the caller should execute it
+	ReserveIdempotencyKey(ctx context.Context, key string, fingerprint string, expiresAt time.Time) ([]byte, error)
+	// reservedUntil is the expiresAt the key was reserved with, the key is only updated if it is still reserved by this request
+	CompleteIdempotencyKey(ctx context.Context, key string, fingerprint string, reservedUntil time.Time, response []byte, expiresAt time.Time) error
+	ReleaseIdempotencyKey(ctx context.Context, key string, fingerprint string, reservedUntil time.Time) error
+}
+
+type idempotency struct {
+	store  IdempotencyStore
+	window time.Duration
+	// keyed by rpc name (eg. UploadInputImage)
+	methods map[string]bool
+	now     func() time.Time
+}
+
+// nil if idempotency keys are disabled
+func newIdempotency(store IdempotencyStore, idempotencyConfig config.IdempotencyConfig) *idempotency {
+	if store == nil || idempotencyConfig.Window == 0 {
+		return nil
+	}
+	methods := map[string]bool{}
+	for _, method := range strings.Split(idempotencyConfig.Methods, ",") {
+		if method = strings.TrimSpace(method); method != "" {
+			methods[method] = true
+		}
+	}
+	return &idempotency{store: store, window: idempotencyConfig.Window, methods: methods, now: time.Now}
+}
+
+// The idempotency store must be set before starting the keypoints server
+func (k *KeypointsServerManager) SetIdempotencyStore(store IdempotencyStore) {
+	k.idempotency = newIdempotency(store, k.config.Idempotency)
+	k.golfKeypointsServer.idempotency = k.idempotency
+}
+
+// Runs after the session interceptor, keys are scoped to the user and method so users can not see each other's responses
+func (k *KeypointsServerManager) idempotencyUnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	return k.idempotency.handleMethod(ctx, req, info.FullMethod, handler)
+}
+
+// Executes req with its idempotency key if the method accepts keys. Streaming uploads call this with the request
+// assembled from the stream, so a retried stream is deduplicated like the unary rpc.
+func (i *idempotency) handleMethod(ctx context.Context, req interface{}, fullMethod string, handler grpc.UnaryHandler) (interface{}, error) {
+	if i == nil || !i.methods[rpcName(fullMethod)] {
+		return handler(ctx, req)
+	}
+	return i.handle(ctx, req, fullMethod, handler)
+}
+
+func (i *idempotency) handle(ctx context.Context, req interface{}, fullMethod string, handler grpc.UnaryHandler) (interface{}, error) {
+	idempotencyKey := getIdempotencyKey(ctx)
+	userId, ok := ctx.Value(util.UserIdKey).(string)
+	if idempotencyKey == "" || !ok {
+		return handler(ctx, req)
+	}
+	if len(idempotencyKey) > maxIdempotencyKeyLength {
+		return nil, apierror.InvalidArgument("", "idempotency key must be at most %d characters", maxIdempotencyKeyLength)
+	}
+	fingerprint, err := getRequestFingerprint(req)
+	if err != nil {
+		return nil, apierror.Internal(err, "could not fingerprint request")
+	}
+	key := hashIdempotencyKey(userId, fullMethod, idempotencyKey)
+	reservedUntil := i.now().Add(idempotencyPendingTimeout)
+	stored, err := i.store.ReserveIdempotencyKey(ctx, key, fingerprint, reservedUntil)
+	if err != nil {
+		return nil, fmt.Errorf("could not check idempotency key: %w", err)
+	}
+	if stored != nil {
+		return replayResponse(ctx, fullMethod, stored)
+	}
+	resp, err := handler(ctx, req)
+	// the outcome is recorded even if the client went away, that is when it retries
+	storeCtx := context.WithoutCancel(ctx)
+	if err != nil {
+		if releaseErr := i.store.ReleaseIdempotencyKey(storeCtx, key, fingerprint, reservedUntil); releaseErr != nil {
+			logger.WarnContext(ctx, "could not release idempotency key", "error", releaseErr)
+		}
+		return nil, err
+	}
+	response, err := marshalResponse(resp)
+	if err == nil {
+		err = i.store.CompleteIdempotencyKey(storeCtx, key, fingerprint, reservedUntil, response, i.now().Add(i.window))
+	}
+	if err != nil {
+		// the request succeeded, a retry will execute it again once the pending key expires
+		logger.WarnContext(ctx, "could not store response for idempotency key", "error", err)
+	}
+	return resp, nil
+}
+
+func getIdempotencyKey(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	values := md.Get(idempotencyKeyHeader)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+func hashIdempotencyKey(userId string, fullMethod string, idempotencyKey string) string {
+	sum := sha256.Sum256([]byte(userId + "|" + fullMethod + "|" + idempotencyKey))
+	return hex.EncodeToString(sum[:])
+}
+
+// Hash of the request without its session token, a retry may be sent with a new session token
+func getRequestFingerprint(req interface{}) (string, error) {
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return "", fmt.Errorf("request %T is not a proto message", req)
+	}
+	msg = proto.Clone(msg)
+	if field := msg.ProtoReflect().Descriptor().Fields().ByName("session_token"); field != nil {
+		msg.ProtoReflect().Clear(field)
+	}
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// Responses are stored as Any so they can be decoded without knowing the rpc
+func marshalResponse(resp interface{}) ([]byte, error) {
+	msg, ok := resp.(proto.Message)
+	if !ok {
+		return nil, fmt.Errorf("response %T is not a proto message", resp)
+	}
+	response, err := anypb.New(msg)
+	if err != nil {
+		return nil, err
+	}
+	return proto.Marshal(response)
+}
+
+func replayResponse(ctx context.Context, fullMethod string, stored []byte) (interface{}, error) {
+	var response anypb.Any
+	if err := proto.Unmarshal(stored, &response); err != nil {
+		return nil, apierror.Internal(err, "could not decode stored response")
+	}
+	resp, err := response.UnmarshalNew()
+	if err != nil {
+		return nil, apierror.Internal(err, "could not decode stored response")
+	}
+	if err := grpc.SetHeader(ctx, metadata.Pairs(idempotentReplayedHeader, "true")); err != nil {
+		logger.WarnContext(ctx, "could not set idempotent-replayed header", "error", err)
+	}
+	metrics.IncIdempotentReplay(fullMethod)
+	logger.InfoContext(ctx, "replayed stored response for idempotency key")
+	return resp, nil
+}
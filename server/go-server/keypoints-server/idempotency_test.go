@@ -0,0 +1,162 @@
+package keypointsserver
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/sirfrank96/go-server/apierror"
+	"github.com/sirfrank96/go-server/config"
+	skp "github.com/sirfrank96/go-server/sports-keypoints-proto"
+	"github.com/sirfrank96/go-server/util"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+)
+
+type fakeIdempotencyKey struct {
+	fingerprint   string
+	reservedUntil time.Time
+	response      []byte
+}
+
+// In memory IdempotencyStore, keys do not expire
+type fakeIdempotencyStore struct {
+	keys map[string]*fakeIdempotencyKey
+}
+
+func (f *fakeIdempotencyStore) ReserveIdempotencyKey(ctx context.Context, key string, fingerprint string, expiresAt time.Time) ([]byte, error) {
+	existing, ok := f.keys[key]
+	if !ok {
+		f.keys[key] = &fakeIdempotencyKey{fingerprint: fingerprint, reservedUntil: expiresAt}
+		return nil, nil
+	}
+	if existing.fingerprint != fingerprint {
+		return nil, apierror.FailedPrecondition("IDEMPOTENCY_KEY_REUSED", "idempotency-key", "idempotency key was already used for a different request")
+	}
+	if existing.response == nil {
+		return nil, apierror.Aborted("a request with this idempotency key is in progress, retry later")
+	}
+	return existing.response, nil
+}
+
+func (f *fakeIdempotencyStore) CompleteIdempotencyKey(ctx context.Context, key string, fingerprint string, reservedUntil time.Time, response []byte, expiresAt time.Time) error {
+	if !f.reserved(key, fingerprint, reservedUntil) {
+		return apierror.NotFound("idempotency key is not reserved by this request")
+	}
+	f.keys[key].response = response
+	return nil
+}
+
+func (f *fakeIdempotencyStore) ReleaseIdempotencyKey(ctx context.Context, key string, fingerprint string, reservedUntil time.Time) error {
+	if f.reserved(key, fingerprint, reservedUntil) {
+		delete(f.keys, key)
+	}
+	return nil
+}
+
+func (f *fakeIdempotencyStore) reserved(key string, fingerprint string, reservedUntil time.Time) bool {
+	existing, ok := f.keys[key]
+	return ok && existing.response == nil && existing.fingerprint == fingerprint && existing.reservedUntil.Equal(reservedUntil)
+}
+
+func TestIdempotency(t *testing.T) {
+	const fullMethod = "/sports_keypoints_proto.GolfKeypointsService/UploadInputImage"
+	i := newIdempotency(&fakeIdempotencyStore{keys: map[string]*fakeIdempotencyKey{}}, config.IdempotencyConfig{Window: time.Hour, Methods: "UploadInputImage"})
+	calls := 0
+	var handlerErr error
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		calls++
+		if handlerErr != nil {
+			return nil, handlerErr
+		}
+		return &skp.UploadInputImageResponse{Success: true, InputImageId: "image1"}, nil
+	}
+	newCtx := func(userId string, idempotencyKey string) context.Context {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(idempotencyKeyHeader, idempotencyKey))
+		return context.WithValue(ctx, util.UserIdKey, userId)
+	}
+	request := &skp.UploadInputImageRequest{SessionToken: "token1", Description: "driver", Image: []byte("image")}
+	// first request executes, the retry gets the stored response even with a new session token
+	if _, err := i.handle(newCtx("user1", "key1"), request, fullMethod, handler); err != nil {
+		t.Fatalf("handle had an unexpected error: %s", err.Error())
+	}
+	retry := &skp.UploadInputImageRequest{SessionToken: "token2", Description: "driver", Image: []byte("image")}
+	resp, err := i.handle(newCtx("user1", "key1"), retry, fullMethod, handler)
+	if err != nil {
+		t.Fatalf("handle had an unexpected error for the retry: %s", err.Error())
+	}
+	if calls != 1 {
+		t.Errorf("handler was called %d times, expected the retry to be replayed", calls)
+	}
+	if !proto.Equal(resp.(proto.Message), &skp.UploadInputImageResponse{Success: true, InputImageId: "image1"}) {
+		t.Errorf("replayed response is %v, expected the original response", resp)
+	}
+	// same key for a different request
+	other := &skp.UploadInputImageRequest{Description: "iron", Image: []byte("image")}
+	if _, err := i.handle(newCtx("user1", "key1"), other, fullMethod, handler); apierror.Code(err) != codes.FailedPrecondition {
+		t.Errorf("handle returned %v for a reused key, expected %s", err, codes.FailedPrecondition)
+	}
+	// keys are per user
+	if _, err := i.handle(newCtx("user2", "key1"), request, fullMethod, handler); err != nil || calls != 2 {
+		t.Errorf("handle returned %v after %d calls, expected another user's key to execute the request", err, calls)
+	}
+	// failed requests are not stored so they can be retried
+	handlerErr = errors.New("computervision is down")
+	if _, err := i.handle(newCtx("user1", "key2"), request, fullMethod, handler); err == nil {
+		t.Errorf("handle is supposed to return the handler's error")
+	}
+	handlerErr = nil
+	if _, err := i.handle(newCtx("user1", "key2"), request, fullMethod, handler); err != nil || calls != 4 {
+		t.Errorf("handle returned %v after %d calls, expected the retry of a failed request to execute", err, calls)
+	}
+	// requests without a key always execute
+	for j := 0; j < 2; j++ {
+		if _, err := i.handle(newCtx("user1", ""), request, fullMethod, handler); err != nil {
+			t.Errorf("handle had an unexpected error without a key: %s", err.Error())
+		}
+	}
+	if calls != 6 {
+		t.Errorf("handler was called %d times, expected requests without a key to execute", calls)
+	}
+}
+
+// A request that ran past the pending timeout lost its key to a retry, it must not complete or release the retry's key
+func TestIdempotencyTakenOverKey(t *testing.T) {
+	const fullMethod = "/sports_keypoints_proto.GolfKeypointsService/UploadInputImage"
+	store := &fakeIdempotencyStore{keys: map[string]*fakeIdempotencyKey{}}
+	i := newIdempotency(store, config.IdempotencyConfig{Window: time.Hour, Methods: "UploadInputImage"})
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(idempotencyKeyHeader, "key1"))
+	ctx = context.WithValue(ctx, util.UserIdKey, "user1")
+	request := &skp.UploadInputImageRequest{Description: "driver", Image: []byte("image")}
+	takeOver := func() {
+		for _, key := range store.keys {
+			key.reservedUntil = key.reservedUntil.Add(idempotencyPendingTimeout)
+		}
+	}
+	succeed := func(ctx context.Context, req interface{}) (interface{}, error) {
+		takeOver()
+		return &skp.UploadInputImageResponse{Success: true, InputImageId: "image1"}, nil
+	}
+	if _, err := i.handle(ctx, request, fullMethod, succeed); err != nil {
+		t.Fatalf("handle had an unexpected error: %s", err.Error())
+	}
+	for _, key := range store.keys {
+		if key.response != nil {
+			t.Errorf("the request completed the key of the retry that took it over")
+		}
+	}
+	store.keys = map[string]*fakeIdempotencyKey{}
+	fail := func(ctx context.Context, req interface{}) (interface{}, error) {
+		takeOver()
+		return nil, errors.New("computervision is down")
+	}
+	if _, err := i.handle(ctx, request, fullMethod, fail); err == nil {
+		t.Errorf("handle is supposed to return the handler's error")
+	}
+	if len(store.keys) != 1 {
+		t.Errorf("the failed request released the key of the retry that took it over")
+	}
+}
@@ -0,0 +1,167 @@
+package keypointsserver
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"strings"
+
+	"github.com/sirfrank96/go-server/apierror"
+	skp "github.com/sirfrank96/go-server/sports-keypoints-proto"
+)
+
+// Image assembled from the chunks of a streaming upload
+type imageUpload struct {
+	// proto field name of the image, used in errors
+	field   string
+	maxSize int64
+	data    []byte
+}
+
+func newImageUpload(field string, maxSize int64) *imageUpload {
+	return &imageUpload{field: field, maxSize: maxSize}
+}
+
+func (u *imageUpload) write(chunk []byte) error {
+	if int64(len(u.data)+len(chunk)) > u.maxSize {
+		return apierror.InvalidArgument(u.field, "%s is larger than %d bytes", u.field, u.maxSize)
+	}
+	u.data = append(u.data, chunk...)
+	return nil
+}
+
+// Compares the hex encoded sha256 sent at the end of the stream with the received image, an image that was not sent
+// has no checksum
+func (u *imageUpload) verify(checksum string) error {
+	if len(u.data) == 0 && checksum == "" {
+		return nil
+	}
+	if checksum == "" {
+		return apierror.InvalidArgument(u.field+"_sha256", "please add the sha256 checksum of %s", u.field)
+	}
+	sum := sha256.Sum256(u.data)
+	if !strings.EqualFold(hex.EncodeToString(sum[:]), checksum) {
+		return apierror.DataLoss("%s does not match its sha256 checksum, please upload it again", u.field)
+	}
+	return nil
+}
+
+// Assembles the image from the stream and continues like UploadInputImage
+func (g *golfKeypointsServer) UploadInputImageStream(stream skp.GolfKeypointsService_UploadInputImageStreamServer) error {
+	first, err := stream.Recv()
+	if err == io.EOF {
+		return apierror.InvalidArgument("metadata", "request is empty")
+	}
+	if err != nil {
+		return err
+	}
+	request := first.GetMetadata()
+	if request == nil {
+		return apierror.InvalidArgument("metadata", "the first message must have the metadata of the image")
+	}
+	image := newImageUpload("image", g.maxImageSize)
+	for {
+		msg, err := stream.Recv()
+		if err == io.EOF {
+			return apierror.InvalidArgument("sha256", "the stream ended before the sha256 checksum of the image")
+		}
+		if err != nil {
+			return err
+		}
+		switch data := msg.Data.(type) {
+		case *skp.UploadInputImageStreamRequest_Chunk:
+			if err := image.write(data.Chunk); err != nil {
+				return err
+			}
+			continue
+		case *skp.UploadInputImageStreamRequest_Sha256:
+			if err := image.verify(data.Sha256); err != nil {
+				return err
+			}
+		default:
+			return apierror.InvalidArgument("metadata", "only the first message can have the metadata")
+		}
+		break
+	}
+	request.Image = image.data
+	if err := validateRequest(request); err != nil {
+		return err
+	}
+	if err := verifyUploadInputImageRequest(request); err != nil {
+		return err
+	}
+	response, err := g.idempotency.handleMethod(stream.Context(), request, "/sports_keypoints_proto.GolfKeypointsService/UploadInputImageStream", func(ctx context.Context, req interface{}) (interface{}, error) {
+		return g.handler.UploadInputImage(ctx, req.(*skp.UploadInputImageRequest))
+	})
+	if err != nil {
+		return err
+	}
+	return stream.SendAndClose(response.(*skp.UploadInputImageResponse))
+}
+
+// Assembles the calibration images from the stream and continues like CalibrateInputImage
+func (g *golfKeypointsServer) CalibrateInputImageStream(stream skp.GolfKeypointsService_CalibrateInputImageStreamServer) error {
+	first, err := stream.Recv()
+	if err == io.EOF {
+		return apierror.InvalidArgument("metadata", "request is empty")
+	}
+	if err != nil {
+		return err
+	}
+	request := first.GetMetadata()
+	if request == nil {
+		return apierror.InvalidArgument("metadata", "the first message must have the calibration request")
+	}
+	axes := newImageUpload("calibration_image_axes", g.maxImageSize)
+	vanishingPoint := newImageUpload("calibration_image_vanishing_point", g.maxImageSize)
+	for {
+		msg, err := stream.Recv()
+		if err == io.EOF {
+			return apierror.InvalidArgument("checksums", "the stream ended before the sha256 checksums of the calibration images")
+		}
+		if err != nil {
+			return err
+		}
+		switch data := msg.Data.(type) {
+		case *skp.CalibrateInputImageStreamRequest_Chunk:
+			switch data.Chunk.CalibrationImage {
+			case skp.CalibrationImage_CALIBRATION_IMAGE_AXES:
+				err = axes.write(data.Chunk.Data)
+			case skp.CalibrationImage_CALIBRATION_IMAGE_VANISHING_POINT:
+				err = vanishingPoint.write(data.Chunk.Data)
+			default:
+				err = apierror.InvalidArgument("chunk.calibration_image", "please enter which calibration image the chunk belongs to")
+			}
+			if err != nil {
+				return err
+			}
+			continue
+		case *skp.CalibrateInputImageStreamRequest_Checksums:
+			if err := axes.verify(data.Checksums.GetCalibrationImageAxesSha256()); err != nil {
+				return err
+			}
+			if err := vanishingPoint.verify(data.Checksums.GetCalibrationImageVanishingPointSha256()); err != nil {
+				return err
+			}
+		default:
+			return apierror.InvalidArgument("metadata", "only the first message can have the calibration request")
+		}
+		break
+	}
+	request.CalibrationImageAxes = axes.data
+	request.CalibrationImageVanishingPoint = vanishingPoint.data
+	if err := validateRequest(request); err != nil {
+		return err
+	}
+	if err := verifyCalibrateInputImageRequest(request); err != nil {
+		return err
+	}
+	response, err := g.idempotency.handleMethod(stream.Context(), request, "/sports_keypoints_proto.GolfKeypointsService/CalibrateInputImageStream", func(ctx context.Context, req interface{}) (interface{}, error) {
+		return g.handler.CalibrateInputImage(ctx, req.(*skp.CalibrateInputImageRequest))
+	})
+	if err != nil {
+		return err
+	}
+	return stream.SendAndClose(response.(*skp.CalibrateInputImageResponse))
+}
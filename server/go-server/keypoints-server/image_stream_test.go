@@ -0,0 +1,165 @@
+package keypointsserver
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/sirfrank96/go-server/apierror"
+	"github.com/sirfrank96/go-server/config"
+	skp "github.com/sirfrank96/go-server/sports-keypoints-proto"
+	"github.com/sirfrank96/go-server/util"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Client side of an UploadInputImageStream, Recv returns the messages in order and then io.EOF
+type fakeUploadStream struct {
+	grpc.ServerStream
+	ctx      context.Context
+	messages []*skp.UploadInputImageStreamRequest
+	response *skp.UploadInputImageResponse
+}
+
+func (f *fakeUploadStream) Context() context.Context {
+	if f.ctx != nil {
+		return f.ctx
+	}
+	return context.Background()
+}
+
+func (f *fakeUploadStream) Recv() (*skp.UploadInputImageStreamRequest, error) {
+	if len(f.messages) == 0 {
+		return nil, io.EOF
+	}
+	msg := f.messages[0]
+	f.messages = f.messages[1:]
+	return msg, nil
+}
+
+func (f *fakeUploadStream) SendAndClose(response *skp.UploadInputImageResponse) error {
+	f.response = response
+	return nil
+}
+
+type fakeUploadHandler struct {
+	skp.UnimplementedGolfKeypointsServiceServer
+	request *skp.UploadInputImageRequest
+	calls   int
+}
+
+func (f *fakeUploadHandler) UploadInputImage(ctx context.Context, request *skp.UploadInputImageRequest) (*skp.UploadInputImageResponse, error) {
+	f.calls++
+	f.request = request
+	return &skp.UploadInputImageResponse{Success: true, InputImageId: "image1"}, nil
+}
+
+func uploadStreamMessages(image []byte, chunkSize int, checksum string) []*skp.UploadInputImageStreamRequest {
+	messages := []*skp.UploadInputImageStreamRequest{{Data: &skp.UploadInputImageStreamRequest_Metadata{Metadata: &skp.UploadInputImageRequest{
+		ImageType:   skp.ImageType_DTL,
+		Description: "driver",
+		Timestamp:   timestamppb.Now(),
+	}}}}
+	for start := 0; start < len(image); start += chunkSize {
+		end := min(start+chunkSize, len(image))
+		messages = append(messages, &skp.UploadInputImageStreamRequest{Data: &skp.UploadInputImageStreamRequest_Chunk{Chunk: image[start:end]}})
+	}
+	if checksum != "" {
+		messages = append(messages, &skp.UploadInputImageStreamRequest{Data: &skp.UploadInputImageStreamRequest_Sha256{Sha256: checksum}})
+	}
+	return messages
+}
+
+func TestUploadInputImageStream(t *testing.T) {
+	image := bytes.Repeat([]byte("0123456789"), 1000)
+	sum := sha256.Sum256(image)
+	checksum := hex.EncodeToString(sum[:])
+	emptySum := sha256.Sum256(nil)
+	handler := &fakeUploadHandler{}
+	g := createNewGolfKeypointsServer(handler, 20000)
+	stream := &fakeUploadStream{messages: uploadStreamMessages(image, 4096, checksum)}
+	if err := g.UploadInputImageStream(stream); err != nil {
+		t.Fatalf("UploadInputImageStream had an unexpected error: %s", err.Error())
+	}
+	if !bytes.Equal(handler.request.Image, image) || handler.request.Description != "driver" {
+		t.Errorf("handler got %d bytes and description %q, expected the whole image and its metadata", len(handler.request.Image), handler.request.Description)
+	}
+	if stream.response.GetInputImageId() != "image1" {
+		t.Errorf("response is %v, expected the handler's response", stream.response)
+	}
+	tests := []struct {
+		name     string
+		messages []*skp.UploadInputImageStreamRequest
+		maxSize  int64
+		code     codes.Code
+	}{
+		{"checksum mismatch", uploadStreamMessages(image, 4096, hex.EncodeToString(make([]byte, 32))), 20000, codes.DataLoss},
+		{"no checksum", uploadStreamMessages(image, 4096, ""), 20000, codes.InvalidArgument},
+		{"too large", uploadStreamMessages(image, 4096, checksum), 8000, codes.InvalidArgument},
+		{"no metadata", uploadStreamMessages(image, 4096, checksum)[1:], 20000, codes.InvalidArgument},
+		{"empty image", uploadStreamMessages(nil, 4096, hex.EncodeToString(emptySum[:])), 20000, codes.InvalidArgument},
+	}
+	for _, test := range tests {
+		handler := &fakeUploadHandler{}
+		g := createNewGolfKeypointsServer(handler, test.maxSize)
+		err := g.UploadInputImageStream(&fakeUploadStream{messages: test.messages})
+		if code := apierror.Code(err); code != test.code {
+			t.Errorf("%s: UploadInputImageStream returned %v, expected %s", test.name, err, test.code)
+		}
+		if handler.request != nil {
+			t.Errorf("%s: handler was called for an invalid upload", test.name)
+		}
+	}
+}
+
+func TestUploadInputImageMaxSize(t *testing.T) {
+	image := bytes.Repeat([]byte("0123456789"), 1000)
+	request := &skp.UploadInputImageRequest{ImageType: skp.ImageType_DTL, Image: image, Description: "driver", Timestamp: timestamppb.Now()}
+	handler := &fakeUploadHandler{}
+	g := createNewGolfKeypointsServer(handler, 8000)
+	_, err := g.UploadInputImage(context.Background(), request)
+	if code := apierror.Code(err); code != codes.InvalidArgument {
+		t.Errorf("UploadInputImage of %d bytes returned %v, expected %s", len(image), err, codes.InvalidArgument)
+	}
+	if handler.calls != 0 {
+		t.Errorf("handler was called for an image larger than the max image size")
+	}
+	g = createNewGolfKeypointsServer(handler, 20000)
+	if _, err := g.UploadInputImage(context.Background(), request); err != nil {
+		t.Errorf("UploadInputImage had an unexpected error: %s", err.Error())
+	}
+}
+
+func TestUploadInputImageStreamIdempotency(t *testing.T) {
+	image := bytes.Repeat([]byte("0123456789"), 1000)
+	sum := sha256.Sum256(image)
+	checksum := hex.EncodeToString(sum[:])
+	handler := &fakeUploadHandler{}
+	g := createNewGolfKeypointsServer(handler, 20000)
+	g.idempotency = newIdempotency(&fakeIdempotencyStore{keys: map[string]*fakeIdempotencyKey{}}, config.IdempotencyConfig{Window: time.Hour, Methods: "UploadInputImageStream"})
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(idempotencyKeyHeader, "key1"))
+	ctx = context.WithValue(ctx, util.UserIdKey, "user1")
+	// the retry is sent in different chunks, the assembled request is the same
+	timestamp := timestamppb.Now()
+	for _, chunkSize := range []int{4096, 1000} {
+		messages := uploadStreamMessages(image, chunkSize, checksum)
+		messages[0].GetMetadata().Timestamp = timestamp
+		stream := &fakeUploadStream{ctx: ctx, messages: messages}
+		if err := g.UploadInputImageStream(stream); err != nil {
+			t.Fatalf("UploadInputImageStream had an unexpected error: %s", err.Error())
+		}
+		if stream.response.GetInputImageId() != "image1" {
+			t.Errorf("response is %v, expected the stored response", stream.response)
+		}
+	}
+	if handler.calls != 1 {
+		t.Errorf("handler was called %d times, expected the retried stream to be replayed", handler.calls)
+	}
+}
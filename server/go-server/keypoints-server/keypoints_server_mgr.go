@@ -4,54 +4,198 @@
 package keypointsserver
 
 import (
-	"flag"
+	"context"
+	"crypto/tls"
 	"fmt"
-	"log"
 	"net"
+	"net/http"
+	"strings"
 
+	"github.com/sirfrank96/go-server/config"
+	"github.com/sirfrank96/go-server/logging"
 	skp "github.com/sirfrank96/go-server/sports-keypoints-proto"
+	"github.com/sirfrank96/go-server/util"
 
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
-	//"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	_ "google.golang.org/grpc/encoding/gzip"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
 )
 
-var (
-	//tls = flag.Bool("tls", false, "Connection uses TLS if true, else plain TCP")
-	//certFile = flag.String("cert_file", "", "The TLS cert file")
-	//keyFile = flag.String("key_file", "", "The TLS key file")
-	port = flag.Int("port", 50052, "The server port")
-)
+var logger = logging.Logger("keypoints-server")
 
 type KeypointsServerManager struct {
+	config              config.KeypointsServerConfig
 	grpcServer          *grpc.Server
+	grpcWebServer       *http.Server
+	gateway             *gateway
 	userServer          *userServer
 	golfKeypointsServer *golfKeypointsServer
+	organizationServer  *organizationServer
+	adminServer         *adminServer
+	healthChecker       *healthChecker
+	rateLimiter         *rateLimiter
+	idempotency         *idempotency
 }
 
-func NewKeypointsServerManager(golfKeypointsHandler skp.GolfKeypointsServiceServer, userHandler skp.UserServiceServer) *KeypointsServerManager {
-	k := &KeypointsServerManager{}
+func NewKeypointsServerManager(serverConfig config.KeypointsServerConfig, golfKeypointsHandler skp.GolfKeypointsServiceServer, userHandler skp.UserServiceServer, organizationHandler skp.OrganizationServiceServer, adminHandler skp.AdminServiceServer) *KeypointsServerManager {
+	k := &KeypointsServerManager{config: serverConfig}
 	k.userServer = createNewUserServer(userHandler)
-	k.golfKeypointsServer = createNewGolfKeypointsServer(golfKeypointsHandler)
-	log.Printf("New keypoints_server_mgr")
+	k.golfKeypointsServer = createNewGolfKeypointsServer(golfKeypointsHandler, serverConfig.MaxImageSize)
+	k.organizationServer = createNewOrganizationServer(organizationHandler)
+	k.adminServer = createNewAdminServer(adminHandler)
+	k.healthChecker = newHealthChecker([]string{
+		skp.GolfKeypointsService_ServiceDesc.ServiceName,
+		skp.UserService_ServiceDesc.ServiceName,
+		skp.OrganizationService_ServiceDesc.ServiceName,
+		skp.AdminService_ServiceDesc.ServiceName,
+	})
+	logger.Info("new keypoints server mgr")
 	return k
 }
 
+// Dependencies must be added before starting the keypoints server, the server is not ready until all of them pass
+func (k *KeypointsServerManager) AddHealthProbe(name string, probe HealthProbe) {
+	k.healthChecker.addProbe(name, probe)
+}
+
+// Reported by the health service like a probe, but failing does not make the keypoints server unready
+func (k *KeypointsServerManager) AddHealthMonitor(name string, probe HealthProbe) {
+	k.healthChecker.addMonitor(name, probe)
+}
+
 func (k *KeypointsServerManager) StartKeypointsServer() error {
-	log.Printf("Starting keypoints and user servers")
-	flag.Parse()
-	lis, err := net.Listen("tcp", fmt.Sprintf("0.0.0.0:%d", *port))
+	logger.Info("starting keypoints server", "port", k.config.Port)
+	// load certificates before listening so a bad tls config fails fast
+	tlsConfig, err := getServerTLSConfig(k.config)
+	if err != nil {
+		return fmt.Errorf("could not get server tls config: %w", err)
+	}
+	creds := insecure.NewCredentials()
+	if tlsConfig != nil {
+		creds = credentials.NewTLS(tlsConfig)
+	}
+	k.rateLimiter, err = getRateLimiter(k.config.RateLimit)
+	if err != nil {
+		return fmt.Errorf("could not get rate limiter: %w", err)
+	}
+	lis, err := net.Listen("tcp", fmt.Sprintf("0.0.0.0:%d", k.config.Port))
 	if err != nil {
 		return fmt.Errorf("failed to listen: %w", err)
 	}
-	//var opts []grpc.ServerOption
-	k.grpcServer = grpc.NewServer(grpc.UnaryInterceptor(sessionUnaryInterceptor))
-	skp.RegisterGolfKeypointsServiceServer(k.grpcServer, k.golfKeypointsServer)
-	skp.RegisterUserServiceServer(k.grpcServer, k.userServer)
+	k.grpcServer = k.newGrpcServer(creds)
+	if k.config.Reflection {
+		reflection.Register(k.grpcServer)
+	}
+	if k.config.Gateway.Port != 0 {
+		if err := k.startGateway(tlsConfig); err != nil {
+			return fmt.Errorf("could not start rest gateway: %w", err)
+		}
+	}
+	if k.config.GrpcWeb.Enabled && k.config.GrpcWeb.Port != 0 {
+		if err := k.startGrpcWebServer(tlsConfig); err != nil {
+			return fmt.Errorf("could not start grpc-web server: %w", err)
+		}
+	}
+	k.healthChecker.start(k.config.HealthProbeInterval)
+	// grpc-web on the grpc port needs an http server in front of the grpc server to tell the two apart
+	if k.config.GrpcWeb.Enabled && k.config.GrpcWeb.Port == 0 {
+		logger.Info("serving grpc-web on the grpc port", "port", k.config.Port)
+		return k.serveGrpcWeb(lis, tlsConfig, true)
+	}
 	k.grpcServer.Serve(lis)
 	return nil
 }
 
+// Every grpc server (the public one and the in-process one behind the rest gateway) has the same interceptors and services
+func (k *KeypointsServerManager) newGrpcServer(creds credentials.TransportCredentials) *grpc.Server {
+	grpcServer := grpc.NewServer(
+		grpc.Creds(creds),
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
+		grpc.MaxRecvMsgSize(k.config.Messages.MaxRecvSize),
+		grpc.MaxSendMsgSize(k.config.Messages.MaxSendSize),
+		grpc.ChainUnaryInterceptor(requestIdUnaryInterceptor, metricsUnaryInterceptor, errorStatusUnaryInterceptor, recoveryUnaryInterceptor, k.readinessUnaryInterceptor, sessionUnaryInterceptor, k.rateLimitUnaryInterceptor, validationUnaryInterceptor, k.idempotencyUnaryInterceptor, k.compressionUnaryInterceptor),
+		grpc.ChainStreamInterceptor(requestIdStreamInterceptor, metricsStreamInterceptor, errorStatusStreamInterceptor, recoveryStreamInterceptor, k.readinessStreamInterceptor, sessionStreamInterceptor, k.rateLimitStreamInterceptor, validationStreamInterceptor, k.compressionStreamInterceptor),
+	)
+	skp.RegisterGolfKeypointsServiceServer(grpcServer, k.golfKeypointsServer)
+	skp.RegisterUserServiceServer(grpcServer, k.userServer)
+	skp.RegisterOrganizationServiceServer(grpcServer, k.organizationServer)
+	skp.RegisterAdminServiceServer(grpcServer, k.adminServer)
+	healthpb.RegisterHealthServer(grpcServer, k.healthChecker.server)
+	return grpcServer
+}
+
 func (k *KeypointsServerManager) StopKeypointsServer() error {
+	if k.grpcServer == nil {
+		return nil
+	}
+	k.healthChecker.stop()
+	if err := k.stopGateway(); err != nil {
+		return err
+	}
+	if err := k.stopGrpcWebServer(); err != nil {
+		return err
+	}
 	k.grpcServer.GracefulStop()
 	return nil
 }
+
+// Rejects requests with Unavailable until mongodb and computervision are ready, health checks are always answered
+func (k *KeypointsServerManager) readinessUnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := k.checkReady(info.FullMethod); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+func (k *KeypointsServerManager) readinessStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := k.checkReady(info.FullMethod); err != nil {
+		return err
+	}
+	return handler(srv, ss)
+}
+
+func (k *KeypointsServerManager) checkReady(fullMethod string) error {
+	if !strings.HasPrefix(fullMethod, "/grpc.health.v1.Health/") && !k.healthChecker.isReady() {
+		return status.Errorf(codes.Unavailable, "keypoints server is not ready, try again later")
+	}
+	return nil
+}
+
+// TLS is required unless keypoints_server.insecure is set explicitly, returns nil if it is set
+func getServerTLSConfig(serverConfig config.KeypointsServerConfig) (*tls.Config, error) {
+	if serverConfig.Insecure {
+		logger.Warn("serving without tls because keypoints_server.insecure is set")
+		return nil, nil
+	}
+	if serverConfig.CertFile == "" || serverConfig.KeyFile == "" {
+		return nil, fmt.Errorf("keypoints_server.cert_file and keypoints_server.key_file are required unless keypoints_server.insecure is set")
+	}
+	reloader, err := util.NewCertReloader(serverConfig.CertFile, serverConfig.KeyFile)
+	if err != nil {
+		return nil, err
+	}
+	tlsConfig := &tls.Config{
+		MinVersion:     tls.VersionTLS12,
+		GetCertificate: reloader.GetCertificate,
+	}
+	if serverConfig.ClientCaFile != "" {
+		pool, err := util.LoadCertPool(serverConfig.ClientCaFile)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.ClientCAs = pool
+		tlsConfig.ClientAuth = tls.VerifyClientCertIfGiven
+		if serverConfig.RequireClientCert {
+			tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+		}
+	} else if serverConfig.RequireClientCert {
+		return nil, fmt.Errorf("keypoints_server.require_client_cert needs keypoints_server.client_ca_file")
+	}
+	return tlsConfig, nil
+}
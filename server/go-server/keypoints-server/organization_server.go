@@ -0,0 +1,81 @@
+package keypointsserver
+
+import (
+	"context"
+
+	skp "github.com/sirfrank96/go-server/sports-keypoints-proto"
+)
+
+type organizationServer struct {
+	skp.UnimplementedOrganizationServiceServer
+	handler skp.OrganizationServiceServer
+}
+
+func createNewOrganizationServer(handler skp.OrganizationServiceServer) *organizationServer {
+	o := &organizationServer{}
+	o.handler = handler
+	return o
+}
+
+func (o *organizationServer) CreateOrganization(ctx context.Context, request *skp.CreateOrganizationRequest) (*skp.CreateOrganizationResponse, error) {
+	if err := verifyCreateOrganizationRequest(request); err != nil {
+		return nil, err
+	}
+	return o.handler.CreateOrganization(ctx, request)
+}
+
+func (o *organizationServer) ReadOrganization(ctx context.Context, request *skp.ReadOrganizationRequest) (*skp.ReadOrganizationResponse, error) {
+	if err := verifyReadOrganizationRequest(request); err != nil {
+		return nil, err
+	}
+	return o.handler.ReadOrganization(ctx, request)
+}
+
+func (o *organizationServer) ListOrganizationInvitations(ctx context.Context, request *skp.ListOrganizationInvitationsRequest) (*skp.ListOrganizationInvitationsResponse, error) {
+	if err := verifyListOrganizationInvitationsRequest(request); err != nil {
+		return nil, err
+	}
+	return o.handler.ListOrganizationInvitations(ctx, request)
+}
+
+func (o *organizationServer) AcceptOrganizationInvitation(ctx context.Context, request *skp.AcceptOrganizationInvitationRequest) (*skp.AcceptOrganizationInvitationResponse, error) {
+	if err := verifyAcceptOrganizationInvitationRequest(request); err != nil {
+		return nil, err
+	}
+	return o.handler.AcceptOrganizationInvitation(ctx, request)
+}
+
+func (o *organizationServer) DeclineOrganizationInvitation(ctx context.Context, request *skp.DeclineOrganizationInvitationRequest) (*skp.DeclineOrganizationInvitationResponse, error) {
+	if err := verifyDeclineOrganizationInvitationRequest(request); err != nil {
+		return nil, err
+	}
+	return o.handler.DeclineOrganizationInvitation(ctx, request)
+}
+
+func (o *organizationServer) ListOrganizationMembers(ctx context.Context, request *skp.ListOrganizationMembersRequest) (*skp.ListOrganizationMembersResponse, error) {
+	if err := verifyListOrganizationMembersRequest(request); err != nil {
+		return nil, err
+	}
+	return o.handler.ListOrganizationMembers(ctx, request)
+}
+
+func (o *organizationServer) InviteOrganizationMember(ctx context.Context, request *skp.InviteOrganizationMemberRequest) (*skp.InviteOrganizationMemberResponse, error) {
+	if err := verifyInviteOrganizationMemberRequest(request); err != nil {
+		return nil, err
+	}
+	return o.handler.InviteOrganizationMember(ctx, request)
+}
+
+func (o *organizationServer) UpdateOrganizationMemberRole(ctx context.Context, request *skp.UpdateOrganizationMemberRoleRequest) (*skp.UpdateOrganizationMemberRoleResponse, error) {
+	if err := verifyUpdateOrganizationMemberRoleRequest(request); err != nil {
+		return nil, err
+	}
+	return o.handler.UpdateOrganizationMemberRole(ctx, request)
+}
+
+func (o *organizationServer) RemoveOrganizationMember(ctx context.Context, request *skp.RemoveOrganizationMemberRequest) (*skp.RemoveOrganizationMemberResponse, error) {
+	if err := verifyRemoveOrganizationMemberRequest(request); err != nil {
+		return nil, err
+	}
+	return o.handler.RemoveOrganizationMember(ctx, request)
+}
@@ -0,0 +1,209 @@
+package keypointsserver
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirfrank96/go-server/apierror"
+	"github.com/sirfrank96/go-server/config"
+	"github.com/sirfrank96/go-server/metrics"
+	"github.com/sirfrank96/go-server/util"
+
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+const (
+	rateLimitedReason = "RATE_LIMITED"
+	retryAfterHeader  = "retry-after"
+	// limiters of callers that have been idle this long are dropped, a new limiter starts with a full bucket anyway
+	rateLimiterIdleTimeout = 10 * time.Minute
+)
+
+type methodRateLimit struct {
+	rate  rate.Limit
+	burst int
+}
+
+type callerRateLimiter struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// Token bucket per method and caller. Callers are identified by their user id once the session interceptor has
+// authenticated them, and by their address for anonymous rpcs like CreateUser and RegisterUser.
+type rateLimiter struct {
+	defaultLimit methodRateLimit
+	// keyed by rpc name (eg. CalculateGolfKeypoints)
+	methodLimits map[string]methodRateLimit
+	mutex        sync.Mutex
+	limiters     map[string]*callerRateLimiter
+	lastSweep    time.Time
+	now          func() time.Time
+}
+
+func newRateLimiter(defaultLimit methodRateLimit, methodLimits map[string]methodRateLimit) *rateLimiter {
+	return &rateLimiter{
+		defaultLimit: defaultLimit,
+		methodLimits: methodLimits,
+		limiters:     map[string]*callerRateLimiter{},
+		now:          time.Now,
+	}
+}
+
+// Builds the rate limiter from the rate_limit config, nil if rate limiting is disabled
+func getRateLimiter(rateLimitConfig config.RateLimitConfig) (*rateLimiter, error) {
+	if rateLimitConfig.Rate == 0 {
+		return nil, nil
+	}
+	if rateLimitConfig.Rate < 0 || rateLimitConfig.Burst <= 0 {
+		return nil, fmt.Errorf("invalid default rate limit %v:%d, expected a positive rate and burst", rateLimitConfig.Rate, rateLimitConfig.Burst)
+	}
+	methodLimits, err := parseMethodRateLimits(rateLimitConfig.Methods)
+	if err != nil {
+		return nil, err
+	}
+	return newRateLimiter(methodRateLimit{rate: rate.Limit(rateLimitConfig.Rate), burst: rateLimitConfig.Burst}, methodLimits), nil
+}
+
+func parseMethodRateLimits(limits string) (map[string]methodRateLimit, error) {
+	res := map[string]methodRateLimit{}
+	if limits == "" {
+		return res, nil
+	}
+	for _, methodLimit := range strings.Split(limits, ",") {
+		method, limit, ok := strings.Cut(strings.TrimSpace(methodLimit), "=")
+		if !ok || method == "" {
+			return nil, fmt.Errorf("invalid method rate limit %s, expected method=requests_per_second:burst", methodLimit)
+		}
+		ratePerSecond, burst, ok := strings.Cut(limit, ":")
+		if !ok {
+			return nil, fmt.Errorf("invalid method rate limit %s, expected method=requests_per_second:burst", methodLimit)
+		}
+		r, err := strconv.ParseFloat(ratePerSecond, 64)
+		if err != nil || r < 0 {
+			return nil, fmt.Errorf("invalid rate %s for method %s", ratePerSecond, method)
+		}
+		b, err := strconv.Atoi(burst)
+		if err != nil || (b <= 0 && r > 0) {
+			return nil, fmt.Errorf("invalid burst %s for method %s", burst, method)
+		}
+		res[method] = methodRateLimit{rate: rate.Limit(r), burst: b}
+	}
+	return res, nil
+}
+
+// Rejects requests over the caller's limit with ResourceExhausted, a google.rpc.RetryInfo and a retry-after header
+// (seconds). Runs after the session interceptor so authenticated callers are limited by user id.
+func (k *KeypointsServerManager) rateLimitUnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if k.rateLimiter == nil || strings.HasPrefix(info.FullMethod, "/grpc.health.v1.Health/") {
+		return handler(ctx, req)
+	}
+	if err := k.checkRateLimit(ctx, info.FullMethod); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// Streams are checked once their first message is received, the session token of streaming rpcs is in that message
+func (k *KeypointsServerManager) rateLimitStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if k.rateLimiter == nil || strings.HasPrefix(info.FullMethod, "/grpc.health.v1.Health/") {
+		return handler(srv, ss)
+	}
+	return handler(srv, &wrappedServerStream{ServerStream: ss, onFirstRecv: func(ctx context.Context, m interface{}) (context.Context, error) {
+		return ctx, k.checkRateLimit(ctx, info.FullMethod)
+	}})
+}
+
+func (k *KeypointsServerManager) checkRateLimit(ctx context.Context, fullMethod string) error {
+	retryAfter, ok := k.rateLimiter.allow(fullMethod, getRateLimitCaller(ctx))
+	if ok {
+		return nil
+	}
+	metrics.IncRateLimited(fullMethod)
+	seconds := int(math.Ceil(retryAfter.Seconds()))
+	if err := grpc.SetHeader(ctx, metadata.Pairs(retryAfterHeader, strconv.Itoa(seconds))); err != nil {
+		logger.WarnContext(ctx, "could not set retry-after header", "error", err)
+	}
+	return apierror.ResourceExhausted(retryAfter, "rate limit exceeded for %s, retry in %d seconds", rpcName(fullMethod), seconds).WithReason(rateLimitedReason)
+}
+
+// Returns false and how long to wait if the caller has no tokens left for the method
+func (r *rateLimiter) allow(fullMethod string, caller string) (time.Duration, bool) {
+	limit, ok := r.methodLimits[rpcName(fullMethod)]
+	if !ok {
+		limit = r.defaultLimit
+	}
+	if limit.rate == 0 {
+		return 0, true
+	}
+	now := r.now()
+	key := fmt.Sprintf("%s|%s", fullMethod, caller)
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.sweep(now)
+	l, ok := r.limiters[key]
+	if !ok {
+		l = &callerRateLimiter{limiter: rate.NewLimiter(limit.rate, limit.burst)}
+		r.limiters[key] = l
+	}
+	l.lastSeen = now
+	reservation := l.limiter.ReserveN(now, 1)
+	if delay := reservation.DelayFrom(now); delay > 0 {
+		reservation.CancelAt(now)
+		return delay, false
+	}
+	return 0, true
+}
+
+// Drops idle limiters at most once per idle timeout so the map does not grow with every client address
+func (r *rateLimiter) sweep(now time.Time) {
+	if now.Sub(r.lastSweep) < rateLimiterIdleTimeout {
+		return
+	}
+	r.lastSweep = now
+	for key, l := range r.limiters {
+		if now.Sub(l.lastSeen) >= rateLimiterIdleTimeout {
+			delete(r.limiters, key)
+		}
+	}
+}
+
+// The user id set by the session interceptor, otherwise the client address. Requests from the rest gateway come
+// from the in-process listener, so the address the gateway adds to x-forwarded-for is used instead.
+func getRateLimitCaller(ctx context.Context) string {
+	if userId, ok := ctx.Value(util.UserIdKey).(string); ok && userId != "" {
+		return fmt.Sprintf("user:%s", userId)
+	}
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return "peer:unknown"
+	}
+	if p.Addr.Network() == "bufconn" {
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if values := md.Get("x-forwarded-for"); len(values) > 0 {
+				// the gateway appends the address it saw, earlier entries are sent by the client and can not be trusted
+				forwardedFor := values[len(values)-1]
+				return fmt.Sprintf("peer:%s", strings.TrimSpace(forwardedFor[strings.LastIndex(forwardedFor, ",")+1:]))
+			}
+		}
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return fmt.Sprintf("peer:%s", p.Addr.String())
+	}
+	return fmt.Sprintf("peer:%s", host)
+}
+
+// CalculateGolfKeypoints for /sports_keypoints_proto.GolfKeypointsService/CalculateGolfKeypoints
+func rpcName(fullMethod string) string {
+	return fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+}
@@ -0,0 +1,156 @@
+package keypointsserver
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/sirfrank96/go-server/util"
+
+	"golang.org/x/time/rate"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+const calculateGolfKeypointsMethod = "/sports_keypoints_proto.GolfKeypointsService/CalculateGolfKeypoints"
+
+func TestParseMethodRateLimits(t *testing.T) {
+	limits, err := parseMethodRateLimits("CalculateGolfKeypoints=0.2:3, CreateUser=0:0")
+	if err != nil {
+		t.Fatalf("parseMethodRateLimits returned error %v", err)
+	}
+	if limits["CalculateGolfKeypoints"] != (methodRateLimit{rate: 0.2, burst: 3}) {
+		t.Errorf("CalculateGolfKeypoints limit is %v, expected 0.2:3", limits["CalculateGolfKeypoints"])
+	}
+	if limits["CreateUser"].rate != 0 {
+		t.Errorf("CreateUser rate is %v, expected 0", limits["CreateUser"].rate)
+	}
+	for _, invalid := range []string{"CalculateGolfKeypoints", "CalculateGolfKeypoints=1", "CalculateGolfKeypoints=a:1", "CalculateGolfKeypoints=1:0", "=1:1"} {
+		if _, err := parseMethodRateLimits(invalid); err == nil {
+			t.Errorf("parseMethodRateLimits(%q) did not return an error", invalid)
+		}
+	}
+}
+
+func TestRateLimiterAllow(t *testing.T) {
+	now := time.Unix(0, 0)
+	r := newRateLimiter(methodRateLimit{rate: 10, burst: 10}, map[string]methodRateLimit{
+		"CalculateGolfKeypoints": {rate: 0.5, burst: 2},
+		"ReadUser":               {rate: 0, burst: 0},
+	})
+	r.now = func() time.Time { return now }
+	for i := 0; i < 2; i++ {
+		if _, ok := r.allow(calculateGolfKeypointsMethod, "user:1"); !ok {
+			t.Fatalf("request %d was rate limited within the burst", i)
+		}
+	}
+	retryAfter, ok := r.allow(calculateGolfKeypointsMethod, "user:1")
+	if ok {
+		t.Fatalf("request over the burst was allowed")
+	}
+	if retryAfter != 2*time.Second {
+		t.Errorf("retry after is %v, expected 2s", retryAfter)
+	}
+	// other users and methods have their own buckets
+	if _, ok := r.allow(calculateGolfKeypointsMethod, "user:2"); !ok {
+		t.Errorf("another user was rate limited")
+	}
+	if _, ok := r.allow("/sports_keypoints_proto.GolfKeypointsService/ReadGolfKeypoints", "user:1"); !ok {
+		t.Errorf("another method was rate limited")
+	}
+	for i := 0; i < 100; i++ {
+		if _, ok := r.allow("/sports_keypoints_proto.UserService/ReadUser", "user:1"); !ok {
+			t.Fatalf("method with rate 0 was rate limited")
+		}
+	}
+	// the bucket refills over time
+	now = now.Add(2 * time.Second)
+	if _, ok := r.allow(calculateGolfKeypointsMethod, "user:1"); !ok {
+		t.Errorf("request after the retry delay was rate limited")
+	}
+	// idle callers are dropped
+	now = now.Add(rateLimiterIdleTimeout)
+	r.allow(calculateGolfKeypointsMethod, "user:3")
+	if len(r.limiters) != 1 {
+		t.Errorf("%d limiters after the sweep, expected 1", len(r.limiters))
+	}
+}
+
+func TestRateLimitUnaryInterceptor(t *testing.T) {
+	k := &KeypointsServerManager{rateLimiter: newRateLimiter(methodRateLimit{rate: rate.Every(time.Minute), burst: 1}, nil)}
+	now := time.Unix(0, 0)
+	k.rateLimiter.now = func() time.Time { return now }
+	info := &grpc.UnaryServerInfo{FullMethod: calculateGolfKeypointsMethod}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return "ok", nil }
+	ctx := context.WithValue(context.Background(), util.UserIdKey, "user1")
+	if _, err := k.rateLimitUnaryInterceptor(ctx, nil, info, handler); err != nil {
+		t.Fatalf("first request returned error %v", err)
+	}
+	stream := &headerRecorder{}
+	_, err := k.rateLimitUnaryInterceptor(grpc.NewContextWithServerTransportStream(ctx, stream), nil, info, handler)
+	st, _ := status.FromError(err)
+	if st.Code() != codes.ResourceExhausted {
+		t.Fatalf("code is %s, expected %s", st.Code(), codes.ResourceExhausted)
+	}
+	var retryInfo *errdetails.RetryInfo
+	for _, detail := range st.Details() {
+		if r, ok := detail.(*errdetails.RetryInfo); ok {
+			retryInfo = r
+		}
+	}
+	if retryInfo == nil || retryInfo.RetryDelay.AsDuration() != time.Minute {
+		t.Errorf("expected a 1m retry delay, details were %v", st.Details())
+	}
+	if values := stream.header.Get(retryAfterHeader); len(values) != 1 || values[0] != "60" {
+		t.Errorf("retry-after header is %v, expected 60", values)
+	}
+	// health checks are never limited
+	healthInfo := &grpc.UnaryServerInfo{FullMethod: "/grpc.health.v1.Health/Check"}
+	for i := 0; i < 3; i++ {
+		if _, err := k.rateLimitUnaryInterceptor(ctx, nil, healthInfo, handler); err != nil {
+			t.Fatalf("health check returned error %v", err)
+		}
+	}
+}
+
+func TestGetRateLimitCaller(t *testing.T) {
+	ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 4321}})
+	if caller := getRateLimitCaller(ctx); caller != "peer:10.0.0.1" {
+		t.Errorf("caller is %s, expected peer:10.0.0.1", caller)
+	}
+	if caller := getRateLimitCaller(context.WithValue(ctx, util.UserIdKey, "user1")); caller != "user:user1" {
+		t.Errorf("caller is %s, expected user:user1", caller)
+	}
+	// x-forwarded-for is only trusted from the rest gateway
+	md := metadata.Pairs("x-forwarded-for", "192.168.1.5, 10.0.0.2")
+	if caller := getRateLimitCaller(metadata.NewIncomingContext(ctx, md)); caller != "peer:10.0.0.1" {
+		t.Errorf("caller is %s, expected peer:10.0.0.1", caller)
+	}
+	gatewayCtx := peer.NewContext(metadata.NewIncomingContext(context.Background(), md), &peer.Peer{Addr: bufconnAddr{}})
+	if caller := getRateLimitCaller(gatewayCtx); caller != "peer:10.0.0.2" {
+		t.Errorf("caller is %s, expected peer:10.0.0.2", caller)
+	}
+}
+
+type bufconnAddr struct{}
+
+func (bufconnAddr) Network() string { return "bufconn" }
+func (bufconnAddr) String() string  { return "bufconn" }
+
+// Captures headers set with grpc.SetHeader
+type headerRecorder struct {
+	header metadata.MD
+}
+
+func (h *headerRecorder) Method() string { return calculateGolfKeypointsMethod }
+func (h *headerRecorder) SetHeader(md metadata.MD) error {
+	h.header = metadata.Join(h.header, md)
+	return nil
+}
+func (h *headerRecorder) SendHeader(md metadata.MD) error { return h.SetHeader(md) }
+func (h *headerRecorder) SetTrailer(md metadata.MD) error { return nil }
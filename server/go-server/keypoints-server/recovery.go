@@ -0,0 +1,37 @@
+package keypointsserver
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+
+	"github.com/sirfrank96/go-server/apierror"
+
+	"google.golang.org/grpc"
+)
+
+// Turns a panic in a later interceptor or handler into an Internal error instead of taking down the server. Runs right
+// after the error status interceptor so the error is converted to a status like any other.
+func recoveryUnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			resp, err = nil, recoveredError(ctx, r)
+		}
+	}()
+	return handler(ctx, req)
+}
+
+func recoveryStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = recoveredError(ss.Context(), r)
+		}
+	}()
+	return handler(srv, ss)
+}
+
+// The panic value and stack are only logged, the caller gets a generic internal error
+func recoveredError(ctx context.Context, r interface{}) error {
+	logger.ErrorContext(ctx, "rpc panicked", "panic", r, "stack", string(debug.Stack()))
+	return apierror.Internal(fmt.Errorf("panic: %v", r), "internal error")
+}
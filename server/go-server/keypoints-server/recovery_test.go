@@ -0,0 +1,36 @@
+package keypointsserver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sirfrank96/go-server/apierror"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+func TestRecoveryInterceptors(t *testing.T) {
+	unaryInfo := &grpc.UnaryServerInfo{FullMethod: calculateGolfKeypointsMethod}
+	resp, err := recoveryUnaryInterceptor(context.Background(), nil, unaryInfo, func(ctx context.Context, req interface{}) (interface{}, error) {
+		var keypoints map[string]int
+		keypoints["nose"] = 1
+		return "unreachable", nil
+	})
+	if resp != nil || apierror.Code(err) != codes.Internal {
+		t.Errorf("recoveryUnaryInterceptor returned %v, %v for a panic, expected %s", resp, err, codes.Internal)
+	}
+	resp, err = recoveryUnaryInterceptor(context.Background(), nil, unaryInfo, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "ok", nil
+	})
+	if resp != "ok" || err != nil {
+		t.Errorf("recoveryUnaryInterceptor returned %v, %v, expected the handler's response", resp, err)
+	}
+	streamInfo := &grpc.StreamServerInfo{FullMethod: "/sports_keypoints_proto.GolfKeypointsService/DownloadInputImage", IsServerStream: true}
+	err = recoveryStreamInterceptor(nil, &fakeServerStream{ctx: context.Background()}, streamInfo, func(srv interface{}, stream grpc.ServerStream) error {
+		panic("stream panicked")
+	})
+	if apierror.Code(err) != codes.Internal {
+		t.Errorf("recoveryStreamInterceptor returned %v for a panic, expected %s", err, codes.Internal)
+	}
+}
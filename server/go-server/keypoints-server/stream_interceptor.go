@@ -0,0 +1,131 @@
+package keypointsserver
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/sirfrank96/go-server/logging"
+	"github.com/sirfrank96/go-server/metrics"
+	skp "github.com/sirfrank96/go-server/sports-keypoints-proto"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Server stream with its own context so interceptors can add to it like they do for unary rpcs.
+// onFirstRecv runs once the first message is received, streaming rpcs send their session token in it.
+type wrappedServerStream struct {
+	grpc.ServerStream
+	ctx         context.Context
+	onFirstRecv func(ctx context.Context, m interface{}) (context.Context, error)
+	received    bool
+}
+
+func (w *wrappedServerStream) Context() context.Context {
+	if w.ctx != nil {
+		return w.ctx
+	}
+	return w.ServerStream.Context()
+}
+
+func (w *wrappedServerStream) RecvMsg(m interface{}) error {
+	if err := w.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	if w.onFirstRecv == nil || w.received {
+		return nil
+	}
+	w.received = true
+	ctx, err := w.onFirstRecv(w.ServerStream.Context(), m)
+	if err != nil {
+		return err
+	}
+	w.ctx = ctx
+	return nil
+}
+
+func requestIdStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx := ss.Context()
+	requestId := getRequestId(ctx)
+	ctx = logging.WithAttrs(logging.WithRequestId(ctx, requestId), "method", info.FullMethod)
+	if err := ss.SetHeader(metadata.Pairs(requestIdHeader, requestId)); err != nil {
+		logger.WarnContext(ctx, "could not set request id header", "error", err)
+	}
+	start := time.Now()
+	err := handler(srv, &wrappedServerStream{ServerStream: ss, ctx: ctx})
+	logRpc(ctx, err, time.Since(start))
+	return err
+}
+
+func metricsStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	start := time.Now()
+	err := handler(srv, ss)
+	metrics.ObserveRpc(info.FullMethod, status.Code(err).String(), start)
+	return err
+}
+
+func errorStatusStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return toStatusError(handler(srv, ss))
+}
+
+// Streaming rpcs send their session token in the first message (or as bearer metadata), the user id is added to the
+// stream's context once it is received
+func sessionStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	switch info.FullMethod {
+	case "/sports_keypoints_proto.GolfKeypointsService/UploadInputImageStream",
+		"/sports_keypoints_proto.GolfKeypointsService/CalibrateInputImageStream",
+		"/sports_keypoints_proto.GolfKeypointsService/DownloadInputImage",
+		"/sports_keypoints_proto.GolfKeypointsService/DownloadCalibrationImage",
+		"/sports_keypoints_proto.GolfKeypointsService/DownloadOutputImage",
+		"/sports_keypoints_proto.GolfKeypointsService/WatchAnalysisJob":
+		return handler(srv, &wrappedServerStream{ServerStream: ss, onFirstRecv: authenticateStream})
+	}
+	return handler(srv, ss)
+}
+
+func authenticateStream(ctx context.Context, m interface{}) (context.Context, error) {
+	var sessionToken string
+	switch req := m.(type) {
+	case *skp.UploadInputImageStreamRequest:
+		sessionToken = req.GetMetadata().GetSessionToken()
+	case *skp.CalibrateInputImageStreamRequest:
+		sessionToken = req.GetMetadata().GetSessionToken()
+	case *skp.DownloadInputImageRequest:
+		sessionToken = req.SessionToken
+	case *skp.DownloadCalibrationImageRequest:
+		sessionToken = req.SessionToken
+	case *skp.DownloadOutputImageRequest:
+		sessionToken = req.SessionToken
+	case *skp.WatchAnalysisJobRequest:
+		sessionToken = req.SessionToken
+	}
+	return withSession(ctx, sessionToken)
+}
+
+// By default responses are only compressed if the request was, keypoints_server.messages.compression compresses
+// responses for every client that accepts it
+func (k *KeypointsServerManager) compressionUnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	k.setSendCompressor(ctx)
+	return handler(ctx, req)
+}
+
+func (k *KeypointsServerManager) compressionStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	k.setSendCompressor(ss.Context())
+	return handler(srv, ss)
+}
+
+func (k *KeypointsServerManager) setSendCompressor(ctx context.Context) {
+	compression := k.config.Messages.Compression
+	if compression == "" || compression == "none" {
+		return
+	}
+	accepted, err := grpc.ClientSupportedCompressors(ctx)
+	if err != nil || !slices.Contains(accepted, compression) {
+		return
+	}
+	if err := grpc.SetSendCompressor(ctx, compression); err != nil {
+		logger.WarnContext(ctx, "could not set response compression", "compression", compression, "error", err)
+	}
+}
@@ -2,109 +2,211 @@ package keypointsserver
 
 import (
 	"context"
-	"fmt"
-	"log"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"regexp"
+	"strings"
+	"time"
 
+	"github.com/sirfrank96/go-server/apierror"
+	"github.com/sirfrank96/go-server/logging"
+	"github.com/sirfrank96/go-server/metrics"
 	skp "github.com/sirfrank96/go-server/sports-keypoints-proto"
 	"github.com/sirfrank96/go-server/util"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 )
 
+const requestIdHeader = "x-request-id"
+
+// request ids from callers are only reused if they can not inject anything into the logs
+var validRequestId = regexp.MustCompile(`^[A-Za-z0-9._-]{1,64}$`)
+
+// Assigns every request an id that is added to all of its log lines and sent back in the x-request-id response header.
+// The caller's x-request-id is reused if it is valid so requests can be correlated with client logs.
+func requestIdUnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	requestId := getRequestId(ctx)
+	ctx = logging.WithAttrs(logging.WithRequestId(ctx, requestId), "method", info.FullMethod)
+	if err := grpc.SetHeader(ctx, metadata.Pairs(requestIdHeader, requestId)); err != nil {
+		logger.WarnContext(ctx, "could not set request id header", "error", err)
+	}
+	start := time.Now()
+	resp, err := handler(ctx, req)
+	logRpc(ctx, err, time.Since(start))
+	return resp, err
+}
+
+func logRpc(ctx context.Context, err error, duration time.Duration) {
+	if err != nil {
+		logger.WarnContext(ctx, "rpc failed", "code", status.Code(err).String(), "duration_ms", duration.Milliseconds(), "error", err)
+	} else {
+		logger.InfoContext(ctx, "rpc finished", "code", codes.OK.String(), "duration_ms", duration.Milliseconds())
+	}
+}
+
+func getRequestId(ctx context.Context) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(requestIdHeader); len(values) > 0 && validRequestId.MatchString(values[0]) {
+			return values[0]
+		}
+	}
+	bytes := make([]byte, 16)
+	rand.Read(bytes)
+	return hex.EncodeToString(bytes)
+}
+
+// Records request counts by status code and latency for every rpc, first in the chain so rejected requests are counted too
+func metricsUnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	start := time.Now()
+	resp, err := handler(ctx, req)
+	metrics.ObserveRpc(info.FullMethod, status.Code(err).String(), start)
+	return resp, err
+}
+
+// Errors without a grpc status (typed errors from the apierror package have one) would reach the client as codes.Unknown,
+// deadlines and cancellations from the caller's context are mapped to their own codes
+func errorStatusUnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	resp, err := handler(ctx, req)
+	return resp, toStatusError(err)
+}
+
+func toStatusError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+		return status.FromContextError(err).Err()
+	}
+	return err
+}
+
 func sessionUnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
-	log.Println("unary interceptor: ", info.FullMethod)
+	var err error
 	switch info.FullMethod {
 	case "/sports_keypoints_proto.UserService/CreateUser":
 		// no-op for now
 	case "/sports_keypoints_proto.UserService/RegisterUser":
 		// no-op for now
 	case "/sports_keypoints_proto.UserService/ReadUser":
-		userId, err := getUserIdFromSessionToken(req.(*skp.ReadUserRequest).SessionToken)
-		if err != nil {
-			return nil, err
-		}
-		ctx = context.WithValue(ctx, util.UserIdKey, userId)
+		ctx, err = withSession(ctx, req.(*skp.ReadUserRequest).SessionToken)
 	case "/sports_keypoints_proto.UserService/UpdateUser":
-		userId, err := getUserIdFromSessionToken(req.(*skp.UpdateUserRequest).SessionToken)
-		if err != nil {
-			return nil, err
-		}
-		ctx = context.WithValue(ctx, util.UserIdKey, userId)
+		ctx, err = withSession(ctx, req.(*skp.UpdateUserRequest).SessionToken)
 	case "/sports_keypoints_proto.UserService/DeleteUser":
-		userId, err := getUserIdFromSessionToken(req.(*skp.DeleteUserRequest).SessionToken)
-		if err != nil {
-			return nil, err
-		}
-		ctx = context.WithValue(ctx, util.UserIdKey, userId)
+		ctx, err = withSession(ctx, req.(*skp.DeleteUserRequest).SessionToken)
+	case "/sports_keypoints_proto.UserService/EnrollTotp":
+		ctx, err = withSession(ctx, req.(*skp.EnrollTotpRequest).SessionToken)
+	case "/sports_keypoints_proto.UserService/ConfirmTotp":
+		ctx, err = withSession(ctx, req.(*skp.ConfirmTotpRequest).SessionToken)
+	case "/sports_keypoints_proto.UserService/DisableTotp":
+		ctx, err = withSession(ctx, req.(*skp.DisableTotpRequest).SessionToken)
+	case "/sports_keypoints_proto.UserService/VerifyTotp":
+		// no-op, authenticated with the totp challenge token instead of a session token
+	case "/sports_keypoints_proto.UserService/LoginWithOidc":
+		// no-op, authenticated with the identity provider instead of a session token
+	case "/sports_keypoints_proto.UserService/LinkOidcIdentity":
+		ctx, err = withSession(ctx, req.(*skp.LinkOidcIdentityRequest).SessionToken)
+	case "/sports_keypoints_proto.OrganizationService/CreateOrganization":
+		ctx, err = withSession(ctx, req.(*skp.CreateOrganizationRequest).SessionToken)
+	case "/sports_keypoints_proto.OrganizationService/ReadOrganization":
+		ctx, err = withSession(ctx, req.(*skp.ReadOrganizationRequest).SessionToken)
+	case "/sports_keypoints_proto.OrganizationService/ListOrganizationMembers":
+		ctx, err = withSession(ctx, req.(*skp.ListOrganizationMembersRequest).SessionToken)
+	case "/sports_keypoints_proto.OrganizationService/ListOrganizationInvitations":
+		ctx, err = withSession(ctx, req.(*skp.ListOrganizationInvitationsRequest).SessionToken)
+	case "/sports_keypoints_proto.OrganizationService/AcceptOrganizationInvitation":
+		ctx, err = withSession(ctx, req.(*skp.AcceptOrganizationInvitationRequest).SessionToken)
+	case "/sports_keypoints_proto.OrganizationService/DeclineOrganizationInvitation":
+		ctx, err = withSession(ctx, req.(*skp.DeclineOrganizationInvitationRequest).SessionToken)
+	case "/sports_keypoints_proto.OrganizationService/InviteOrganizationMember":
+		ctx, err = withSession(ctx, req.(*skp.InviteOrganizationMemberRequest).SessionToken)
+	case "/sports_keypoints_proto.OrganizationService/UpdateOrganizationMemberRole":
+		ctx, err = withSession(ctx, req.(*skp.UpdateOrganizationMemberRoleRequest).SessionToken)
+	case "/sports_keypoints_proto.OrganizationService/RemoveOrganizationMember":
+		ctx, err = withSession(ctx, req.(*skp.RemoveOrganizationMemberRequest).SessionToken)
 	case "/sports_keypoints_proto.GolfKeypointsService/UploadInputImage":
-		userId, err := getUserIdFromSessionToken(req.(*skp.UploadInputImageRequest).SessionToken)
-		if err != nil {
-			return nil, err
-		}
-		ctx = context.WithValue(ctx, util.UserIdKey, userId)
+		ctx, err = withSession(ctx, req.(*skp.UploadInputImageRequest).SessionToken)
 	case "/sports_keypoints_proto.GolfKeypointsService/ListInputImagesForUser":
-		userId, err := getUserIdFromSessionToken(req.(*skp.ListInputImagesForUserRequest).SessionToken)
-		if err != nil {
-			return nil, err
-		}
-		ctx = context.WithValue(ctx, util.UserIdKey, userId)
+		ctx, err = withSession(ctx, req.(*skp.ListInputImagesForUserRequest).SessionToken)
 	case "/sports_keypoints_proto.GolfKeypointsService/ReadInputImage":
-		userId, err := getUserIdFromSessionToken(req.(*skp.ReadInputImageRequest).SessionToken)
-		if err != nil {
-			return nil, err
-		}
-		ctx = context.WithValue(ctx, util.UserIdKey, userId)
+		ctx, err = withSession(ctx, req.(*skp.ReadInputImageRequest).SessionToken)
 	case "/sports_keypoints_proto.GolfKeypointsService/DeleteInputImage":
-		userId, err := getUserIdFromSessionToken(req.(*skp.DeleteInputImageRequest).SessionToken)
-		if err != nil {
-			return nil, err
-		}
-		ctx = context.WithValue(ctx, util.UserIdKey, userId)
+		ctx, err = withSession(ctx, req.(*skp.DeleteInputImageRequest).SessionToken)
 	case "/sports_keypoints_proto.GolfKeypointsService/CalibrateInputImage":
-		userId, err := getUserIdFromSessionToken(req.(*skp.CalibrateInputImageRequest).SessionToken)
-		if err != nil {
-			return nil, err
-		}
-		ctx = context.WithValue(ctx, util.UserIdKey, userId)
+		ctx, err = withSession(ctx, req.(*skp.CalibrateInputImageRequest).SessionToken)
 	case "/sports_keypoints_proto.GolfKeypointsService/CalculateGolfKeypoints":
-		userId, err := getUserIdFromSessionToken(req.(*skp.CalculateGolfKeypointsRequest).SessionToken)
-		if err != nil {
-			return nil, err
-		}
-		ctx = context.WithValue(ctx, util.UserIdKey, userId)
+		ctx, err = withSession(ctx, req.(*skp.CalculateGolfKeypointsRequest).SessionToken)
 	case "/sports_keypoints_proto.GolfKeypointsService/ReadGolfKeypoints":
-		userId, err := getUserIdFromSessionToken(req.(*skp.ReadGolfKeypointsRequest).SessionToken)
-		if err != nil {
-			return nil, err
-		}
-		ctx = context.WithValue(ctx, util.UserIdKey, userId)
+		ctx, err = withSession(ctx, req.(*skp.ReadGolfKeypointsRequest).SessionToken)
 	case "/sports_keypoints_proto.GolfKeypointsService/UpdateBodyKeypoints":
-		userId, err := getUserIdFromSessionToken(req.(*skp.UpdateBodyKeypointsRequest).SessionToken)
-		if err != nil {
-			return nil, err
-		}
-		ctx = context.WithValue(ctx, util.UserIdKey, userId)
+		ctx, err = withSession(ctx, req.(*skp.UpdateBodyKeypointsRequest).SessionToken)
 	case "/sports_keypoints_proto.GolfKeypointsService/DeleteGolfKeypoints":
-		userId, err := getUserIdFromSessionToken(req.(*skp.DeleteGolfKeypointsRequest).SessionToken)
-		if err != nil {
-			return nil, err
-		}
-		ctx = context.WithValue(ctx, util.UserIdKey, userId)
+		ctx, err = withSession(ctx, req.(*skp.DeleteGolfKeypointsRequest).SessionToken)
+	case "/sports_keypoints_proto.GolfKeypointsService/ImportGolfKeypoints":
+		ctx, err = withSession(ctx, req.(*skp.ImportGolfKeypointsRequest).SessionToken)
+	case "/sports_keypoints_proto.GolfKeypointsService/SubmitAnalysis":
+		ctx, err = withSession(ctx, req.(*skp.SubmitAnalysisRequest).SessionToken)
+	case "/sports_keypoints_proto.GolfKeypointsService/GetAnalysisJob":
+		ctx, err = withSession(ctx, req.(*skp.GetAnalysisJobRequest).SessionToken)
+	case "/sports_keypoints_proto.AdminService/ListUsers":
+		ctx, err = withSession(ctx, req.(*skp.ListUsersRequest).SessionToken)
+	case "/sports_keypoints_proto.AdminService/SetUserDisabled":
+		ctx, err = withSession(ctx, req.(*skp.SetUserDisabledRequest).SessionToken)
+	case "/sports_keypoints_proto.AdminService/ForcePasswordReset":
+		ctx, err = withSession(ctx, req.(*skp.ForcePasswordResetRequest).SessionToken)
+	case "/sports_keypoints_proto.AdminService/SetUserRole":
+		ctx, err = withSession(ctx, req.(*skp.SetUserRoleRequest).SessionToken)
+	case "/sports_keypoints_proto.AdminService/GetUserStats":
+		ctx, err = withSession(ctx, req.(*skp.GetUserStatsRequest).SessionToken)
+	case "/sports_keypoints_proto.AdminService/AdminDeleteUser":
+		ctx, err = withSession(ctx, req.(*skp.AdminDeleteUserRequest).SessionToken)
+	case "/sports_keypoints_proto.AdminService/RunMaintenanceJob":
+		ctx, err = withSession(ctx, req.(*skp.RunMaintenanceJobRequest).SessionToken)
+	}
+	if err != nil {
+		return nil, err
 	}
 	return handler(ctx, req)
 }
 
-func getUserIdFromSessionToken(sessionToken string) (string, error) {
+// Adds the user id and when the session token was issued (so revoked sessions can be rejected) to the context.
+// The session token can be sent in the request or as "authorization: Bearer <token>" metadata (used by the rest gateway).
+func withSession(ctx context.Context, sessionToken string) (context.Context, error) {
+	if sessionToken == "" {
+		sessionToken = getBearerToken(ctx)
+	}
 	if sessionToken == "" {
-		return "", fmt.Errorf("no session token provided")
+		return ctx, apierror.Unauthenticated("no session token provided")
 	}
 	claims, err := util.VerifyJWTSessionToken(sessionToken)
 	if err != nil {
-		return "", err
+		return ctx, apierror.Unauthenticated("invalid session token: %s", err.Error())
 	}
 	userId, err := util.GetUserIdFromClaims(claims)
 	if err != nil {
-		return "", err
+		return ctx, apierror.Unauthenticated("invalid session token: %s", err.Error())
+	}
+	ctx = context.WithValue(ctx, util.UserIdKey, userId)
+	ctx = context.WithValue(ctx, util.IssuedAtKey, util.GetIssuedAtFromClaims(claims))
+	return logging.WithAttrs(ctx, "user_id", userId), nil
+}
+
+func getBearerToken(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	for _, value := range md.Get("authorization") {
+		scheme, token, found := strings.Cut(value, " ")
+		if found && strings.EqualFold(scheme, "bearer") {
+			return strings.TrimSpace(token)
+		}
 	}
-	return userId, nil
+	return ""
 }
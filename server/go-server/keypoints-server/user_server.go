@@ -51,3 +51,45 @@ func (u *userServer) DeleteUser(ctx context.Context, request *skp.DeleteUserRequ
 	}
 	return u.handler.DeleteUser(ctx, request)
 }
+
+func (u *userServer) EnrollTotp(ctx context.Context, request *skp.EnrollTotpRequest) (*skp.EnrollTotpResponse, error) {
+	if err := verifyEnrollTotpRequest(request); err != nil {
+		return nil, err
+	}
+	return u.handler.EnrollTotp(ctx, request)
+}
+
+func (u *userServer) ConfirmTotp(ctx context.Context, request *skp.ConfirmTotpRequest) (*skp.ConfirmTotpResponse, error) {
+	if err := verifyConfirmTotpRequest(request); err != nil {
+		return nil, err
+	}
+	return u.handler.ConfirmTotp(ctx, request)
+}
+
+func (u *userServer) DisableTotp(ctx context.Context, request *skp.DisableTotpRequest) (*skp.DisableTotpResponse, error) {
+	if err := verifyDisableTotpRequest(request); err != nil {
+		return nil, err
+	}
+	return u.handler.DisableTotp(ctx, request)
+}
+
+func (u *userServer) VerifyTotp(ctx context.Context, request *skp.VerifyTotpRequest) (*skp.VerifyTotpResponse, error) {
+	if err := verifyVerifyTotpRequest(request); err != nil {
+		return nil, err
+	}
+	return u.handler.VerifyTotp(ctx, request)
+}
+
+func (u *userServer) LoginWithOidc(ctx context.Context, request *skp.LoginWithOidcRequest) (*skp.LoginWithOidcResponse, error) {
+	if err := verifyLoginWithOidcRequest(request); err != nil {
+		return nil, err
+	}
+	return u.handler.LoginWithOidc(ctx, request)
+}
+
+func (u *userServer) LinkOidcIdentity(ctx context.Context, request *skp.LinkOidcIdentityRequest) (*skp.LinkOidcIdentityResponse, error) {
+	if err := verifyLinkOidcIdentityRequest(request); err != nil {
+		return nil, err
+	}
+	return u.handler.LinkOidcIdentity(ctx, request)
+}
@@ -0,0 +1,155 @@
+package keypointsserver
+
+import (
+	"context"
+	"fmt"
+	"net/mail"
+	"unicode/utf8"
+
+	"github.com/sirfrank96/go-server/apierror"
+	skp "github.com/sirfrank96/go-server/sports-keypoints-proto"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Checks requests against the validation rules declared on their fields in the protos (see protos/validate.proto)
+// before they reach the verify*Request functions and the handlers
+func validationUnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := validateRequest(req); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// Server streaming requests are validated when they are received. Client streams send their request in parts, it is
+// validated once image_stream.go has assembled it.
+func validationStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if info.IsClientStream {
+		return handler(srv, ss)
+	}
+	return handler(srv, &wrappedServerStream{ServerStream: ss, onFirstRecv: func(ctx context.Context, m interface{}) (context.Context, error) {
+		return ctx, validateRequest(m)
+	}})
+}
+
+// Returns an InvalidArgument error with a field violation for every broken rule, nil if there are none
+func validateRequest(req interface{}) error {
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return nil
+	}
+	var violations []fieldViolation
+	validateMessage(msg.ProtoReflect(), "", &violations)
+	if len(violations) == 0 {
+		return nil
+	}
+	err := apierror.InvalidArgument(violations[0].field, "%s", violations[0].description)
+	for _, violation := range violations[1:] {
+		err.WithFieldViolation(violation.field, violation.description)
+	}
+	return err
+}
+
+type fieldViolation struct {
+	// path of the field, eg. updated_body_keypoints.nose.confidence
+	field       string
+	description string
+}
+
+func validateMessage(msg protoreflect.Message, prefix string, violations *[]fieldViolation) {
+	if !msg.IsValid() {
+		return
+	}
+	fields := msg.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		path := prefix + string(fd.Name())
+		rules, _ := proto.GetExtension(fd.Options(), skp.E_Rules).(*skp.FieldRules)
+		if rules != nil && !fd.IsList() && !fd.IsMap() {
+			for _, description := range checkFieldRules(fd, msg.Get(fd), msg.Has(fd), rules) {
+				*violations = append(*violations, fieldViolation{field: path, description: description})
+			}
+		}
+		// rules of nested messages only apply if they are set
+		if fd.Kind() != protoreflect.MessageKind || fd.IsMap() || !msg.Has(fd) {
+			continue
+		}
+		if fd.IsList() {
+			list := msg.Get(fd).List()
+			for j := 0; j < list.Len(); j++ {
+				validateMessage(list.Get(j).Message(), fmt.Sprintf("%s[%d].", path, j), violations)
+			}
+			continue
+		}
+		validateMessage(msg.Get(fd).Message(), path+".", violations)
+	}
+}
+
+// Descriptions of the rules the value breaks
+func checkFieldRules(fd protoreflect.FieldDescriptor, value protoreflect.Value, has bool, rules *skp.FieldRules) []string {
+	var res []string
+	name := string(fd.Name())
+	switch fd.Kind() {
+	case protoreflect.StringKind:
+		s := value.String()
+		if rules.Required && s == "" {
+			res = append(res, fmt.Sprintf("please enter a non-empty %s", name))
+		}
+		if rules.Email && s != "" {
+			if parsed, err := mail.ParseAddress(s); err != nil || parsed.Address != s {
+				res = append(res, fmt.Sprintf("%s is not a valid email address", name))
+			}
+		}
+		res = append(res, checkLength(name, uint64(utf8.RuneCountInString(s)), "characters", rules)...)
+	case protoreflect.BytesKind:
+		b := value.Bytes()
+		if rules.Required && len(b) == 0 {
+			res = append(res, fmt.Sprintf("please add %s", name))
+		}
+		res = append(res, checkLength(name, uint64(len(b)), "bytes", rules)...)
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		if rules.Required && !has {
+			res = append(res, fmt.Sprintf("please add %s", name))
+		}
+	case protoreflect.EnumKind:
+		number := value.Enum()
+		if rules.DefinedOnly && fd.Enum().Values().ByNumber(number) == nil {
+			res = append(res, fmt.Sprintf("%d is not a valid %s", number, name))
+		}
+		if rules.NotUnspecified && number == 0 {
+			res = append(res, fmt.Sprintf("please enter a %s", name))
+		}
+	case protoreflect.Int32Kind, protoreflect.Int64Kind, protoreflect.Sint32Kind, protoreflect.Sint64Kind,
+		protoreflect.Sfixed32Kind, protoreflect.Sfixed64Kind:
+		res = append(res, checkBounds(name, float64(value.Int()), rules)...)
+	case protoreflect.Uint32Kind, protoreflect.Uint64Kind, protoreflect.Fixed32Kind, protoreflect.Fixed64Kind:
+		res = append(res, checkBounds(name, float64(value.Uint()), rules)...)
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		res = append(res, checkBounds(name, value.Float(), rules)...)
+	}
+	return res
+}
+
+func checkLength(name string, length uint64, unit string, rules *skp.FieldRules) []string {
+	var res []string
+	if rules.MinLen != nil && length < rules.GetMinLen() {
+		res = append(res, fmt.Sprintf("%s must be at least %d %s", name, rules.GetMinLen(), unit))
+	}
+	if rules.MaxLen != nil && length > rules.GetMaxLen() {
+		res = append(res, fmt.Sprintf("%s must be at most %d %s", name, rules.GetMaxLen(), unit))
+	}
+	return res
+}
+
+func checkBounds(name string, number float64, rules *skp.FieldRules) []string {
+	var res []string
+	if rules.Gte != nil && number < rules.GetGte() {
+		res = append(res, fmt.Sprintf("%s must be at least %v", name, rules.GetGte()))
+	}
+	if rules.Lte != nil && number > rules.GetLte() {
+		res = append(res, fmt.Sprintf("%s must be at most %v", name, rules.GetLte()))
+	}
+	return res
+}
@@ -0,0 +1,105 @@
+package keypointsserver
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/sirfrank96/go-server/apierror"
+	skp "github.com/sirfrank96/go-server/sports-keypoints-proto"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Server side of a server streaming rpc, RecvMsg receives recv
+type fakeServerStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	recv proto.Message
+}
+
+func (f *fakeServerStream) Context() context.Context {
+	return f.ctx
+}
+
+func (f *fakeServerStream) RecvMsg(m interface{}) error {
+	proto.Merge(m.(proto.Message), f.recv)
+	return nil
+}
+
+// Fields of all the violations of err
+func violatedFields(err error) []string {
+	var fields []string
+	for _, violation := range apierror.FieldViolations(err) {
+		fields = append(fields, violation.GetField())
+	}
+	return fields
+}
+
+func TestValidateRequest(t *testing.T) {
+	// every missing field is reported
+	upload := &skp.UploadInputImageRequest{}
+	err := validateRequest(upload)
+	if apierror.Code(err) != codes.InvalidArgument {
+		t.Fatalf("validateRequest(%+v) returned %v, expected %s", upload, err, codes.InvalidArgument)
+	}
+	if fields := strings.Join(violatedFields(err), ","); fields != "image_type,image,description,timestamp" {
+		t.Errorf("validateRequest(%+v) reported violations of %s", upload, fields)
+	}
+	// undefined enum values
+	upload = &skp.UploadInputImageRequest{ImageType: skp.ImageType(99), Image: []byte("image"), Description: "driver", Timestamp: timestamppb.Now()}
+	if fields := strings.Join(violatedFields(validateRequest(upload)), ","); fields != "image_type" {
+		t.Errorf("validateRequest reported violations of %s, expected image_type", fields)
+	}
+	upload.ImageType = skp.ImageType_DTL
+	if err := validateRequest(upload); err != nil {
+		t.Errorf("validateRequest(%+v) had an unexpected error: %s", upload, err.Error())
+	}
+	// email is only checked if it is set
+	updateUser := &skp.UpdateUserRequest{}
+	if err := validateRequest(updateUser); err != nil {
+		t.Errorf("validateRequest(%+v) had an unexpected error: %s", updateUser, err.Error())
+	}
+	for _, email := range []string{"abc", "abc.gmail.com", "Abc <abc@gmail.com>"} {
+		updateUser.Email = email
+		if fields := violatedFields(validateRequest(updateUser)); len(fields) != 1 || fields[0] != "email" {
+			t.Errorf("validateRequest(%+v) reported violations of %v, expected email", updateUser, fields)
+		}
+	}
+	updateUser.Email = "abc@gmail.com"
+	if err := validateRequest(updateUser); err != nil {
+		t.Errorf("validateRequest(%+v) had an unexpected error: %s", updateUser, err.Error())
+	}
+	// rules of nested messages are checked with the path of the field
+	updateBody := &skp.UpdateBodyKeypointsRequest{InputImageId: "image1", UpdatedBodyKeypoints: &skp.Body25PoseKeypoints{
+		Nose: &skp.Keypoint{X: 10, Y: 20, Confidence: 1.5},
+		Neck: &skp.Keypoint{X: -1, Y: 20, Confidence: 0.5},
+	}}
+	if fields := strings.Join(violatedFields(validateRequest(updateBody)), ","); fields != "updated_body_keypoints.nose.confidence,updated_body_keypoints.neck.x" {
+		t.Errorf("validateRequest reported violations of %s", fields)
+	}
+	// bytes that are too long
+	importKeypoints := &skp.ImportGolfKeypointsRequest{InputImageId: "image1", Keypoints: &skp.ImportGolfKeypointsRequest_OpenposeJson{OpenposeJson: make([]byte, 1048577)}}
+	if fields := violatedFields(validateRequest(importKeypoints)); len(fields) != 1 || fields[0] != "openpose_json" {
+		t.Errorf("validateRequest reported violations of %v, expected openpose_json", fields)
+	}
+	// numeric bounds
+	listUsers := &skp.ListUsersRequest{PageSize: 501}
+	if fields := violatedFields(validateRequest(listUsers)); len(fields) != 1 || fields[0] != "page_size" {
+		t.Errorf("validateRequest(%+v) reported violations of %v, expected page_size", listUsers, fields)
+	}
+}
+
+func TestValidationStreamInterceptor(t *testing.T) {
+	info := &grpc.StreamServerInfo{FullMethod: "/sports_keypoints_proto.GolfKeypointsService/DownloadInputImage", IsServerStream: true}
+	ss := &fakeServerStream{ctx: context.Background(), recv: &skp.DownloadInputImageRequest{}}
+	err := validationStreamInterceptor(nil, ss, info, func(srv interface{}, stream grpc.ServerStream) error {
+		return stream.RecvMsg(&skp.DownloadInputImageRequest{})
+	})
+	if fields := violatedFields(err); len(fields) != 1 || fields[0] != "input_image_id" {
+		t.Errorf("validationStreamInterceptor returned %v, expected a violation of input_image_id", err)
+	}
+}
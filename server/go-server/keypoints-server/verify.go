@@ -1,171 +1,336 @@
 package keypointsserver
 
 import (
-	"fmt"
-	"net/mail"
-
+	"github.com/sirfrank96/go-server/apierror"
 	skp "github.com/sirfrank96/go-server/sports-keypoints-proto"
 )
 
 func verifyCreateUserRequest(request *skp.CreateUserRequest) error {
 	if request == nil {
-		return fmt.Errorf("request is empty")
-	}
-	if request.UserName == "" {
-		return fmt.Errorf("please enter a non-empty username")
-	}
-	if request.Password == "" {
-		return fmt.Errorf("please enter a non-empty password")
-	}
-	if request.Email == "" {
-		return fmt.Errorf("please enter a non-empty email")
-	}
-	// make sure email is correct format
-	emailParsed, err := mail.ParseAddress(request.Email)
-	if err != nil {
-		return fmt.Errorf("invalid email: %s", err.Error())
-	}
-	if emailParsed.Address != request.Email {
-		return fmt.Errorf("invalid email, bad format")
+		return apierror.InvalidArgument("", "request is empty")
 	}
 	return nil
 }
 
 func verifyRegisterUserRequest(request *skp.RegisterUserRequest) error {
 	if request == nil {
-		return fmt.Errorf("request is empty")
-	}
-	if request.UserName == "" {
-		return fmt.Errorf("please enter a non-empty username")
-	}
-	if request.Password == "" {
-		return fmt.Errorf("please enter a non-empty password")
+		return apierror.InvalidArgument("", "request is empty")
 	}
 	return nil
 }
 
 func verifyReadUserRequest(request *skp.ReadUserRequest) error {
 	if request == nil {
-		return fmt.Errorf("request is empty")
+		return apierror.InvalidArgument("", "request is empty")
 	}
 	return nil
 }
 
 func verifyUpdateUserRequest(request *skp.UpdateUserRequest) error {
 	if request == nil {
-		return fmt.Errorf("request is empty")
+		return apierror.InvalidArgument("", "request is empty")
 	}
 	if request.UserName == "" && request.Password == "" && request.Email == "" {
-		return fmt.Errorf("please add at least one field to be updated")
+		return apierror.InvalidArgument("", "please add at least one field to be updated")
 	}
 	return nil
 }
 
 func verifyDeleteUserRequest(request *skp.DeleteUserRequest) error {
 	if request == nil {
-		return fmt.Errorf("request is empty")
+		return apierror.InvalidArgument("", "request is empty")
 	}
 	return nil
 }
 
-func verifyUploadInputImageRequest(request *skp.UploadInputImageRequest) error {
+func verifyEnrollTotpRequest(request *skp.EnrollTotpRequest) error {
 	if request == nil {
-		return fmt.Errorf("request is empty")
+		return apierror.InvalidArgument("", "request is empty")
 	}
-	if request.ImageType == skp.ImageType_IMAGE_TYPE_UNSPECIFIED {
-		return fmt.Errorf("please enter an image type")
+	return nil
+}
+
+func verifyConfirmTotpRequest(request *skp.ConfirmTotpRequest) error {
+	if request == nil {
+		return apierror.InvalidArgument("", "request is empty")
 	}
-	if request.Image == nil || len(request.Image) == 0 {
-		return fmt.Errorf("please upload an input image")
+	return nil
+}
+
+func verifyDisableTotpRequest(request *skp.DisableTotpRequest) error {
+	if request == nil {
+		return apierror.InvalidArgument("", "request is empty")
 	}
-	if request.Description == "" {
-		return fmt.Errorf("please add a description")
+	return nil
+}
+
+func verifyVerifyTotpRequest(request *skp.VerifyTotpRequest) error {
+	if request == nil {
+		return apierror.InvalidArgument("", "request is empty")
 	}
-	if request.Timestamp == nil {
-		return fmt.Errorf("please add a timestamp")
+	return nil
+}
+
+func verifyLoginWithOidcRequest(request *skp.LoginWithOidcRequest) error {
+	if request == nil {
+		return apierror.InvalidArgument("", "request is empty")
+	}
+	if request.AuthorizationCode == "" && request.IdToken == "" {
+		return apierror.InvalidArgument("authorization_code", "please enter an authorization code or an id token")
+	}
+	if request.AuthorizationCode != "" && request.IdToken != "" {
+		return apierror.InvalidArgument("authorization_code", "please enter only one of authorization code or id token")
+	}
+	return nil
+}
+
+func verifyLinkOidcIdentityRequest(request *skp.LinkOidcIdentityRequest) error {
+	if request == nil {
+		return apierror.InvalidArgument("", "request is empty")
+	}
+	if request.AuthorizationCode == "" && request.IdToken == "" {
+		return apierror.InvalidArgument("authorization_code", "please enter an authorization code or an id token")
+	}
+	if request.AuthorizationCode != "" && request.IdToken != "" {
+		return apierror.InvalidArgument("authorization_code", "please enter only one of authorization code or id token")
+	}
+	return nil
+}
+
+func verifyUploadInputImageRequest(request *skp.UploadInputImageRequest) error {
+	if request == nil {
+		return apierror.InvalidArgument("", "request is empty")
 	}
 	if err := request.Timestamp.CheckValid(); err != nil {
-		return fmt.Errorf("invalid timestamp: %s", err.Error())
+		return apierror.InvalidArgument("timestamp", "invalid timestamp: %s", err.Error())
+	}
+	return nil
+}
+
+// Images are limited by keypoints_server.max_image_size instead of a rule in the protos so the limit can be configured
+func verifyImageSize(field string, image []byte, maxImageSize int64) error {
+	if int64(len(image)) > maxImageSize {
+		return apierror.InvalidArgument(field, "%s is larger than %d bytes", field, maxImageSize)
 	}
 	return nil
 }
 
 func verifyListInputImagesForUserRequest(request *skp.ListInputImagesForUserRequest) error {
 	if request == nil {
-		return fmt.Errorf("request is empty")
+		return apierror.InvalidArgument("", "request is empty")
 	}
 	return nil
 }
 
 func verifyReadInputImageRequest(request *skp.ReadInputImageRequest) error {
 	if request == nil {
-		return fmt.Errorf("request is empty")
-	}
-	if request.InputImageId == "" {
-		return fmt.Errorf("please enter an input image id")
+		return apierror.InvalidArgument("", "request is empty")
 	}
 	return nil
 }
 
 func verifyDeleteInputImageRequest(request *skp.DeleteInputImageRequest) error {
 	if request == nil {
-		return fmt.Errorf("request is empty")
-	}
-	if request.InputImageId == "" {
-		return fmt.Errorf("please enter an input image id")
+		return apierror.InvalidArgument("", "request is empty")
 	}
 	return nil
 }
 
 func verifyCalibrateInputImageRequest(request *skp.CalibrateInputImageRequest) error {
 	if request == nil {
-		return fmt.Errorf("request is empty")
-	}
-	if request.InputImageId == "" {
-		return fmt.Errorf("please enter an input image id")
+		return apierror.InvalidArgument("", "request is empty")
 	}
 	return nil
 }
 
 func verifyCalculateGolfKeypointsRequest(request *skp.CalculateGolfKeypointsRequest) error {
 	if request == nil {
-		return fmt.Errorf("request is empty")
-	}
-	if request.InputImageId == "" {
-		return fmt.Errorf("please enter an input image id")
+		return apierror.InvalidArgument("", "request is empty")
 	}
 	return nil
 }
 
 func verifyReadGolfKeypointsRequest(request *skp.ReadGolfKeypointsRequest) error {
 	if request == nil {
-		return fmt.Errorf("request is empty")
-	}
-	if request.InputImageId == "" {
-		return fmt.Errorf("please enter an input image id")
+		return apierror.InvalidArgument("", "request is empty")
 	}
 	return nil
 }
 
 func verifyUpdateBodyKeypointsRequest(request *skp.UpdateBodyKeypointsRequest) error {
 	if request == nil {
-		return fmt.Errorf("request is empty")
+		return apierror.InvalidArgument("", "request is empty")
 	}
-	if request.InputImageId == "" {
-		return fmt.Errorf("please enter an input image id")
+	return nil
+}
+
+func verifyImportGolfKeypointsRequest(request *skp.ImportGolfKeypointsRequest) error {
+	if request == nil {
+		return apierror.InvalidArgument("", "request is empty")
 	}
-	if request.UpdatedBodyKeypoints == nil {
-		return fmt.Errorf("please add at least one body keypoint to update")
+	if len(request.GetOpenposeJson()) == 0 && request.GetBodyKeypoints() == nil {
+		return apierror.InvalidArgument("openpose_json", "please add openpose json or body keypoints to import")
 	}
 	return nil
 }
 
 func verifyDeleteGolfKeypointsRequest(request *skp.DeleteGolfKeypointsRequest) error {
 	if request == nil {
-		return fmt.Errorf("request is empty")
+		return apierror.InvalidArgument("", "request is empty")
+	}
+	return nil
+}
+
+func verifyDownloadInputImageRequest(request *skp.DownloadInputImageRequest) error {
+	if request == nil {
+		return apierror.InvalidArgument("", "request is empty")
+	}
+	return nil
+}
+
+func verifyDownloadCalibrationImageRequest(request *skp.DownloadCalibrationImageRequest) error {
+	if request == nil {
+		return apierror.InvalidArgument("", "request is empty")
+	}
+	return nil
+}
+
+func verifyDownloadOutputImageRequest(request *skp.DownloadOutputImageRequest) error {
+	if request == nil {
+		return apierror.InvalidArgument("", "request is empty")
+	}
+	return nil
+}
+
+func verifySubmitAnalysisRequest(request *skp.SubmitAnalysisRequest) error {
+	if request == nil {
+		return apierror.InvalidArgument("", "request is empty")
+	}
+	return nil
+}
+
+func verifyGetAnalysisJobRequest(request *skp.GetAnalysisJobRequest) error {
+	if request == nil {
+		return apierror.InvalidArgument("", "request is empty")
+	}
+	return nil
+}
+
+func verifyWatchAnalysisJobRequest(request *skp.WatchAnalysisJobRequest) error {
+	if request == nil {
+		return apierror.InvalidArgument("", "request is empty")
 	}
-	if request.InputImageId == "" {
-		return fmt.Errorf("please enter an input image id")
+	return nil
+}
+
+func verifyCreateOrganizationRequest(request *skp.CreateOrganizationRequest) error {
+	if request == nil {
+		return apierror.InvalidArgument("", "request is empty")
+	}
+	return nil
+}
+
+func verifyReadOrganizationRequest(request *skp.ReadOrganizationRequest) error {
+	if request == nil {
+		return apierror.InvalidArgument("", "request is empty")
+	}
+	return nil
+}
+
+func verifyListOrganizationMembersRequest(request *skp.ListOrganizationMembersRequest) error {
+	if request == nil {
+		return apierror.InvalidArgument("", "request is empty")
+	}
+	return nil
+}
+
+func verifyListOrganizationInvitationsRequest(request *skp.ListOrganizationInvitationsRequest) error {
+	if request == nil {
+		return apierror.InvalidArgument("", "request is empty")
+	}
+	return nil
+}
+
+func verifyAcceptOrganizationInvitationRequest(request *skp.AcceptOrganizationInvitationRequest) error {
+	if request == nil {
+		return apierror.InvalidArgument("", "request is empty")
+	}
+	return nil
+}
+
+func verifyDeclineOrganizationInvitationRequest(request *skp.DeclineOrganizationInvitationRequest) error {
+	if request == nil {
+		return apierror.InvalidArgument("", "request is empty")
+	}
+	return nil
+}
+
+func verifyInviteOrganizationMemberRequest(request *skp.InviteOrganizationMemberRequest) error {
+	if request == nil {
+		return apierror.InvalidArgument("", "request is empty")
+	}
+	return nil
+}
+
+func verifyUpdateOrganizationMemberRoleRequest(request *skp.UpdateOrganizationMemberRoleRequest) error {
+	if request == nil {
+		return apierror.InvalidArgument("", "request is empty")
+	}
+	return nil
+}
+
+func verifyRemoveOrganizationMemberRequest(request *skp.RemoveOrganizationMemberRequest) error {
+	if request == nil {
+		return apierror.InvalidArgument("", "request is empty")
+	}
+	return nil
+}
+
+func verifyListUsersRequest(request *skp.ListUsersRequest) error {
+	if request == nil {
+		return apierror.InvalidArgument("", "request is empty")
+	}
+	return nil
+}
+
+func verifySetUserDisabledRequest(request *skp.SetUserDisabledRequest) error {
+	if request == nil {
+		return apierror.InvalidArgument("", "request is empty")
+	}
+	return nil
+}
+
+func verifyForcePasswordResetRequest(request *skp.ForcePasswordResetRequest) error {
+	if request == nil {
+		return apierror.InvalidArgument("", "request is empty")
+	}
+	return nil
+}
+
+func verifySetUserRoleRequest(request *skp.SetUserRoleRequest) error {
+	if request == nil {
+		return apierror.InvalidArgument("", "request is empty")
+	}
+	return nil
+}
+
+func verifyGetUserStatsRequest(request *skp.GetUserStatsRequest) error {
+	if request == nil {
+		return apierror.InvalidArgument("", "request is empty")
+	}
+	return nil
+}
+
+func verifyAdminDeleteUserRequest(request *skp.AdminDeleteUserRequest) error {
+	if request == nil {
+		return apierror.InvalidArgument("", "request is empty")
+	}
+	return nil
+}
+
+func verifyRunMaintenanceJobRequest(request *skp.RunMaintenanceJobRequest) error {
+	if request == nil {
+		return apierror.InvalidArgument("", "request is empty")
 	}
 	return nil
 }
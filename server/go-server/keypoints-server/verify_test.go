@@ -3,50 +3,60 @@ package keypointsserver
 import (
 	"testing"
 
+	"google.golang.org/grpc/codes"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/sirfrank96/go-server/apierror"
 	skp "github.com/sirfrank96/go-server/sports-keypoints-proto"
 )
 
+// Checks request the way the server does, against the rules of its proto annotations and then with verify
+func validateAndVerify[T any](verify func(T) error, request T) error {
+	if err := validateRequest(request); err != nil {
+		return err
+	}
+	return verify(request)
+}
+
 func TestVerifyCreateUserRequest(t *testing.T) {
 	// nil request
-	err := verifyCreateUserRequest(nil)
+	err := validateAndVerify(verifyCreateUserRequest, nil)
 	if err == nil {
 		t.Errorf("verifyCreateUserRequest(nil) is supposed to have an error")
 	}
 	// empty request
 	createUserRequest := &skp.CreateUserRequest{}
-	err = verifyCreateUserRequest(createUserRequest)
+	err = validateAndVerify(verifyCreateUserRequest, createUserRequest)
 	if err == nil {
 		t.Errorf("verifyCreateUserRequest(%+v) is supposed to have an error", createUserRequest)
 	}
 	// only user name set
 	createUserRequest.UserName = "user1"
-	err = verifyCreateUserRequest(createUserRequest)
+	err = validateAndVerify(verifyCreateUserRequest, createUserRequest)
 	if err == nil {
 		t.Errorf("verifyCreateUserRequest(%+v) is supposed to have an error", createUserRequest)
 	}
 	// only user name and password set
 	createUserRequest.Password = "password1"
-	err = verifyCreateUserRequest(createUserRequest)
+	err = validateAndVerify(verifyCreateUserRequest, createUserRequest)
 	if err == nil {
 		t.Errorf("verifyCreateUserRequest(%+v) is supposed to have an error", createUserRequest)
 	}
 	// bad email
 	createUserRequest.Email = "abc"
-	err = verifyCreateUserRequest(createUserRequest)
+	err = validateAndVerify(verifyCreateUserRequest, createUserRequest)
 	if err == nil {
 		t.Errorf("verifyCreateUserRequest(%+v) is supposed to have an error", createUserRequest)
 	}
 	// bad email
 	createUserRequest.Email = "abc.gmail.com"
-	err = verifyCreateUserRequest(createUserRequest)
+	err = validateAndVerify(verifyCreateUserRequest, createUserRequest)
 	if err == nil {
 		t.Errorf("verifyCreateUserRequest(%+v) is supposed to have an error", createUserRequest)
 	}
 	// good request
 	createUserRequest.Email = "abc@gmail.com"
-	err = verifyCreateUserRequest(createUserRequest)
+	err = validateAndVerify(verifyCreateUserRequest, createUserRequest)
 	if err != nil {
 		t.Errorf("verifyCreateUserRequest(%+v) had an unexpected error: %s", createUserRequest, err.Error())
 	}
@@ -54,25 +64,25 @@ func TestVerifyCreateUserRequest(t *testing.T) {
 
 func TestVerifyRegisterUserRequest(t *testing.T) {
 	// nil request
-	err := verifyRegisterUserRequest(nil)
+	err := validateAndVerify(verifyRegisterUserRequest, nil)
 	if err == nil {
 		t.Errorf("(verifyRegisterUserRequest(nil) is supposed to have an error")
 	}
 	// empty request
 	registerUserRequest := &skp.RegisterUserRequest{}
-	err = verifyRegisterUserRequest(registerUserRequest)
+	err = validateAndVerify(verifyRegisterUserRequest, registerUserRequest)
 	if err == nil {
 		t.Errorf("(verifyRegisterUserRequest(%+v) is supposed to have an error", registerUserRequest)
 	}
 	// only user name set
 	registerUserRequest.UserName = "user1"
-	err = verifyRegisterUserRequest(registerUserRequest)
+	err = validateAndVerify(verifyRegisterUserRequest, registerUserRequest)
 	if err == nil {
 		t.Errorf("(verifyRegisterUserRequest(%+v) is supposed to have an error", registerUserRequest)
 	}
 	// good request
 	registerUserRequest.Password = "password1"
-	err = verifyRegisterUserRequest(registerUserRequest)
+	err = validateAndVerify(verifyRegisterUserRequest, registerUserRequest)
 	if err != nil {
 		t.Errorf("(verifyRegisterUserRequest(%+v) had an unexpected error: %s", registerUserRequest, err.Error())
 	}
@@ -80,13 +90,13 @@ func TestVerifyRegisterUserRequest(t *testing.T) {
 
 func TestVerifyReadUserRequest(t *testing.T) {
 	// nil request
-	err := verifyReadUserRequest(nil)
+	err := validateAndVerify(verifyReadUserRequest, nil)
 	if err == nil {
 		t.Errorf("(verifyReadUserRequest(nil) is supposed to have an error")
 	}
 	// good request
 	readUserRequest := &skp.ReadUserRequest{}
-	err = verifyReadUserRequest(readUserRequest)
+	err = validateAndVerify(verifyReadUserRequest, readUserRequest)
 	if err != nil {
 		t.Errorf("(verifyReadUserRequest(%+v) had an unexpected error: %s", readUserRequest, err.Error())
 	}
@@ -94,19 +104,19 @@ func TestVerifyReadUserRequest(t *testing.T) {
 
 func TestVerifyUpdateUserRequest(t *testing.T) {
 	// nil request
-	err := verifyUpdateUserRequest(nil)
+	err := validateAndVerify(verifyUpdateUserRequest, nil)
 	if err == nil {
 		t.Errorf("(verifyUpdateUserRequest(nil) is supposed to have an error")
 	}
 	// empty request
 	updateUserRequest := &skp.UpdateUserRequest{}
-	err = verifyUpdateUserRequest(updateUserRequest)
+	err = validateAndVerify(verifyUpdateUserRequest, updateUserRequest)
 	if err == nil {
 		t.Errorf("(verifyUpdateUserRequest(%+v) is supposed to have an error", updateUserRequest)
 	}
 	// good request
 	updateUserRequest.Password = "password1"
-	err = verifyUpdateUserRequest(updateUserRequest)
+	err = validateAndVerify(verifyUpdateUserRequest, updateUserRequest)
 	if err != nil {
 		t.Errorf("verifyUpdateUserRequest(%+v) had an unexpected error: %s", updateUserRequest, err.Error())
 	}
@@ -114,51 +124,211 @@ func TestVerifyUpdateUserRequest(t *testing.T) {
 
 func TestVerifyDeleteUserRequest(t *testing.T) {
 	// nil request
-	err := verifyDeleteUserRequest(nil)
+	err := validateAndVerify(verifyDeleteUserRequest, nil)
 	if err == nil {
 		t.Errorf("(verifyDeleteUserRequest(nil) is supposed to have an error")
 	}
 	// good request
 	deleteUserRequest := &skp.DeleteUserRequest{}
-	err = verifyDeleteUserRequest(deleteUserRequest)
+	err = validateAndVerify(verifyDeleteUserRequest, deleteUserRequest)
 	if err != nil {
 		t.Errorf("(verifyDeleteUserRequest(%+v) had an unexpected error: %s", deleteUserRequest, err.Error())
 	}
 }
 
+func TestVerifyEnrollTotpRequest(t *testing.T) {
+	// nil request
+	err := validateAndVerify(verifyEnrollTotpRequest, nil)
+	if err == nil {
+		t.Errorf("(verifyEnrollTotpRequest(nil) is supposed to have an error")
+	}
+	// good request
+	enrollTotpRequest := &skp.EnrollTotpRequest{}
+	err = validateAndVerify(verifyEnrollTotpRequest, enrollTotpRequest)
+	if err != nil {
+		t.Errorf("(verifyEnrollTotpRequest(%+v) had an unexpected error: %s", enrollTotpRequest, err.Error())
+	}
+}
+
+func TestVerifyConfirmTotpRequest(t *testing.T) {
+	// nil request
+	err := validateAndVerify(verifyConfirmTotpRequest, nil)
+	if err == nil {
+		t.Errorf("(verifyConfirmTotpRequest(nil) is supposed to have an error")
+	}
+	// empty request
+	confirmTotpRequest := &skp.ConfirmTotpRequest{}
+	err = validateAndVerify(verifyConfirmTotpRequest, confirmTotpRequest)
+	if err == nil {
+		t.Errorf("(verifyConfirmTotpRequest(%+v) is supposed to have an error", confirmTotpRequest)
+	}
+	// good request
+	confirmTotpRequest.Code = "123456"
+	err = validateAndVerify(verifyConfirmTotpRequest, confirmTotpRequest)
+	if err != nil {
+		t.Errorf("(verifyConfirmTotpRequest(%+v) had an unexpected error: %s", confirmTotpRequest, err.Error())
+	}
+}
+
+func TestVerifyDisableTotpRequest(t *testing.T) {
+	// nil request
+	err := validateAndVerify(verifyDisableTotpRequest, nil)
+	if err == nil {
+		t.Errorf("(verifyDisableTotpRequest(nil) is supposed to have an error")
+	}
+	// empty request
+	disableTotpRequest := &skp.DisableTotpRequest{}
+	err = validateAndVerify(verifyDisableTotpRequest, disableTotpRequest)
+	if err == nil {
+		t.Errorf("(verifyDisableTotpRequest(%+v) is supposed to have an error", disableTotpRequest)
+	}
+	// good request
+	disableTotpRequest.Code = "123456"
+	err = validateAndVerify(verifyDisableTotpRequest, disableTotpRequest)
+	if err != nil {
+		t.Errorf("(verifyDisableTotpRequest(%+v) had an unexpected error: %s", disableTotpRequest, err.Error())
+	}
+}
+
+func TestVerifyVerifyTotpRequest(t *testing.T) {
+	// nil request
+	err := validateAndVerify(verifyVerifyTotpRequest, nil)
+	if err == nil {
+		t.Errorf("(verifyVerifyTotpRequest(nil) is supposed to have an error")
+	}
+	// empty request
+	verifyTotpRequest := &skp.VerifyTotpRequest{}
+	err = validateAndVerify(verifyVerifyTotpRequest, verifyTotpRequest)
+	if err == nil {
+		t.Errorf("(verifyVerifyTotpRequest(%+v) is supposed to have an error", verifyTotpRequest)
+	}
+	// only challenge token set
+	verifyTotpRequest.TotpChallengeToken = "token"
+	err = validateAndVerify(verifyVerifyTotpRequest, verifyTotpRequest)
+	if err == nil {
+		t.Errorf("(verifyVerifyTotpRequest(%+v) is supposed to have an error", verifyTotpRequest)
+	}
+	// good request
+	verifyTotpRequest.Code = "123456"
+	err = validateAndVerify(verifyVerifyTotpRequest, verifyTotpRequest)
+	if err != nil {
+		t.Errorf("(verifyVerifyTotpRequest(%+v) had an unexpected error: %s", verifyTotpRequest, err.Error())
+	}
+}
+
+func TestVerifyLoginWithOidcRequest(t *testing.T) {
+	// nil request
+	err := validateAndVerify(verifyLoginWithOidcRequest, nil)
+	if err == nil {
+		t.Errorf("(verifyLoginWithOidcRequest(nil) is supposed to have an error")
+	}
+	// empty request
+	loginWithOidcRequest := &skp.LoginWithOidcRequest{}
+	err = validateAndVerify(verifyLoginWithOidcRequest, loginWithOidcRequest)
+	if err == nil {
+		t.Errorf("(verifyLoginWithOidcRequest(%+v) is supposed to have an error", loginWithOidcRequest)
+	}
+	// only provider set
+	loginWithOidcRequest.Provider = "club"
+	err = validateAndVerify(verifyLoginWithOidcRequest, loginWithOidcRequest)
+	if err == nil {
+		t.Errorf("(verifyLoginWithOidcRequest(%+v) is supposed to have an error", loginWithOidcRequest)
+	}
+	// both authorization code and id token set
+	loginWithOidcRequest.AuthorizationCode = "code"
+	loginWithOidcRequest.IdToken = "token"
+	err = validateAndVerify(verifyLoginWithOidcRequest, loginWithOidcRequest)
+	if err == nil {
+		t.Errorf("(verifyLoginWithOidcRequest(%+v) is supposed to have an error", loginWithOidcRequest)
+	}
+	// good request with id token
+	loginWithOidcRequest.AuthorizationCode = ""
+	err = validateAndVerify(verifyLoginWithOidcRequest, loginWithOidcRequest)
+	if err != nil {
+		t.Errorf("(verifyLoginWithOidcRequest(%+v) had an unexpected error: %s", loginWithOidcRequest, err.Error())
+	}
+	// good request with authorization code
+	loginWithOidcRequest.AuthorizationCode = "code"
+	loginWithOidcRequest.IdToken = ""
+	err = validateAndVerify(verifyLoginWithOidcRequest, loginWithOidcRequest)
+	if err != nil {
+		t.Errorf("(verifyLoginWithOidcRequest(%+v) had an unexpected error: %s", loginWithOidcRequest, err.Error())
+	}
+}
+
+func TestVerifyLinkOidcIdentityRequest(t *testing.T) {
+	// nil request
+	err := validateAndVerify(verifyLinkOidcIdentityRequest, nil)
+	if err == nil {
+		t.Errorf("(verifyLinkOidcIdentityRequest(nil) is supposed to have an error")
+	}
+	// empty request
+	linkOidcIdentityRequest := &skp.LinkOidcIdentityRequest{}
+	err = validateAndVerify(verifyLinkOidcIdentityRequest, linkOidcIdentityRequest)
+	if err == nil {
+		t.Errorf("(verifyLinkOidcIdentityRequest(%+v) is supposed to have an error", linkOidcIdentityRequest)
+	}
+	// only provider set
+	linkOidcIdentityRequest.Provider = "club"
+	err = validateAndVerify(verifyLinkOidcIdentityRequest, linkOidcIdentityRequest)
+	if err == nil {
+		t.Errorf("(verifyLinkOidcIdentityRequest(%+v) is supposed to have an error", linkOidcIdentityRequest)
+	}
+	// both authorization code and id token set
+	linkOidcIdentityRequest.AuthorizationCode = "code"
+	linkOidcIdentityRequest.IdToken = "token"
+	err = validateAndVerify(verifyLinkOidcIdentityRequest, linkOidcIdentityRequest)
+	if err == nil {
+		t.Errorf("(verifyLinkOidcIdentityRequest(%+v) is supposed to have an error", linkOidcIdentityRequest)
+	}
+	// good request with id token
+	linkOidcIdentityRequest.AuthorizationCode = ""
+	err = validateAndVerify(verifyLinkOidcIdentityRequest, linkOidcIdentityRequest)
+	if err != nil {
+		t.Errorf("(verifyLinkOidcIdentityRequest(%+v) had an unexpected error: %s", linkOidcIdentityRequest, err.Error())
+	}
+	// good request with authorization code
+	linkOidcIdentityRequest.AuthorizationCode = "code"
+	linkOidcIdentityRequest.IdToken = ""
+	err = validateAndVerify(verifyLinkOidcIdentityRequest, linkOidcIdentityRequest)
+	if err != nil {
+		t.Errorf("(verifyLinkOidcIdentityRequest(%+v) had an unexpected error: %s", linkOidcIdentityRequest, err.Error())
+	}
+}
+
 func TestVerifyUploadInputImageRequest(t *testing.T) {
 	// nil request
-	err := verifyUploadInputImageRequest(nil)
+	err := validateAndVerify(verifyUploadInputImageRequest, nil)
 	if err == nil {
 		t.Errorf("(verifyUploadInputImageRequest(nil) is supposed to have an error")
 	}
 	// empty request
 	uploadInputImageRequest := &skp.UploadInputImageRequest{}
-	err = verifyUploadInputImageRequest(uploadInputImageRequest)
+	err = validateAndVerify(verifyUploadInputImageRequest, uploadInputImageRequest)
 	if err == nil {
 		t.Errorf("(verifyUploadInputImageRequest(%+v) is supposed to have an error", uploadInputImageRequest)
 	}
 	// only image type set
 	uploadInputImageRequest.ImageType = skp.ImageType_DTL
-	err = verifyUploadInputImageRequest(uploadInputImageRequest)
+	err = validateAndVerify(verifyUploadInputImageRequest, uploadInputImageRequest)
 	if err == nil {
 		t.Errorf("(verifyUploadInputImageRequest(%+v) is supposed to have an error", uploadInputImageRequest)
 	}
 	// zero length image
 	uploadInputImageRequest.Image = []byte{}
-	err = verifyUploadInputImageRequest(uploadInputImageRequest)
+	err = validateAndVerify(verifyUploadInputImageRequest, uploadInputImageRequest)
 	if err == nil {
 		t.Errorf("(verifyUploadInputImageRequest(%+v) is supposed to have an error", uploadInputImageRequest)
 	}
 	// only image and image type set
 	uploadInputImageRequest.Image = []byte{1, 2, 3, 5, 8}
-	err = verifyUploadInputImageRequest(uploadInputImageRequest)
+	err = validateAndVerify(verifyUploadInputImageRequest, uploadInputImageRequest)
 	if err == nil {
 		t.Errorf("(verifyUploadInputImageRequest(%+v) is supposed to have an error", uploadInputImageRequest)
 	}
 	// only image, image type, and description set
 	uploadInputImageRequest.Description = "input image description..."
-	err = verifyUploadInputImageRequest(uploadInputImageRequest)
+	err = validateAndVerify(verifyUploadInputImageRequest, uploadInputImageRequest)
 	if err == nil {
 		t.Errorf("(verifyUploadInputImageRequest(%+v) is supposed to have an error", uploadInputImageRequest)
 	}
@@ -167,13 +337,13 @@ func TestVerifyUploadInputImageRequest(t *testing.T) {
 		Seconds: timestamppb.Now().GetSeconds(),
 		Nanos:   1000000000, // Invalid (must be < 1 billion)
 	}
-	err = verifyUploadInputImageRequest(uploadInputImageRequest)
+	err = validateAndVerify(verifyUploadInputImageRequest, uploadInputImageRequest)
 	if err == nil {
 		t.Errorf("(verifyUploadInputImageRequest(%+v) is supposed to have an error", uploadInputImageRequest)
 	}
 	// good request
 	uploadInputImageRequest.Timestamp = timestamppb.Now()
-	err = verifyUploadInputImageRequest(uploadInputImageRequest)
+	err = validateAndVerify(verifyUploadInputImageRequest, uploadInputImageRequest)
 	if err != nil {
 		t.Errorf("verifyUploadInputImageRequest(%+v) had an unexpected error: %s", uploadInputImageRequest, err.Error())
 	}
@@ -181,13 +351,13 @@ func TestVerifyUploadInputImageRequest(t *testing.T) {
 
 func TestVerifyListInputImagesForUserRequest(t *testing.T) {
 	// nil request
-	err := verifyListInputImagesForUserRequest(nil)
+	err := validateAndVerify(verifyListInputImagesForUserRequest, nil)
 	if err == nil {
 		t.Errorf("(verifyListInputImagesForUserRequest(nil) is supposed to have an error")
 	}
 	// good request
 	listInputImagesForUserRequest := &skp.ListInputImagesForUserRequest{}
-	err = verifyListInputImagesForUserRequest(listInputImagesForUserRequest)
+	err = validateAndVerify(verifyListInputImagesForUserRequest, listInputImagesForUserRequest)
 	if err != nil {
 		t.Errorf("(verifyListInputImagesForUserRequest(%+v) had an unexpected error: %s", listInputImagesForUserRequest, err.Error())
 	}
@@ -195,19 +365,19 @@ func TestVerifyListInputImagesForUserRequest(t *testing.T) {
 
 func TestVerifyReadInputImageRequest(t *testing.T) {
 	// nil request
-	err := verifyReadInputImageRequest(nil)
+	err := validateAndVerify(verifyReadInputImageRequest, nil)
 	if err == nil {
 		t.Errorf("(verifyReadInputImageRequest(nil) is supposed to have an error")
 	}
 	// empty request
 	readInputImageRequest := &skp.ReadInputImageRequest{}
-	err = verifyReadInputImageRequest(readInputImageRequest)
+	err = validateAndVerify(verifyReadInputImageRequest, readInputImageRequest)
 	if err == nil {
 		t.Errorf("(verifyReadInputImageRequest(%+v) is supposed to have an error", readInputImageRequest)
 	}
 	// good request
 	readInputImageRequest.InputImageId = "image1"
-	err = verifyReadInputImageRequest(readInputImageRequest)
+	err = validateAndVerify(verifyReadInputImageRequest, readInputImageRequest)
 	if err != nil {
 		t.Errorf("verifyReadInputImageRequest(%+v) had an unexpected error: %s", readInputImageRequest, err.Error())
 	}
@@ -215,19 +385,19 @@ func TestVerifyReadInputImageRequest(t *testing.T) {
 
 func TestVerifyDeleteInputImageRequest(t *testing.T) {
 	// nil request
-	err := verifyDeleteInputImageRequest(nil)
+	err := validateAndVerify(verifyDeleteInputImageRequest, nil)
 	if err == nil {
 		t.Errorf("(verifyDeleteInputImageRequest(nil) is supposed to have an error")
 	}
 	// empty request
 	deleteInputImageRequest := &skp.DeleteInputImageRequest{}
-	err = verifyDeleteInputImageRequest(deleteInputImageRequest)
+	err = validateAndVerify(verifyDeleteInputImageRequest, deleteInputImageRequest)
 	if err == nil {
 		t.Errorf("(verifyDeleteInputImageRequest(%+v) is supposed to have an error", deleteInputImageRequest)
 	}
 	// good request
 	deleteInputImageRequest.InputImageId = "image1"
-	err = verifyDeleteInputImageRequest(deleteInputImageRequest)
+	err = validateAndVerify(verifyDeleteInputImageRequest, deleteInputImageRequest)
 	if err != nil {
 		t.Errorf("verifyDeleteInputImageRequest(%+v) had an unexpected error: %s", deleteInputImageRequest, err.Error())
 	}
@@ -235,19 +405,19 @@ func TestVerifyDeleteInputImageRequest(t *testing.T) {
 
 func TestVerifyCalibrateInputImageRequest(t *testing.T) {
 	// nil request
-	err := verifyCalibrateInputImageRequest(nil)
+	err := validateAndVerify(verifyCalibrateInputImageRequest, nil)
 	if err == nil {
 		t.Errorf("(verifyCalibrateInputImageRequest(nil) is supposed to have an error")
 	}
 	// empty request
 	calibrateInputImageRequest := &skp.CalibrateInputImageRequest{}
-	err = verifyCalibrateInputImageRequest(calibrateInputImageRequest)
+	err = validateAndVerify(verifyCalibrateInputImageRequest, calibrateInputImageRequest)
 	if err == nil {
 		t.Errorf("(verifyCalibrateInputImageRequest(%+v) is supposed to have an error", calibrateInputImageRequest)
 	}
 	// good request
 	calibrateInputImageRequest.InputImageId = "image1"
-	err = verifyCalibrateInputImageRequest(calibrateInputImageRequest)
+	err = validateAndVerify(verifyCalibrateInputImageRequest, calibrateInputImageRequest)
 	if err != nil {
 		t.Errorf("verifyCalibrateInputImageRequest(%+v) had an unexpected error: %s", calibrateInputImageRequest, err.Error())
 	}
@@ -255,19 +425,19 @@ func TestVerifyCalibrateInputImageRequest(t *testing.T) {
 
 func TestVerifyCalculateGolfKeypointsRequest(t *testing.T) {
 	// nil request
-	err := verifyCalculateGolfKeypointsRequest(nil)
+	err := validateAndVerify(verifyCalculateGolfKeypointsRequest, nil)
 	if err == nil {
 		t.Errorf("(verifyCalculateGolfKeypointsRequest(nil) is supposed to have an error")
 	}
 	// empty request
 	calculateGolfKeypointsRequest := &skp.CalculateGolfKeypointsRequest{}
-	err = verifyCalculateGolfKeypointsRequest(calculateGolfKeypointsRequest)
+	err = validateAndVerify(verifyCalculateGolfKeypointsRequest, calculateGolfKeypointsRequest)
 	if err == nil {
 		t.Errorf("(verifyCalculateGolfKeypointsRequest(%+v) is supposed to have an error", calculateGolfKeypointsRequest)
 	}
 	// good request
 	calculateGolfKeypointsRequest.InputImageId = "image1"
-	err = verifyCalculateGolfKeypointsRequest(calculateGolfKeypointsRequest)
+	err = validateAndVerify(verifyCalculateGolfKeypointsRequest, calculateGolfKeypointsRequest)
 	if err != nil {
 		t.Errorf("verifyCalculateGolfKeypoints(%+v) had an unexpected error: %s", calculateGolfKeypointsRequest, err.Error())
 	}
@@ -275,19 +445,19 @@ func TestVerifyCalculateGolfKeypointsRequest(t *testing.T) {
 
 func TestVerifyReadGolfKeypointsRequest(t *testing.T) {
 	// nil request
-	err := verifyReadGolfKeypointsRequest(nil)
+	err := validateAndVerify(verifyReadGolfKeypointsRequest, nil)
 	if err == nil {
 		t.Errorf("(verifyReadGolfKeypointsRequest(nil) is supposed to have an error")
 	}
 	// empty request
 	readGolfKeypointsRequest := &skp.ReadGolfKeypointsRequest{}
-	err = verifyReadGolfKeypointsRequest(readGolfKeypointsRequest)
+	err = validateAndVerify(verifyReadGolfKeypointsRequest, readGolfKeypointsRequest)
 	if err == nil {
 		t.Errorf("(verifyReadGolfKeypointsRequest(%+v) is supposed to have an error", readGolfKeypointsRequest)
 	}
 	// good request
 	readGolfKeypointsRequest.InputImageId = "image1"
-	err = verifyReadGolfKeypointsRequest(readGolfKeypointsRequest)
+	err = validateAndVerify(verifyReadGolfKeypointsRequest, readGolfKeypointsRequest)
 	if err != nil {
 		t.Errorf("verifyReadGolfKeypoints(%+v) had an unexpected error: %s", readGolfKeypointsRequest, err.Error())
 	}
@@ -295,19 +465,19 @@ func TestVerifyReadGolfKeypointsRequest(t *testing.T) {
 
 func TestVerifyUpdateBodyKeypointsRequest(t *testing.T) {
 	// nil request
-	err := verifyUpdateBodyKeypointsRequest(nil)
+	err := validateAndVerify(verifyUpdateBodyKeypointsRequest, nil)
 	if err == nil {
 		t.Errorf("(verifyUpdateBodyKeypointsRequest(nil) is supposed to have an error")
 	}
 	// empty request
 	updateBodyKeypointsRequest := &skp.UpdateBodyKeypointsRequest{}
-	err = verifyUpdateBodyKeypointsRequest(updateBodyKeypointsRequest)
+	err = validateAndVerify(verifyUpdateBodyKeypointsRequest, updateBodyKeypointsRequest)
 	if err == nil {
 		t.Errorf("(verifyUpdateBodyKeypointsRequest(%+v) is supposed to have an error", updateBodyKeypointsRequest)
 	}
 	// only input image set
 	updateBodyKeypointsRequest.InputImageId = "image1"
-	err = verifyUpdateBodyKeypointsRequest(updateBodyKeypointsRequest)
+	err = validateAndVerify(verifyUpdateBodyKeypointsRequest, updateBodyKeypointsRequest)
 	if err == nil {
 		t.Errorf("(verifyUpdateBodyKeypointsRequest(%+v) is supposed to have an error", updateBodyKeypointsRequest)
 	}
@@ -319,28 +489,270 @@ func TestVerifyUpdateBodyKeypointsRequest(t *testing.T) {
 			Confidence: 1.0,
 		},
 	}
-	err = verifyUpdateBodyKeypointsRequest(updateBodyKeypointsRequest)
+	err = validateAndVerify(verifyUpdateBodyKeypointsRequest, updateBodyKeypointsRequest)
 	if err != nil {
 		t.Errorf("verifyUpdateBodyKeypoints(%+v) had an unexpected error: %s", updateBodyKeypointsRequest, err.Error())
 	}
 }
 
+func TestVerifyImportGolfKeypointsRequest(t *testing.T) {
+	// nil request
+	err := validateAndVerify(verifyImportGolfKeypointsRequest, nil)
+	if err == nil {
+		t.Errorf("(verifyImportGolfKeypointsRequest(nil) is supposed to have an error")
+	}
+	// only input image set
+	importGolfKeypointsRequest := &skp.ImportGolfKeypointsRequest{InputImageId: "image1"}
+	err = validateAndVerify(verifyImportGolfKeypointsRequest, importGolfKeypointsRequest)
+	if err == nil {
+		t.Errorf("(verifyImportGolfKeypointsRequest(%+v) is supposed to have an error", importGolfKeypointsRequest)
+	}
+	// openpose json
+	importGolfKeypointsRequest.Keypoints = &skp.ImportGolfKeypointsRequest_OpenposeJson{OpenposeJson: []byte(`{"people": []}`)}
+	err = validateAndVerify(verifyImportGolfKeypointsRequest, importGolfKeypointsRequest)
+	if err != nil {
+		t.Errorf("verifyImportGolfKeypoints(%+v) had an unexpected error: %s", importGolfKeypointsRequest, err.Error())
+	}
+	// body keypoints
+	importGolfKeypointsRequest.Keypoints = &skp.ImportGolfKeypointsRequest_BodyKeypoints{BodyKeypoints: &skp.Body25PoseKeypoints{}}
+	err = validateAndVerify(verifyImportGolfKeypointsRequest, importGolfKeypointsRequest)
+	if err != nil {
+		t.Errorf("verifyImportGolfKeypoints(%+v) had an unexpected error: %s", importGolfKeypointsRequest, err.Error())
+	}
+}
+
 func TestVerifyDeleteGolfKeypointsRequest(t *testing.T) {
 	// nil request
-	err := verifyDeleteGolfKeypointsRequest(nil)
+	err := validateAndVerify(verifyDeleteGolfKeypointsRequest, nil)
 	if err == nil {
 		t.Errorf("(verifyDeleteGolfKeypointsRequest(nil) is supposed to have an error")
 	}
 	// empty request
 	deleteGolfKeypointsRequest := &skp.DeleteGolfKeypointsRequest{}
-	err = verifyDeleteGolfKeypointsRequest(deleteGolfKeypointsRequest)
+	err = validateAndVerify(verifyDeleteGolfKeypointsRequest, deleteGolfKeypointsRequest)
 	if err == nil {
 		t.Errorf("(verifyDeleteGolfKeypointsRequest(%+v) is supposed to have an error", deleteGolfKeypointsRequest)
 	}
 	// good request
 	deleteGolfKeypointsRequest.InputImageId = "image1"
-	err = verifyDeleteGolfKeypointsRequest(deleteGolfKeypointsRequest)
+	err = validateAndVerify(verifyDeleteGolfKeypointsRequest, deleteGolfKeypointsRequest)
 	if err != nil {
 		t.Errorf("verifyDeleteGolfKeypoints(%+v) had an unexpected error: %s", deleteGolfKeypointsRequest, err.Error())
 	}
 }
+
+func TestVerifySubmitAnalysisRequest(t *testing.T) {
+	// nil request
+	err := validateAndVerify(verifySubmitAnalysisRequest, nil)
+	if err == nil {
+		t.Errorf("(verifySubmitAnalysisRequest(nil) is supposed to have an error")
+	}
+	// empty request
+	submitAnalysisRequest := &skp.SubmitAnalysisRequest{}
+	err = validateAndVerify(verifySubmitAnalysisRequest, submitAnalysisRequest)
+	if err == nil {
+		t.Errorf("(verifySubmitAnalysisRequest(%+v) is supposed to have an error", submitAnalysisRequest)
+	}
+	// good request
+	submitAnalysisRequest.InputImageId = "image1"
+	err = validateAndVerify(verifySubmitAnalysisRequest, submitAnalysisRequest)
+	if err != nil {
+		t.Errorf("verifySubmitAnalysisRequest(%+v) had an unexpected error: %s", submitAnalysisRequest, err.Error())
+	}
+}
+
+func TestVerifyGetAnalysisJobRequest(t *testing.T) {
+	// nil request
+	err := validateAndVerify(verifyGetAnalysisJobRequest, nil)
+	if err == nil {
+		t.Errorf("(verifyGetAnalysisJobRequest(nil) is supposed to have an error")
+	}
+	// empty request
+	getAnalysisJobRequest := &skp.GetAnalysisJobRequest{}
+	err = validateAndVerify(verifyGetAnalysisJobRequest, getAnalysisJobRequest)
+	if err == nil {
+		t.Errorf("(verifyGetAnalysisJobRequest(%+v) is supposed to have an error", getAnalysisJobRequest)
+	}
+	// good request
+	getAnalysisJobRequest.JobId = "job1"
+	err = validateAndVerify(verifyGetAnalysisJobRequest, getAnalysisJobRequest)
+	if err != nil {
+		t.Errorf("verifyGetAnalysisJobRequest(%+v) had an unexpected error: %s", getAnalysisJobRequest, err.Error())
+	}
+}
+
+func TestVerifyCreateOrganizationRequest(t *testing.T) {
+	// nil request
+	err := validateAndVerify(verifyCreateOrganizationRequest, nil)
+	if err == nil {
+		t.Errorf("(verifyCreateOrganizationRequest(nil) is supposed to have an error")
+	}
+	// empty request
+	createOrganizationRequest := &skp.CreateOrganizationRequest{}
+	err = validateAndVerify(verifyCreateOrganizationRequest, createOrganizationRequest)
+	if err == nil {
+		t.Errorf("(verifyCreateOrganizationRequest(%+v) is supposed to have an error", createOrganizationRequest)
+	}
+	// good request
+	createOrganizationRequest.Name = "academy1"
+	err = validateAndVerify(verifyCreateOrganizationRequest, createOrganizationRequest)
+	if err != nil {
+		t.Errorf("verifyCreateOrganizationRequest(%+v) had an unexpected error: %s", createOrganizationRequest, err.Error())
+	}
+}
+
+func TestVerifyInviteOrganizationMemberRequest(t *testing.T) {
+	// nil request
+	err := validateAndVerify(verifyInviteOrganizationMemberRequest, nil)
+	if err == nil {
+		t.Errorf("(verifyInviteOrganizationMemberRequest(nil) is supposed to have an error")
+	}
+	// empty request
+	inviteOrganizationMemberRequest := &skp.InviteOrganizationMemberRequest{}
+	err = validateAndVerify(verifyInviteOrganizationMemberRequest, inviteOrganizationMemberRequest)
+	if err == nil {
+		t.Errorf("(verifyInviteOrganizationMemberRequest(%+v) is supposed to have an error", inviteOrganizationMemberRequest)
+	}
+	// invalid role
+	inviteOrganizationMemberRequest.UserName = "user1"
+	inviteOrganizationMemberRequest.Role = skp.OrganizationRole(10)
+	err = validateAndVerify(verifyInviteOrganizationMemberRequest, inviteOrganizationMemberRequest)
+	if err == nil {
+		t.Errorf("(verifyInviteOrganizationMemberRequest(%+v) is supposed to have an error", inviteOrganizationMemberRequest)
+	}
+	// good request, role defaults to member
+	inviteOrganizationMemberRequest.Role = skp.OrganizationRole_ORGANIZATION_ROLE_UNSPECIFIED
+	err = validateAndVerify(verifyInviteOrganizationMemberRequest, inviteOrganizationMemberRequest)
+	if err != nil {
+		t.Errorf("verifyInviteOrganizationMemberRequest(%+v) had an unexpected error: %s", inviteOrganizationMemberRequest, err.Error())
+	}
+}
+
+func TestVerifyAcceptOrganizationInvitationRequest(t *testing.T) {
+	// nil request
+	err := validateAndVerify(verifyAcceptOrganizationInvitationRequest, nil)
+	if err == nil {
+		t.Errorf("(verifyAcceptOrganizationInvitationRequest(nil) is supposed to have an error")
+	}
+	// no organization id
+	acceptOrganizationInvitationRequest := &skp.AcceptOrganizationInvitationRequest{}
+	err = validateAndVerify(verifyAcceptOrganizationInvitationRequest, acceptOrganizationInvitationRequest)
+	if err == nil {
+		t.Errorf("(verifyAcceptOrganizationInvitationRequest(%+v) is supposed to have an error", acceptOrganizationInvitationRequest)
+	}
+	// good request
+	acceptOrganizationInvitationRequest.OrganizationId = "org1"
+	err = validateAndVerify(verifyAcceptOrganizationInvitationRequest, acceptOrganizationInvitationRequest)
+	if err != nil {
+		t.Errorf("verifyAcceptOrganizationInvitationRequest(%+v) had an unexpected error: %s", acceptOrganizationInvitationRequest, err.Error())
+	}
+}
+
+func TestVerifyUpdateOrganizationMemberRoleRequest(t *testing.T) {
+	// nil request
+	err := validateAndVerify(verifyUpdateOrganizationMemberRoleRequest, nil)
+	if err == nil {
+		t.Errorf("(verifyUpdateOrganizationMemberRoleRequest(nil) is supposed to have an error")
+	}
+	// no role
+	updateOrganizationMemberRoleRequest := &skp.UpdateOrganizationMemberRoleRequest{UserName: "user1"}
+	err = validateAndVerify(verifyUpdateOrganizationMemberRoleRequest, updateOrganizationMemberRoleRequest)
+	if err == nil {
+		t.Errorf("(verifyUpdateOrganizationMemberRoleRequest(%+v) is supposed to have an error", updateOrganizationMemberRoleRequest)
+	}
+	// good request
+	updateOrganizationMemberRoleRequest.Role = skp.OrganizationRole_ORGANIZATION_ADMIN
+	err = validateAndVerify(verifyUpdateOrganizationMemberRoleRequest, updateOrganizationMemberRoleRequest)
+	if err != nil {
+		t.Errorf("verifyUpdateOrganizationMemberRoleRequest(%+v) had an unexpected error: %s", updateOrganizationMemberRoleRequest, err.Error())
+	}
+}
+
+func TestVerifyListUsersRequest(t *testing.T) {
+	// nil request
+	err := validateAndVerify(verifyListUsersRequest, nil)
+	if err == nil {
+		t.Errorf("(verifyListUsersRequest(nil) is supposed to have an error")
+	}
+	// page size too large
+	listUsersRequest := &skp.ListUsersRequest{PageSize: 501}
+	err = validateAndVerify(verifyListUsersRequest, listUsersRequest)
+	if err == nil {
+		t.Errorf("(verifyListUsersRequest(%+v) is supposed to have an error", listUsersRequest)
+	}
+	// negative page size
+	listUsersRequest.PageSize = -1
+	err = validateAndVerify(verifyListUsersRequest, listUsersRequest)
+	if err == nil {
+		t.Errorf("(verifyListUsersRequest(%+v) is supposed to have an error", listUsersRequest)
+	}
+	// good request, page size defaults
+	listUsersRequest.PageSize = 0
+	err = validateAndVerify(verifyListUsersRequest, listUsersRequest)
+	if err != nil {
+		t.Errorf("verifyListUsersRequest(%+v) had an unexpected error: %s", listUsersRequest, err.Error())
+	}
+}
+
+func TestVerifySetUserRoleRequest(t *testing.T) {
+	// nil request
+	err := validateAndVerify(verifySetUserRoleRequest, nil)
+	if err == nil {
+		t.Errorf("(verifySetUserRoleRequest(nil) is supposed to have an error")
+	}
+	// no username
+	setUserRoleRequest := &skp.SetUserRoleRequest{Role: skp.UserRole_USER_ROLE_ADMIN}
+	err = validateAndVerify(verifySetUserRoleRequest, setUserRoleRequest)
+	if err == nil {
+		t.Errorf("(verifySetUserRoleRequest(%+v) is supposed to have an error", setUserRoleRequest)
+	}
+	// unknown role
+	setUserRoleRequest = &skp.SetUserRoleRequest{UserName: "user1", Role: skp.UserRole(7)}
+	err = validateAndVerify(verifySetUserRoleRequest, setUserRoleRequest)
+	if err == nil {
+		t.Errorf("(verifySetUserRoleRequest(%+v) is supposed to have an error", setUserRoleRequest)
+	}
+	// good request, unspecified role removes the admin role
+	setUserRoleRequest.Role = skp.UserRole_USER_ROLE_UNSPECIFIED
+	err = validateAndVerify(verifySetUserRoleRequest, setUserRoleRequest)
+	if err != nil {
+		t.Errorf("verifySetUserRoleRequest(%+v) had an unexpected error: %s", setUserRoleRequest, err.Error())
+	}
+}
+
+func TestVerifyRunMaintenanceJobRequest(t *testing.T) {
+	// nil request
+	err := validateAndVerify(verifyRunMaintenanceJobRequest, nil)
+	if err == nil {
+		t.Errorf("(verifyRunMaintenanceJobRequest(nil) is supposed to have an error")
+	}
+	// no job
+	runMaintenanceJobRequest := &skp.RunMaintenanceJobRequest{DryRun: true}
+	err = validateAndVerify(verifyRunMaintenanceJobRequest, runMaintenanceJobRequest)
+	if err == nil {
+		t.Errorf("(verifyRunMaintenanceJobRequest(%+v) is supposed to have an error", runMaintenanceJobRequest)
+	}
+	// good request
+	runMaintenanceJobRequest.Job = skp.MaintenanceJob_ORPHAN_CLEANUP
+	err = validateAndVerify(verifyRunMaintenanceJobRequest, runMaintenanceJobRequest)
+	if err != nil {
+		t.Errorf("verifyRunMaintenanceJobRequest(%+v) had an unexpected error: %s", runMaintenanceJobRequest, err.Error())
+	}
+}
+
+func TestVerifyFieldViolations(t *testing.T) {
+	// missing fields are reported as InvalidArgument with the proto field name
+	err := validateAndVerify(verifyCreateUserRequest, &skp.CreateUserRequest{UserName: "user1"})
+	if code := apierror.Code(err); code != codes.InvalidArgument {
+		t.Errorf("verifyCreateUserRequest code is %s, expected %s", code, codes.InvalidArgument)
+	}
+	violations := apierror.FieldViolations(err)
+	if len(violations) != 2 || violations[0].Field != "password" || violations[1].Field != "email" {
+		t.Errorf("verifyCreateUserRequest field violations are %v, expected password and email", violations)
+	}
+	err = validateAndVerify(verifyReadInputImageRequest, &skp.ReadInputImageRequest{})
+	violations = apierror.FieldViolations(err)
+	if len(violations) != 1 || violations[0].Field != "input_image_id" {
+		t.Errorf("verifyReadInputImageRequest field violations are %v, expected input_image_id", violations)
+	}
+}
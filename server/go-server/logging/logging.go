@@ -0,0 +1,193 @@
+// Structured, leveled logging for the go-server built on log/slog
+// Every package gets its own logger so levels can be set per package, request scoped attributes (request id, user id, trace id)
+// are carried in the context and added to every line logged with a *Context method, and sensitive attributes are redacted
+package logging
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/sirfrank96/go-server/config"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+const redacted = "[REDACTED]"
+
+// Attribute keys that are never logged with their value
+var redactedKeys = map[string]bool{
+	"password":           true,
+	"password_hash":      true,
+	"session_token":      true,
+	"token":              true,
+	"id_token":           true,
+	"authorization_code": true,
+	"code_verifier":      true,
+	"client_secret":      true,
+	"secret":             true,
+	"totp_secret":        true,
+	"totp_code":          true,
+	"recovery_codes":     true,
+}
+
+type handlerConfig struct {
+	mutex        sync.RWMutex
+	handler      slog.Handler
+	defaultLevel slog.Level
+	levels       map[string]slog.Level
+}
+
+// Until Configure is called, logs info and above as json to stderr
+var cfg = &handlerConfig{
+	handler:      newBaseHandler(os.Stderr, "json"),
+	defaultLevel: slog.LevelInfo,
+	levels:       map[string]slog.Level{},
+}
+
+// Installs the configured handler and routes the standard log package through it
+func Configure(loggingConfig config.LoggingConfig) error {
+	if loggingConfig.Format != "json" && loggingConfig.Format != "text" {
+		return fmt.Errorf("unknown log format %s, expected json or text", loggingConfig.Format)
+	}
+	defaultLevel, err := parseLevel(loggingConfig.Level)
+	if err != nil {
+		return err
+	}
+	levels, err := parseLevels(loggingConfig.Levels)
+	if err != nil {
+		return err
+	}
+	cfg.mutex.Lock()
+	cfg.handler = newBaseHandler(os.Stderr, loggingConfig.Format)
+	cfg.defaultLevel = defaultLevel
+	cfg.levels = levels
+	cfg.mutex.Unlock()
+	slog.SetDefault(Logger("main"))
+	return nil
+}
+
+// Logger for a package, the package name is added to every line and used to look up the package's log level
+func Logger(pkg string) *slog.Logger {
+	return slog.New(&packageHandler{pkg: pkg})
+}
+
+type contextKey struct{}
+
+// Returns a context whose log lines carry the given attributes (key value pairs like slog.Logger.With)
+func WithAttrs(ctx context.Context, args ...any) context.Context {
+	attrs := append([]slog.Attr{}, getContextAttrs(ctx)...)
+	record := slog.Record{}
+	record.Add(args...)
+	record.Attrs(func(attr slog.Attr) bool {
+		attrs = append(attrs, attr)
+		return true
+	})
+	return context.WithValue(ctx, contextKey{}, attrs)
+}
+
+func WithRequestId(ctx context.Context, requestId string) context.Context {
+	return WithAttrs(ctx, "request_id", requestId)
+}
+
+func getContextAttrs(ctx context.Context) []slog.Attr {
+	if ctx == nil {
+		return nil
+	}
+	attrs, _ := ctx.Value(contextKey{}).([]slog.Attr)
+	return attrs
+}
+
+func newBaseHandler(w io.Writer, format string) slog.Handler {
+	// levels are checked by packageHandler, the base handler lets everything through
+	opts := &slog.HandlerOptions{Level: slog.LevelDebug, ReplaceAttr: redactAttr}
+	if format == "text" {
+		return slog.NewTextHandler(w, opts)
+	}
+	return slog.NewJSONHandler(w, opts)
+}
+
+func redactAttr(groups []string, attr slog.Attr) slog.Attr {
+	if redactedKeys[strings.ToLower(attr.Key)] {
+		return slog.String(attr.Key, redacted)
+	}
+	return attr
+}
+
+func parseLevel(level string) (slog.Level, error) {
+	var l slog.Level
+	if err := l.UnmarshalText([]byte(level)); err != nil {
+		return l, fmt.Errorf("invalid log level %s: %w", level, err)
+	}
+	return l, nil
+}
+
+func parseLevels(levels string) (map[string]slog.Level, error) {
+	res := map[string]slog.Level{}
+	if levels == "" {
+		return res, nil
+	}
+	for _, pkgLevel := range strings.Split(levels, ",") {
+		pkg, level, ok := strings.Cut(strings.TrimSpace(pkgLevel), "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid package log level %s, expected package=level", pkgLevel)
+		}
+		l, err := parseLevel(level)
+		if err != nil {
+			return nil, err
+		}
+		res[pkg] = l
+	}
+	return res, nil
+}
+
+// Looks up the handler and level at log time since package loggers are created before Configure is called
+type packageHandler struct {
+	pkg string
+	// WithAttrs and WithGroup calls, replayed onto the configured handler
+	wrap []func(slog.Handler) slog.Handler
+}
+
+func (h *packageHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	cfg.mutex.RLock()
+	defer cfg.mutex.RUnlock()
+	minLevel, ok := cfg.levels[h.pkg]
+	if !ok {
+		minLevel = cfg.defaultLevel
+	}
+	return level >= minLevel
+}
+
+func (h *packageHandler) Handle(ctx context.Context, record slog.Record) error {
+	cfg.mutex.RLock()
+	handler := cfg.handler
+	cfg.mutex.RUnlock()
+	handler = handler.WithAttrs([]slog.Attr{slog.String("package", h.pkg)})
+	for _, wrap := range h.wrap {
+		handler = wrap(handler)
+	}
+	record.AddAttrs(getContextAttrs(ctx)...)
+	if spanContext := trace.SpanContextFromContext(ctx); spanContext.IsValid() {
+		record.AddAttrs(slog.String("trace_id", spanContext.TraceID().String()))
+	}
+	return handler.Handle(ctx, record)
+}
+
+func (h *packageHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return h.with(func(handler slog.Handler) slog.Handler { return handler.WithAttrs(attrs) })
+}
+
+func (h *packageHandler) WithGroup(name string) slog.Handler {
+	return h.with(func(handler slog.Handler) slog.Handler { return handler.WithGroup(name) })
+}
+
+func (h *packageHandler) with(wrap func(slog.Handler) slog.Handler) slog.Handler {
+	return &packageHandler{
+		pkg:  h.pkg,
+		wrap: append(append([]func(slog.Handler) slog.Handler{}, h.wrap...), wrap),
+	}
+}
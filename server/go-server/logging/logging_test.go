@@ -0,0 +1,89 @@
+package logging
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+// Points the package loggers at a buffer, returns a func that decodes the logged json lines
+func setTestConfig(t *testing.T, defaultLevel slog.Level, levels map[string]slog.Level) func() []map[string]interface{} {
+	buffer := &bytes.Buffer{}
+	cfg.mutex.Lock()
+	cfg.handler = newBaseHandler(buffer, "json")
+	cfg.defaultLevel = defaultLevel
+	cfg.levels = levels
+	cfg.mutex.Unlock()
+	return func() []map[string]interface{} {
+		var lines []map[string]interface{}
+		for _, line := range strings.Split(strings.TrimSpace(buffer.String()), "\n") {
+			if line == "" {
+				continue
+			}
+			var decoded map[string]interface{}
+			if err := json.Unmarshal([]byte(line), &decoded); err != nil {
+				t.Fatalf("could not decode log line %s: %s", line, err.Error())
+			}
+			lines = append(lines, decoded)
+		}
+		return lines
+	}
+}
+
+func TestRequestAttrsAndRedaction(t *testing.T) {
+	getLines := setTestConfig(t, slog.LevelInfo, map[string]slog.Level{})
+	logger := Logger("db")
+	ctx := WithRequestId(context.Background(), "req-1")
+	ctx = WithAttrs(ctx, "user_id", "user-1")
+	logger.InfoContext(ctx, "read user", "username", "user_1", "password_hash", "$2a$10$abc", slog.Group("totp", "secret", "JBSWY3DP"))
+	lines := getLines()
+	if len(lines) != 1 {
+		t.Fatalf("expected 1 log line, got %d", len(lines))
+	}
+	line := lines[0]
+	if line["request_id"] != "req-1" || line["user_id"] != "user-1" || line["package"] != "db" || line["username"] != "user_1" {
+		t.Errorf("log line is missing attributes: %+v", line)
+	}
+	if line["password_hash"] != redacted {
+		t.Errorf("password_hash was not redacted: %+v", line)
+	}
+	if totp, ok := line["totp"].(map[string]interface{}); !ok || totp["secret"] != redacted {
+		t.Errorf("nested totp secret was not redacted: %+v", line)
+	}
+}
+
+func TestPackageLevels(t *testing.T) {
+	getLines := setTestConfig(t, slog.LevelInfo, map[string]slog.Level{"db": slog.LevelDebug, "cv-client": slog.LevelWarn})
+	Logger("db").Debug("db debug")
+	Logger("cv-client").Info("cv info")
+	Logger("cv-client").Warn("cv warn")
+	Logger("controller").Debug("controller debug")
+	Logger("controller").Info("controller info")
+	var messages []string
+	for _, line := range getLines() {
+		messages = append(messages, line["msg"].(string))
+	}
+	expected := "db debug,cv warn,controller info"
+	if strings.Join(messages, ",") != expected {
+		t.Errorf("logged %v, expected %s", messages, expected)
+	}
+}
+
+func TestParseLevels(t *testing.T) {
+	levels, err := parseLevels("db=debug, cv-client=warn")
+	if err != nil {
+		t.Fatalf("parseLevels had an unexpected error: %s", err.Error())
+	}
+	if levels["db"] != slog.LevelDebug || levels["cv-client"] != slog.LevelWarn {
+		t.Errorf("parseLevels returned %+v", levels)
+	}
+	if _, err := parseLevels("db"); err == nil {
+		t.Errorf("parseLevels(db) is supposed to have an error")
+	}
+	if _, err := parseLevels("db=loud"); err == nil {
+		t.Errorf("parseLevels(db=loud) is supposed to have an error")
+	}
+}
@@ -2,30 +2,53 @@ package main
 
 import (
 	"context"
+	"flag"
 	"fmt"
-	"log"
 	"os"
 	"os/signal"
 	"syscall"
 
+	"github.com/sirfrank96/go-server/config"
 	"github.com/sirfrank96/go-server/controller"
+	"github.com/sirfrank96/go-server/logging"
 )
 
+var configPath = flag.String("config", os.Getenv("GO_SERVER_CONFIG"), "Path to the yaml config file, defaults to GO_SERVER_CONFIG. Settings not in the file use their defaults or GO_SERVER_* environment variables")
+
+var logger = logging.Logger("main")
+
+// Clients are started before the keypoints server so requests never see nil clients, the server is not ready until the health probes pass
 func startServices(ctx context.Context, controller *controller.Controller) error {
-	go func() {
-		if err := controller.StartKeypointsServer(); err != nil {
-			log.Fatalf("Could not start keypoints server %w", err)
-		}
-	}()
-	log.Printf("Started Golf Keypoints Server")
+	// tracing first so the grpc server and clients pick up the tracer provider and propagator
+	if err := controller.StartTracing(ctx); err != nil {
+		return fmt.Errorf("could not start tracing: %w", err)
+	}
+	logger.InfoContext(ctx, "started tracing")
 	if err := controller.StartDatabaseClient(ctx); err != nil {
 		return fmt.Errorf("could not start database: %w", err)
 	}
-	log.Printf("Started Database Client")
+	logger.InfoContext(ctx, "started database client")
 	if err := controller.StartCvClient(); err != nil {
 		return fmt.Errorf("could not start cvclient %w", err)
 	}
-	log.Printf("Started CV client")
+	logger.InfoContext(ctx, "started cv client")
+	controller.StartAnalysisWorkers()
+	logger.InfoContext(ctx, "started analysis workers")
+	if err := controller.StartOidcManager(); err != nil {
+		return fmt.Errorf("could not start oidc manager %w", err)
+	}
+	logger.InfoContext(ctx, "started oidc manager")
+	if err := controller.StartMetricsServer(); err != nil {
+		return fmt.Errorf("could not start metrics server %w", err)
+	}
+	logger.InfoContext(ctx, "started metrics server")
+	go func() {
+		if err := controller.StartKeypointsServer(); err != nil {
+			logger.ErrorContext(ctx, "could not start keypoints server", "error", err)
+			os.Exit(1)
+		}
+	}()
+	logger.InfoContext(ctx, "started golf keypoints server")
 	return nil
 }
 
@@ -33,35 +56,58 @@ func stopServices(ctx context.Context, controller *controller.Controller) error
 	if err := controller.StopKeypointsServer(); err != nil {
 		return fmt.Errorf("could not stop keypoints server %w", err)
 	}
-	log.Printf("Stopped Golf Keypoints Server")
+	logger.InfoContext(ctx, "stopped golf keypoints server")
+	// running jobs are queued again before the database client is closed
+	controller.StopAnalysisWorkers()
+	logger.InfoContext(ctx, "stopped analysis workers")
 	if err := controller.CloseDatabaseClient(ctx); err != nil {
 		return fmt.Errorf("could not stop database client %w", err)
 	}
-	log.Printf("Stopped Database Client")
+	logger.InfoContext(ctx, "stopped database client")
 	if err := controller.CloseCvClient(); err != nil {
 		return fmt.Errorf("could not close cvclient %w", err)
 	}
-	log.Printf("Closed Cv client")
+	logger.InfoContext(ctx, "closed cv client")
+	if err := controller.StopMetricsServer(ctx); err != nil {
+		return fmt.Errorf("could not stop metrics server %w", err)
+	}
+	logger.InfoContext(ctx, "stopped metrics server")
+	if err := controller.StopTracing(ctx); err != nil {
+		return fmt.Errorf("could not stop tracing %w", err)
+	}
+	logger.InfoContext(ctx, "stopped tracing")
 	return nil
 }
 
 func main() {
 	ctx := context.Background()
-	controller := controller.NewController()
-	log.Printf("Starting services")
-	err := startServices(ctx, controller)
+	flag.Parse()
+	cfg, err := config.Load(*configPath)
+	if err != nil {
+		logger.ErrorContext(ctx, "could not load config", "error", err)
+		os.Exit(1)
+	}
+	// logging first so every manager logs with the configured format and levels
+	if err := logging.Configure(cfg.Logging); err != nil {
+		logger.ErrorContext(ctx, "could not configure logging", "error", err)
+		os.Exit(1)
+	}
+	controller := controller.NewController(cfg)
+	logger.InfoContext(ctx, "starting services")
+	err = startServices(ctx, controller)
 	if err != nil {
-		log.Fatalf("Could not start services: %w", err)
+		logger.ErrorContext(ctx, "could not start services", "error", err)
+		os.Exit(1)
 	}
 	// Set up a channel to listen for OS signals
 	stopChan := make(chan os.Signal, 1)
 	signal.Notify(stopChan, syscall.SIGINT, syscall.SIGTERM)
-	log.Printf("Waiting for computer vision golf requests at port 50052")
-	log.Printf("Waiting for sigint to stop services...")
+	logger.InfoContext(ctx, "waiting for sigint to stop services")
 	<-stopChan
-	log.Printf("Stopping services")
+	logger.InfoContext(ctx, "stopping services")
 	err = stopServices(ctx, controller)
 	if err != nil {
-		log.Fatalf("Could not stop services: %w", err)
+		logger.ErrorContext(ctx, "could not stop services", "error", err)
+		os.Exit(1)
 	}
 }
@@ -0,0 +1,190 @@
+// Prometheus metrics for the keypoints server rpcs, computervision calls, database operations and golf metric warnings
+// Served over http at /metrics on a separate port from the grpc server
+package metrics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/sirfrank96/go-server/config"
+	"github.com/sirfrank96/go-server/logging"
+	"github.com/sirfrank96/go-server/util"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+var (
+	rpcRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "keypoints_server_rpc_requests_total",
+		Help: "Number of rpcs handled by the keypoints server by method and grpc status code.",
+	}, []string{"method", "code"})
+	rpcLatency = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "keypoints_server_rpc_duration_seconds",
+		Help:    "Latency of rpcs handled by the keypoints server by method.",
+		Buckets: []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60},
+	}, []string{"method"})
+	rpcRateLimited = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "keypoints_server_rate_limited_total",
+		Help: "Number of rpcs rejected by the keypoints server rate limiter by method.",
+	}, []string{"method"})
+	rpcIdempotentReplays = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "keypoints_server_idempotent_replays_total",
+		Help: "Number of rpcs answered with the stored response of an earlier request with the same idempotency key by method.",
+	}, []string{"method"})
+	cvCallLatency = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "computervision_call_duration_seconds",
+		Help:    "Latency of calls to the computervision service by method.",
+		Buckets: []float64{0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60, 100},
+	}, []string{"method"})
+	cvCallFailures = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "computervision_call_failures_total",
+		Help: "Number of failed calls to the computervision service by method.",
+	}, []string{"method"})
+	cvCallRetries = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "computervision_call_retries_total",
+		Help: "Number of computervision calls sent again after a transient failure by method.",
+	}, []string{"method"})
+	cvCircuitBreakerOpen = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "computervision_circuit_breaker_open",
+		Help: "1 while the computervision circuit breaker is open and calls fail fast, 0 otherwise.",
+	})
+	cvCacheRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "computervision_cache_requests_total",
+		Help: "Number of pose result cache lookups by method and result (memory_hit, database_hit or miss).",
+	}, []string{"method", "result"})
+	cvBackendOutstanding = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "computervision_backend_outstanding_requests",
+		Help: "Number of calls sent to a computervision backend that have not finished by backend address.",
+	}, []string{"backend"})
+	cvBackendEjections = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "computervision_backend_ejections_total",
+		Help: "Number of times a computervision backend was ejected after failing calls in a row by backend address.",
+	}, []string{"backend"})
+	dbOperationLatency = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "database_operation_duration_seconds",
+		Help:    "Latency of database operations by operation.",
+		Buckets: []float64{0.001, 0.0025, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5},
+	}, []string{"operation"})
+	analysisJobsRunning = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "analysis_jobs_running",
+		Help: "Number of analysis jobs run by this server's workers right now.",
+	})
+	analysisJobAttempts = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "analysis_job_attempt_duration_seconds",
+		Help:    "Duration of analysis job attempts by result (done, failed or requeued).",
+		Buckets: []float64{0.5, 1, 2.5, 5, 10, 20, 30, 60, 120, 300},
+	}, []string{"result"})
+	metricWarnings = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "golf_metric_warnings_total",
+		Help: "Number of warnings attached to calculated golf metrics by metric name and severity.",
+	}, []string{"metric", "severity"})
+)
+
+func ObserveRpc(method string, code string, start time.Time) {
+	rpcRequests.WithLabelValues(method, code).Inc()
+	rpcLatency.WithLabelValues(method).Observe(time.Since(start).Seconds())
+}
+
+func IncRateLimited(method string) {
+	rpcRateLimited.WithLabelValues(method).Inc()
+}
+
+func IncIdempotentReplay(method string) {
+	rpcIdempotentReplays.WithLabelValues(method).Inc()
+}
+
+func ObserveCvCall(method string, start time.Time, err error) {
+	cvCallLatency.WithLabelValues(method).Observe(time.Since(start).Seconds())
+	if err != nil {
+		cvCallFailures.WithLabelValues(method).Inc()
+	}
+}
+
+func IncCvRetry(method string) {
+	cvCallRetries.WithLabelValues(method).Inc()
+}
+
+func SetCvCircuitBreakerOpen(open bool) {
+	if open {
+		cvCircuitBreakerOpen.Set(1)
+	} else {
+		cvCircuitBreakerOpen.Set(0)
+	}
+}
+
+func IncCvCacheRequest(method string, result string) {
+	cvCacheRequests.WithLabelValues(method, result).Inc()
+}
+
+func SetCvBackendOutstanding(backend string, outstanding int) {
+	cvBackendOutstanding.WithLabelValues(backend).Set(float64(outstanding))
+}
+
+func IncCvBackendEjection(backend string) {
+	cvBackendEjections.WithLabelValues(backend).Inc()
+}
+
+// Latency of a DbManager operation, measured from the start of the method (including waiting for the db mutex)
+func ObserveDbOperation(operation string, start time.Time) {
+	dbOperationLatency.WithLabelValues(operation).Observe(time.Since(start).Seconds())
+}
+
+func SetAnalysisJobsRunning(running int) {
+	analysisJobsRunning.Set(float64(running))
+}
+
+func ObserveAnalysisJobAttempt(result string, start time.Time) {
+	analysisJobAttempts.WithLabelValues(result).Observe(time.Since(start).Seconds())
+}
+
+func IncMetricWarning(metricName string, severity util.Severity) {
+	metricWarnings.WithLabelValues(metricName, severity.String()).Inc()
+}
+
+var logger = logging.Logger("metrics")
+
+type MetricsManager struct {
+	config     config.MetricsConfig
+	httpServer *http.Server
+}
+
+func NewMetricsManager(metricsConfig config.MetricsConfig) *MetricsManager {
+	m := &MetricsManager{config: metricsConfig}
+	logger.Info("new metrics mgr")
+	return m
+}
+
+func (m *MetricsManager) StartMetricsServer() error {
+	logger.Info("starting metrics server")
+	if m.config.Port == 0 {
+		logger.Info("metrics server disabled")
+		return nil
+	}
+	lis, err := net.Listen("tcp", fmt.Sprintf("0.0.0.0:%d", m.config.Port))
+	if err != nil {
+		return fmt.Errorf("failed to listen: %w", err)
+	}
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+	m.httpServer = &http.Server{Handler: mux, ReadHeaderTimeout: 10 * time.Second}
+	go func() {
+		if err := m.httpServer.Serve(lis); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logger.Error("metrics server stopped", "error", err)
+		}
+	}()
+	logger.Info("serving metrics", "port", m.config.Port)
+	return nil
+}
+
+func (m *MetricsManager) StopMetricsServer(ctx context.Context) error {
+	if m.httpServer == nil {
+		return nil
+	}
+	return m.httpServer.Shutdown(ctx)
+}
@@ -0,0 +1,129 @@
+// Handles logging in with an external OpenID Connect identity provider (eg. a club's existing identity provider)
+// Exchanges authorization codes for id tokens and validates id tokens (signature, issuer, audience, nonce) against configured providers
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/sirfrank96/go-server/config"
+	"github.com/sirfrank96/go-server/logging"
+)
+
+var logger = logging.Logger("oidc")
+
+// Providers are listed in the oidc section of the config or in its providers_file
+type ProviderConfig = config.OidcProviderConfig
+
+// Identity from a validated id token
+type Identity struct {
+	Provider          string
+	Issuer            string
+	Subject           string
+	Email             string
+	EmailVerified     bool
+	PreferredUsername string
+}
+
+type OidcManager struct {
+	config     config.OidcConfig
+	mutex      sync.Mutex
+	httpClient *http.Client
+	providers  map[string]*provider
+}
+
+func NewOidcManager(oidcConfig config.OidcConfig) *OidcManager {
+	o := &OidcManager{
+		config:     oidcConfig,
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+		providers:  map[string]*provider{},
+	}
+	logger.Info("new oidc mgr")
+	return o
+}
+
+func (o *OidcManager) StartOidcManager() error {
+	logger.Info("starting oidc mgr")
+	configs := o.config.Providers
+	if o.config.ProvidersFile != "" {
+		bytes, err := os.ReadFile(o.config.ProvidersFile)
+		if err != nil {
+			return fmt.Errorf("could not read oidc providers file %s: %w", o.config.ProvidersFile, err)
+		}
+		var fileConfigs []ProviderConfig
+		if err := json.Unmarshal(bytes, &fileConfigs); err != nil {
+			return fmt.Errorf("could not parse oidc providers file %s: %w", o.config.ProvidersFile, err)
+		}
+		configs = append(configs, fileConfigs...)
+	}
+	if len(configs) == 0 {
+		logger.Info("no oidc providers configured")
+		return nil
+	}
+	for _, providerConfig := range configs {
+		if err := o.AddProvider(providerConfig); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Provider metadata and signing keys are fetched lazily on first use, so an unreachable provider does not stop the server from starting
+func (o *OidcManager) AddProvider(config ProviderConfig) error {
+	if config.Name == "" || config.Issuer == "" || config.ClientId == "" {
+		return fmt.Errorf("oidc provider needs a name, issuer and client id")
+	}
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+	if _, ok := o.providers[config.Name]; ok {
+		return fmt.Errorf("oidc provider %s is configured more than once", config.Name)
+	}
+	o.providers[config.Name] = newProvider(config, o.httpClient)
+	logger.Info("added oidc provider", "provider", config.Name, "issuer", config.Issuer)
+	return nil
+}
+
+func (o *OidcManager) GetProviderConfig(providerName string) (*ProviderConfig, error) {
+	p, err := o.getProvider(providerName)
+	if err != nil {
+		return nil, err
+	}
+	return &p.config, nil
+}
+
+// Exchanges an authorization code at the provider's token endpoint, then validates the returned id token
+func (o *OidcManager) LoginWithAuthorizationCode(ctx context.Context, providerName string, code string, redirectUri string, codeVerifier string, nonce string) (*Identity, error) {
+	p, err := o.getProvider(providerName)
+	if err != nil {
+		return nil, err
+	}
+	idToken, err := p.exchangeAuthorizationCode(ctx, code, redirectUri, codeVerifier)
+	if err != nil {
+		return nil, err
+	}
+	return p.verifyIdToken(ctx, idToken, nonce)
+}
+
+// Validates an id token the client already got from the provider (eg. implicit or native app flows)
+func (o *OidcManager) LoginWithIdToken(ctx context.Context, providerName string, idToken string, nonce string) (*Identity, error) {
+	p, err := o.getProvider(providerName)
+	if err != nil {
+		return nil, err
+	}
+	return p.verifyIdToken(ctx, idToken, nonce)
+}
+
+func (o *OidcManager) getProvider(providerName string) (*provider, error) {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+	p, ok := o.providers[providerName]
+	if !ok {
+		return nil, fmt.Errorf("oidc provider %s is not configured", providerName)
+	}
+	return p, nil
+}
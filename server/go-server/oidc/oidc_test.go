@@ -0,0 +1,173 @@
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/sirfrank96/go-server/config"
+
+	jwt "github.com/golang-jwt/jwt/v5"
+)
+
+// Local mock identity provider serving discovery, jwks and token endpoints
+type mockProvider struct {
+	server  *httptest.Server
+	key     *rsa.PrivateKey
+	kid     string
+	idToken string
+}
+
+func newMockProvider(t *testing.T) *mockProvider {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("could not generate rsa key: %s", err.Error())
+	}
+	m := &mockProvider{key: key, kid: "key-1"}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]string{
+			"issuer":         m.server.URL,
+			"token_endpoint": m.server.URL + "/token",
+			"jwks_uri":       m.server.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"keys": []map[string]string{{
+				"kty": "RSA",
+				"kid": m.kid,
+				"use": "sig",
+				"n":   base64.RawURLEncoding.EncodeToString(m.key.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(m.key.E)).Bytes()),
+			}},
+		})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		if r.Form.Get("code") != "good-code" {
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode(map[string]string{"error": "invalid_grant"})
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]string{"id_token": m.idToken})
+	})
+	m.server = httptest.NewServer(mux)
+	return m
+}
+
+func (m *mockProvider) signIdToken(t *testing.T, claims jwt.MapClaims) string {
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = m.kid
+	signed, err := token.SignedString(m.key)
+	if err != nil {
+		t.Fatalf("could not sign id token: %s", err.Error())
+	}
+	return signed
+}
+
+func (m *mockProvider) getClaims(audience string, nonce string) jwt.MapClaims {
+	return jwt.MapClaims{
+		"iss":            m.server.URL,
+		"sub":            "external-user-1",
+		"aud":            audience,
+		"exp":            time.Now().Add(time.Hour).Unix(),
+		"iat":            time.Now().Unix(),
+		"nonce":          nonce,
+		"email":          "member@club.com",
+		"email_verified": true,
+	}
+}
+
+func newTestOidcManager(t *testing.T, m *mockProvider) *OidcManager {
+	o := NewOidcManager(config.OidcConfig{})
+	err := o.AddProvider(ProviderConfig{Name: "club", Issuer: m.server.URL, ClientId: "client-1", ClientSecret: "secret"})
+	if err != nil {
+		t.Fatalf("AddProvider had an unexpected error: %s", err.Error())
+	}
+	return o
+}
+
+func TestLoginWithIdToken(t *testing.T) {
+	ctx := context.Background()
+	m := newMockProvider(t)
+	defer m.server.Close()
+	o := newTestOidcManager(t, m)
+	// good id token
+	idToken := m.signIdToken(t, m.getClaims("client-1", "nonce-1"))
+	identity, err := o.LoginWithIdToken(ctx, "club", idToken, "nonce-1")
+	if err != nil {
+		t.Fatalf("LoginWithIdToken had an unexpected error: %s", err.Error())
+	}
+	if identity.Subject != "external-user-1" || identity.Issuer != m.server.URL || identity.Email != "member@club.com" || !identity.EmailVerified {
+		t.Errorf("LoginWithIdToken returned unexpected identity %+v", identity)
+	}
+	// wrong nonce
+	if _, err := o.LoginWithIdToken(ctx, "club", idToken, "nonce-2"); err == nil {
+		t.Errorf("LoginWithIdToken with wrong nonce is supposed to have an error")
+	}
+	// wrong audience
+	idToken = m.signIdToken(t, m.getClaims("client-2", "nonce-1"))
+	if _, err := o.LoginWithIdToken(ctx, "club", idToken, "nonce-1"); err == nil {
+		t.Errorf("LoginWithIdToken with wrong audience is supposed to have an error")
+	}
+	// wrong issuer
+	claims := m.getClaims("client-1", "nonce-1")
+	claims["iss"] = "https://evil.example.com"
+	idToken = m.signIdToken(t, claims)
+	if _, err := o.LoginWithIdToken(ctx, "club", idToken, "nonce-1"); err == nil {
+		t.Errorf("LoginWithIdToken with wrong issuer is supposed to have an error")
+	}
+	// expired
+	claims = m.getClaims("client-1", "nonce-1")
+	claims["exp"] = time.Now().Add(-time.Hour).Unix()
+	idToken = m.signIdToken(t, claims)
+	if _, err := o.LoginWithIdToken(ctx, "club", idToken, "nonce-1"); err == nil {
+		t.Errorf("LoginWithIdToken with expired token is supposed to have an error")
+	}
+	// signed by a different key
+	otherKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, m.getClaims("client-1", "nonce-1"))
+	token.Header["kid"] = m.kid
+	idToken, _ = token.SignedString(otherKey)
+	if _, err := o.LoginWithIdToken(ctx, "club", idToken, "nonce-1"); err == nil {
+		t.Errorf("LoginWithIdToken with bad signature is supposed to have an error")
+	}
+	// hs256 signed with the public key is not accepted
+	token = jwt.NewWithClaims(jwt.SigningMethodHS256, m.getClaims("client-1", "nonce-1"))
+	idToken, _ = token.SignedString(m.key.N.Bytes())
+	if _, err := o.LoginWithIdToken(ctx, "club", idToken, "nonce-1"); err == nil {
+		t.Errorf("LoginWithIdToken with hs256 token is supposed to have an error")
+	}
+	// unknown provider
+	if _, err := o.LoginWithIdToken(ctx, "other", idToken, "nonce-1"); err == nil {
+		t.Errorf("LoginWithIdToken with unknown provider is supposed to have an error")
+	}
+}
+
+func TestLoginWithAuthorizationCode(t *testing.T) {
+	ctx := context.Background()
+	m := newMockProvider(t)
+	defer m.server.Close()
+	o := newTestOidcManager(t, m)
+	m.idToken = m.signIdToken(t, m.getClaims("client-1", "nonce-1"))
+	// good code
+	identity, err := o.LoginWithAuthorizationCode(ctx, "club", "good-code", "http://localhost/callback", "", "nonce-1")
+	if err != nil {
+		t.Fatalf("LoginWithAuthorizationCode had an unexpected error: %s", err.Error())
+	}
+	if identity.Subject != "external-user-1" {
+		t.Errorf("LoginWithAuthorizationCode returned unexpected identity %+v", identity)
+	}
+	// bad code
+	if _, err := o.LoginWithAuthorizationCode(ctx, "club", "bad-code", "http://localhost/callback", "", "nonce-1"); err == nil {
+		t.Errorf("LoginWithAuthorizationCode with bad code is supposed to have an error")
+	}
+}
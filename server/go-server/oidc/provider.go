@@ -0,0 +1,275 @@
+package oidc
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	jwt "github.com/golang-jwt/jwt/v5"
+)
+
+// minimum time between jwks refreshes when an id token has an unknown key id
+const jwksRefreshInterval = time.Minute
+
+type discoveryDocument struct {
+	Issuer        string `json:"issuer"`
+	TokenEndpoint string `json:"token_endpoint"`
+	JwksUri       string `json:"jwks_uri"`
+}
+
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+type idTokenClaims struct {
+	jwt.RegisteredClaims
+	Nonce             string `json:"nonce"`
+	Email             string `json:"email"`
+	EmailVerified     bool   `json:"email_verified"`
+	PreferredUsername string `json:"preferred_username"`
+}
+
+type provider struct {
+	mutex         sync.Mutex
+	config        ProviderConfig
+	httpClient    *http.Client
+	discovery     *discoveryDocument
+	keys          map[string]interface{}
+	lastJwksFetch time.Time
+}
+
+func newProvider(config ProviderConfig, httpClient *http.Client) *provider {
+	return &provider{
+		config:     config,
+		httpClient: httpClient,
+		keys:       map[string]interface{}{},
+	}
+}
+
+func (p *provider) getDiscoveryDocument(ctx context.Context) (*discoveryDocument, error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if p.discovery != nil {
+		return p.discovery, nil
+	}
+	discoveryUrl := strings.TrimSuffix(p.config.Issuer, "/") + "/.well-known/openid-configuration"
+	var discovery discoveryDocument
+	if err := p.getJson(ctx, discoveryUrl, &discovery); err != nil {
+		return nil, fmt.Errorf("could not get discovery document for %s: %w", p.config.Name, err)
+	}
+	// issuer in discovery document must match configured issuer exactly (OpenID Connect Discovery 1.0 section 4.3)
+	if discovery.Issuer != p.config.Issuer {
+		return nil, fmt.Errorf("discovery document issuer %s does not match configured issuer %s", discovery.Issuer, p.config.Issuer)
+	}
+	p.discovery = &discovery
+	return p.discovery, nil
+}
+
+func (p *provider) exchangeAuthorizationCode(ctx context.Context, code string, redirectUri string, codeVerifier string) (string, error) {
+	discovery, err := p.getDiscoveryDocument(ctx)
+	if err != nil {
+		return "", err
+	}
+	if discovery.TokenEndpoint == "" {
+		return "", fmt.Errorf("oidc provider %s has no token endpoint", p.config.Name)
+	}
+	if redirectUri == "" {
+		redirectUri = p.config.RedirectUri
+	}
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", code)
+	form.Set("redirect_uri", redirectUri)
+	form.Set("client_id", p.config.ClientId)
+	if codeVerifier != "" {
+		form.Set("code_verifier", codeVerifier)
+	}
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, discovery.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", fmt.Errorf("could not create token request: %w", err)
+	}
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	request.Header.Set("Accept", "application/json")
+	if p.config.ClientSecret != "" {
+		request.SetBasicAuth(url.QueryEscape(p.config.ClientId), url.QueryEscape(p.config.ClientSecret))
+	}
+	response, err := p.httpClient.Do(request)
+	if err != nil {
+		return "", fmt.Errorf("could not exchange authorization code: %w", err)
+	}
+	defer response.Body.Close()
+	var tokenResponse struct {
+		IdToken          string `json:"id_token"`
+		Error            string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+	}
+	if err := json.NewDecoder(response.Body).Decode(&tokenResponse); err != nil {
+		return "", fmt.Errorf("could not decode token response: %w", err)
+	}
+	if response.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("token endpoint returned %d: %s %s", response.StatusCode, tokenResponse.Error, tokenResponse.ErrorDescription)
+	}
+	if tokenResponse.IdToken == "" {
+		return "", fmt.Errorf("token response did not include an id token")
+	}
+	return tokenResponse.IdToken, nil
+}
+
+func (p *provider) verifyIdToken(ctx context.Context, idToken string, nonce string) (*Identity, error) {
+	discovery, err := p.getDiscoveryDocument(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var claims idTokenClaims
+	_, err = jwt.ParseWithClaims(idToken, &claims, func(token *jwt.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+		return p.getKey(ctx, discovery, kid)
+	},
+		jwt.WithValidMethods([]string{"RS256", "RS384", "RS512", "ES256", "ES384", "ES512"}),
+		jwt.WithIssuer(p.config.Issuer),
+		jwt.WithAudience(p.config.ClientId),
+		jwt.WithExpirationRequired(),
+		jwt.WithIssuedAt(),
+		jwt.WithLeeway(time.Minute),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("could not verify id token: %w", err)
+	}
+	// nonce binds the id token to the client's login attempt, only skipped if the client did not send one in the auth request
+	if nonce != "" && claims.Nonce != nonce {
+		return nil, fmt.Errorf("id token nonce does not match")
+	}
+	if nonce == "" && claims.Nonce != "" {
+		return nil, fmt.Errorf("id token has a nonce but none was provided")
+	}
+	if claims.Subject == "" {
+		return nil, fmt.Errorf("id token has no subject")
+	}
+	identity := &Identity{
+		Provider:          p.config.Name,
+		Issuer:            claims.Issuer,
+		Subject:           claims.Subject,
+		Email:             claims.Email,
+		EmailVerified:     claims.EmailVerified,
+		PreferredUsername: claims.PreferredUsername,
+	}
+	return identity, nil
+}
+
+// Looks up signing key by key id, refreshes the jwks if the key is unknown (provider rotated keys)
+func (p *provider) getKey(ctx context.Context, discovery *discoveryDocument, kid string) (interface{}, error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if key, ok := p.findKey(kid); ok {
+		return key, nil
+	}
+	if time.Since(p.lastJwksFetch) < jwksRefreshInterval {
+		return nil, fmt.Errorf("no signing key with kid %s", kid)
+	}
+	p.lastJwksFetch = time.Now()
+	var jwks struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := p.getJson(ctx, discovery.JwksUri, &jwks); err != nil {
+		return nil, fmt.Errorf("could not get jwks for %s: %w", p.config.Name, err)
+	}
+	keys := map[string]interface{}{}
+	for _, jwk := range jwks.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		key, err := parseJsonWebKey(&jwk)
+		if err != nil {
+			continue
+		}
+		keys[jwk.Kid] = key
+	}
+	p.keys = keys
+	if key, ok := p.findKey(kid); ok {
+		return key, nil
+	}
+	return nil, fmt.Errorf("no signing key with kid %s", kid)
+}
+
+// an id token without a kid is only accepted if the provider has a single key
+func (p *provider) findKey(kid string) (interface{}, bool) {
+	if kid == "" && len(p.keys) == 1 {
+		for _, key := range p.keys {
+			return key, true
+		}
+	}
+	key, ok := p.keys[kid]
+	return key, ok
+}
+
+func (p *provider) getJson(ctx context.Context, url string, v interface{}) error {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Accept", "application/json")
+	response, err := p.httpClient.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s returned %d", url, response.StatusCode)
+	}
+	return json.NewDecoder(response.Body).Decode(v)
+}
+
+func parseJsonWebKey(jwk *jsonWebKey) (interface{}, error) {
+	switch jwk.Kty {
+	case "RSA":
+		n, err := base64.RawURLEncoding.DecodeString(jwk.N)
+		if err != nil {
+			return nil, fmt.Errorf("invalid rsa modulus: %w", err)
+		}
+		e, err := base64.RawURLEncoding.DecodeString(jwk.E)
+		if err != nil {
+			return nil, fmt.Errorf("invalid rsa exponent: %w", err)
+		}
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch jwk.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %s", jwk.Crv)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(jwk.X)
+		if err != nil {
+			return nil, fmt.Errorf("invalid ec x: %w", err)
+		}
+		y, err := base64.RawURLEncoding.DecodeString(jwk.Y)
+		if err != nil {
+			return nil, fmt.Errorf("invalid ec y: %w", err)
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}, nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %s", jwk.Kty)
+	}
+}
@@ -1,6 +1,6 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.25.0-devel
+// 	protoc-gen-go v1.27.1
 // 	protoc        v3.13.0
 // source: common.proto
 
@@ -20,6 +20,62 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Landmark set of the pose model that estimated the keypoints, the go-server converts every other set to Body25
+type KeypointSchema int32
+
+const (
+	KeypointSchema_KEYPOINT_SCHEMA_UNSPECIFIED KeypointSchema = 0
+	// openpose, has every Body25 keypoint
+	KeypointSchema_KEYPOINT_SCHEMA_BODY25 KeypointSchema = 1
+	// coco 17 keypoints, no neck, midhip or feet
+	KeypointSchema_KEYPOINT_SCHEMA_COCO17 KeypointSchema = 2
+	// mediapipe blazepose 33 landmarks, no neck, midhip or small toes
+	KeypointSchema_KEYPOINT_SCHEMA_MEDIAPIPE33 KeypointSchema = 3
+)
+
+// Enum value maps for KeypointSchema.
+var (
+	KeypointSchema_name = map[int32]string{
+		0: "KEYPOINT_SCHEMA_UNSPECIFIED",
+		1: "KEYPOINT_SCHEMA_BODY25",
+		2: "KEYPOINT_SCHEMA_COCO17",
+		3: "KEYPOINT_SCHEMA_MEDIAPIPE33",
+	}
+	KeypointSchema_value = map[string]int32{
+		"KEYPOINT_SCHEMA_UNSPECIFIED": 0,
+		"KEYPOINT_SCHEMA_BODY25":      1,
+		"KEYPOINT_SCHEMA_COCO17":      2,
+		"KEYPOINT_SCHEMA_MEDIAPIPE33": 3,
+	}
+)
+
+func (x KeypointSchema) Enum() *KeypointSchema {
+	p := new(KeypointSchema)
+	*p = x
+	return p
+}
+
+func (x KeypointSchema) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (KeypointSchema) Descriptor() protoreflect.EnumDescriptor {
+	return file_common_proto_enumTypes[0].Descriptor()
+}
+
+func (KeypointSchema) Type() protoreflect.EnumType {
+	return &file_common_proto_enumTypes[0]
+}
+
+func (x KeypointSchema) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use KeypointSchema.Descriptor instead.
+func (KeypointSchema) EnumDescriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{0}
+}
+
 type Double struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -757,268 +813,282 @@ var File_common_proto protoreflect.FileDescriptor
 var file_common_proto_rawDesc = []byte{
 	0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x16,
 	0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73,
-	0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x36, 0x0a, 0x06, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65,
+	0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x36, 0x0a, 0x06, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65,
 	0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04,
 	0x64, 0x61, 0x74, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x22, 0x46,
-	0x0a, 0x08, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x01, 0x52, 0x01, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64,
-	0x65, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66,
-	0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x22, 0xcd, 0x0b, 0x0a, 0x13, 0x42, 0x6f, 0x64, 0x79, 0x32,
-	0x35, 0x50, 0x6f, 0x73, 0x65, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x34,
-	0x0a, 0x04, 0x6e, 0x6f, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73,
-	0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x04,
-	0x6e, 0x6f, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x04, 0x6e, 0x65, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x22, 0x7c,
+	0x0a, 0x08, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x01, 0x78, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x01, 0x42, 0x0d, 0xa2, 0xbb, 0x18, 0x09, 0x39, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x52, 0x01, 0x78, 0x12, 0x1b, 0x0a, 0x01, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x01, 0x42, 0x0d, 0xa2, 0xbb, 0x18, 0x09, 0x39, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x52, 0x01, 0x79, 0x12, 0x36, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e,
+	0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x42, 0x16, 0xa2, 0xbb, 0x18, 0x12, 0x39, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x41, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f,
+	0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x22, 0xcd, 0x0b, 0x0a,
+	0x13, 0x42, 0x6f, 0x64, 0x79, 0x32, 0x35, 0x50, 0x6f, 0x73, 0x65, 0x4b, 0x65, 0x79, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x73, 0x12, 0x34, 0x0a, 0x04, 0x6e, 0x6f, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70,
 	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4b, 0x65, 0x79, 0x70,
-	0x6f, 0x69, 0x6e, 0x74, 0x52, 0x04, 0x6e, 0x65, 0x63, 0x6b, 0x12, 0x3f, 0x0a, 0x0a, 0x72, 0x5f,
-	0x73, 0x68, 0x6f, 0x75, 0x6c, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20,
-	0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74,
-	0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74,
-	0x52, 0x09, 0x72, 0x53, 0x68, 0x6f, 0x75, 0x6c, 0x64, 0x65, 0x72, 0x12, 0x39, 0x0a, 0x07, 0x72,
-	0x5f, 0x65, 0x6c, 0x62, 0x6f, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73,
-	0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x06,
-	0x72, 0x45, 0x6c, 0x62, 0x6f, 0x77, 0x12, 0x39, 0x0a, 0x07, 0x72, 0x5f, 0x77, 0x72, 0x69, 0x73,
-	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73,
-	0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x06, 0x72, 0x57, 0x72, 0x69, 0x73,
-	0x74, 0x12, 0x3f, 0x0a, 0x0a, 0x6c, 0x5f, 0x73, 0x68, 0x6f, 0x75, 0x6c, 0x64, 0x65, 0x72, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b,
-	0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4b,
-	0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x09, 0x6c, 0x53, 0x68, 0x6f, 0x75, 0x6c, 0x64,
-	0x65, 0x72, 0x12, 0x39, 0x0a, 0x07, 0x6c, 0x5f, 0x65, 0x6c, 0x62, 0x6f, 0x77, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79,
-	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4b, 0x65, 0x79,
-	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x06, 0x6c, 0x45, 0x6c, 0x62, 0x6f, 0x77, 0x12, 0x39, 0x0a,
-	0x07, 0x6c, 0x5f, 0x77, 0x72, 0x69, 0x73, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20,
-	0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74,
-	0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74,
-	0x52, 0x06, 0x6c, 0x57, 0x72, 0x69, 0x73, 0x74, 0x12, 0x38, 0x0a, 0x06, 0x6d, 0x69, 0x64, 0x68,
-	0x69, 0x70, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74,
+	0x6f, 0x69, 0x6e, 0x74, 0x52, 0x04, 0x6e, 0x6f, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x04, 0x6e, 0x65,
+	0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74,
 	0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x06, 0x6d, 0x69, 0x64, 0x68,
-	0x69, 0x70, 0x12, 0x35, 0x0a, 0x05, 0x72, 0x5f, 0x68, 0x69, 0x70, 0x18, 0x0a, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x20, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f,
-	0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4b, 0x65, 0x79, 0x70, 0x6f,
-	0x69, 0x6e, 0x74, 0x52, 0x04, 0x72, 0x48, 0x69, 0x70, 0x12, 0x37, 0x0a, 0x06, 0x72, 0x5f, 0x6b,
-	0x6e, 0x65, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x70, 0x6f, 0x72,
+	0x6f, 0x2e, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x04, 0x6e, 0x65, 0x63, 0x6b,
+	0x12, 0x3f, 0x0a, 0x0a, 0x72, 0x5f, 0x73, 0x68, 0x6f, 0x75, 0x6c, 0x64, 0x65, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65,
+	0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4b, 0x65,
+	0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x09, 0x72, 0x53, 0x68, 0x6f, 0x75, 0x6c, 0x64, 0x65,
+	0x72, 0x12, 0x39, 0x0a, 0x07, 0x72, 0x5f, 0x65, 0x6c, 0x62, 0x6f, 0x77, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4b, 0x65, 0x79, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x52, 0x06, 0x72, 0x45, 0x6c, 0x62, 0x6f, 0x77, 0x12, 0x39, 0x0a, 0x07,
+	0x72, 0x5f, 0x77, 0x72, 0x69, 0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e,
+	0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73,
+	0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52,
+	0x06, 0x72, 0x57, 0x72, 0x69, 0x73, 0x74, 0x12, 0x3f, 0x0a, 0x0a, 0x6c, 0x5f, 0x73, 0x68, 0x6f,
+	0x75, 0x6c, 0x64, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x70,
+	0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x09, 0x6c,
+	0x53, 0x68, 0x6f, 0x75, 0x6c, 0x64, 0x65, 0x72, 0x12, 0x39, 0x0a, 0x07, 0x6c, 0x5f, 0x65, 0x6c,
+	0x62, 0x6f, 0x77, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x70, 0x6f, 0x72,
 	0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x05, 0x72, 0x4b, 0x6e,
-	0x65, 0x65, 0x12, 0x39, 0x0a, 0x07, 0x72, 0x5f, 0x61, 0x6e, 0x6b, 0x6c, 0x65, 0x18, 0x0c, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79,
-	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4b, 0x65, 0x79,
-	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x06, 0x72, 0x41, 0x6e, 0x6b, 0x6c, 0x65, 0x12, 0x35, 0x0a,
-	0x05, 0x6c, 0x5f, 0x68, 0x69, 0x70, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73,
-	0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x04,
-	0x6c, 0x48, 0x69, 0x70, 0x12, 0x37, 0x0a, 0x06, 0x6c, 0x5f, 0x6b, 0x6e, 0x65, 0x65, 0x18, 0x0e,
+	0x74, 0x6f, 0x2e, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x06, 0x6c, 0x45, 0x6c,
+	0x62, 0x6f, 0x77, 0x12, 0x39, 0x0a, 0x07, 0x6c, 0x5f, 0x77, 0x72, 0x69, 0x73, 0x74, 0x18, 0x08,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65,
 	0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4b, 0x65,
-	0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x05, 0x6c, 0x4b, 0x6e, 0x65, 0x65, 0x12, 0x39, 0x0a,
-	0x07, 0x6c, 0x5f, 0x61, 0x6e, 0x6b, 0x6c, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20,
+	0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x06, 0x6c, 0x57, 0x72, 0x69, 0x73, 0x74, 0x12, 0x38,
+	0x0a, 0x06, 0x6d, 0x69, 0x64, 0x68, 0x69, 0x70, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20,
 	0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74,
 	0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74,
-	0x52, 0x06, 0x6c, 0x41, 0x6e, 0x6b, 0x6c, 0x65, 0x12, 0x35, 0x0a, 0x05, 0x72, 0x5f, 0x65, 0x79,
-	0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73,
+	0x52, 0x06, 0x6d, 0x69, 0x64, 0x68, 0x69, 0x70, 0x12, 0x35, 0x0a, 0x05, 0x72, 0x5f, 0x68, 0x69,
+	0x70, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73,
 	0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x04, 0x72, 0x45, 0x79, 0x65, 0x12,
-	0x35, 0x0a, 0x05, 0x6c, 0x5f, 0x65, 0x79, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20,
-	0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74,
-	0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74,
-	0x52, 0x04, 0x6c, 0x45, 0x79, 0x65, 0x12, 0x35, 0x0a, 0x05, 0x72, 0x5f, 0x65, 0x61, 0x72, 0x18,
-	0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b,
-	0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4b,
-	0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x04, 0x72, 0x45, 0x61, 0x72, 0x12, 0x35, 0x0a,
-	0x05, 0x6c, 0x5f, 0x65, 0x61, 0x72, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73,
-	0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x04,
-	0x6c, 0x45, 0x61, 0x72, 0x12, 0x3c, 0x0a, 0x09, 0x6c, 0x5f, 0x62, 0x69, 0x67, 0x5f, 0x74, 0x6f,
-	0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73,
-	0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x07, 0x6c, 0x42, 0x69, 0x67, 0x54,
-	0x6f, 0x65, 0x12, 0x40, 0x0a, 0x0b, 0x6c, 0x5f, 0x73, 0x6d, 0x61, 0x6c, 0x6c, 0x5f, 0x74, 0x6f,
-	0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73,
-	0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x09, 0x6c, 0x53, 0x6d, 0x61, 0x6c,
-	0x6c, 0x54, 0x6f, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x6c, 0x5f, 0x68, 0x65, 0x65, 0x6c, 0x18, 0x16,
+	0x2e, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x04, 0x72, 0x48, 0x69, 0x70, 0x12,
+	0x37, 0x0a, 0x06, 0x72, 0x5f, 0x6b, 0x6e, 0x65, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x20, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x52, 0x05, 0x72, 0x4b, 0x6e, 0x65, 0x65, 0x12, 0x39, 0x0a, 0x07, 0x72, 0x5f, 0x61, 0x6e,
+	0x6b, 0x6c, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x70, 0x6f, 0x72,
+	0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x06, 0x72, 0x41, 0x6e,
+	0x6b, 0x6c, 0x65, 0x12, 0x35, 0x0a, 0x05, 0x6c, 0x5f, 0x68, 0x69, 0x70, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4b, 0x65, 0x79, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x52, 0x04, 0x6c, 0x48, 0x69, 0x70, 0x12, 0x37, 0x0a, 0x06, 0x6c, 0x5f,
+	0x6b, 0x6e, 0x65, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x70, 0x6f,
+	0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x05, 0x6c, 0x4b,
+	0x6e, 0x65, 0x65, 0x12, 0x39, 0x0a, 0x07, 0x6c, 0x5f, 0x61, 0x6e, 0x6b, 0x6c, 0x65, 0x18, 0x0f,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65,
 	0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4b, 0x65,
-	0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x05, 0x6c, 0x48, 0x65, 0x65, 0x6c, 0x12, 0x3c, 0x0a,
-	0x09, 0x72, 0x5f, 0x62, 0x69, 0x67, 0x5f, 0x74, 0x6f, 0x65, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x20, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69,
-	0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69,
-	0x6e, 0x74, 0x52, 0x07, 0x72, 0x42, 0x69, 0x67, 0x54, 0x6f, 0x65, 0x12, 0x40, 0x0a, 0x0b, 0x72,
-	0x5f, 0x73, 0x6d, 0x61, 0x6c, 0x6c, 0x5f, 0x74, 0x6f, 0x65, 0x18, 0x18, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x20, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69,
-	0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69,
-	0x6e, 0x74, 0x52, 0x09, 0x72, 0x53, 0x6d, 0x61, 0x6c, 0x6c, 0x54, 0x6f, 0x65, 0x12, 0x37, 0x0a,
-	0x06, 0x72, 0x5f, 0x68, 0x65, 0x65, 0x6c, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e,
+	0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x06, 0x6c, 0x41, 0x6e, 0x6b, 0x6c, 0x65, 0x12, 0x35,
+	0x0a, 0x05, 0x72, 0x5f, 0x65, 0x79, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e,
 	0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73,
 	0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52,
-	0x05, 0x72, 0x48, 0x65, 0x65, 0x6c, 0x22, 0xff, 0x13, 0x0a, 0x13, 0x42, 0x6f, 0x64, 0x79, 0x32,
-	0x35, 0x48, 0x61, 0x6e, 0x64, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x39,
-	0x0a, 0x07, 0x6c, 0x5f, 0x77, 0x72, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x20, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e,
-	0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e,
-	0x74, 0x52, 0x06, 0x6c, 0x57, 0x72, 0x69, 0x73, 0x74, 0x12, 0x3b, 0x0a, 0x08, 0x6c, 0x5f, 0x74,
-	0x68, 0x75, 0x6d, 0x62, 0x31, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x70,
+	0x04, 0x72, 0x45, 0x79, 0x65, 0x12, 0x35, 0x0a, 0x05, 0x6c, 0x5f, 0x65, 0x79, 0x65, 0x18, 0x11,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65,
+	0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4b, 0x65,
+	0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x04, 0x6c, 0x45, 0x79, 0x65, 0x12, 0x35, 0x0a, 0x05,
+	0x72, 0x5f, 0x65, 0x61, 0x72, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x70,
 	0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x07, 0x6c,
-	0x54, 0x68, 0x75, 0x6d, 0x62, 0x31, 0x12, 0x3b, 0x0a, 0x08, 0x6c, 0x5f, 0x74, 0x68, 0x75, 0x6d,
-	0x62, 0x32, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74,
-	0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x07, 0x6c, 0x54, 0x68, 0x75,
-	0x6d, 0x62, 0x32, 0x12, 0x3b, 0x0a, 0x08, 0x6c, 0x5f, 0x74, 0x68, 0x75, 0x6d, 0x62, 0x33, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b,
-	0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4b,
-	0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x07, 0x6c, 0x54, 0x68, 0x75, 0x6d, 0x62, 0x33,
-	0x12, 0x39, 0x0a, 0x07, 0x6c, 0x5f, 0x74, 0x68, 0x75, 0x6d, 0x62, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x20, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f,
-	0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4b, 0x65, 0x79, 0x70, 0x6f,
-	0x69, 0x6e, 0x74, 0x52, 0x06, 0x6c, 0x54, 0x68, 0x75, 0x6d, 0x62, 0x12, 0x3b, 0x0a, 0x08, 0x6c,
-	0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x31, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x04, 0x72,
+	0x45, 0x61, 0x72, 0x12, 0x35, 0x0a, 0x05, 0x6c, 0x5f, 0x65, 0x61, 0x72, 0x18, 0x13, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4b, 0x65, 0x79, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x52, 0x04, 0x6c, 0x45, 0x61, 0x72, 0x12, 0x3c, 0x0a, 0x09, 0x6c, 0x5f,
+	0x62, 0x69, 0x67, 0x5f, 0x74, 0x6f, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e,
+	0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73,
+	0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52,
+	0x07, 0x6c, 0x42, 0x69, 0x67, 0x54, 0x6f, 0x65, 0x12, 0x40, 0x0a, 0x0b, 0x6c, 0x5f, 0x73, 0x6d,
+	0x61, 0x6c, 0x6c, 0x5f, 0x74, 0x6f, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e,
 	0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73,
 	0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52,
-	0x07, 0x6c, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x31, 0x12, 0x3b, 0x0a, 0x08, 0x6c, 0x5f, 0x69, 0x6e,
-	0x64, 0x65, 0x78, 0x32, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x70, 0x6f,
+	0x09, 0x6c, 0x53, 0x6d, 0x61, 0x6c, 0x6c, 0x54, 0x6f, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x6c, 0x5f,
+	0x68, 0x65, 0x65, 0x6c, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x70, 0x6f,
 	0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x07, 0x6c, 0x49,
-	0x6e, 0x64, 0x65, 0x78, 0x32, 0x12, 0x3b, 0x0a, 0x08, 0x6c, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78,
-	0x33, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73,
-	0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x07, 0x6c, 0x49, 0x6e, 0x64, 0x65,
-	0x78, 0x33, 0x12, 0x39, 0x0a, 0x07, 0x6c, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x09, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79,
-	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4b, 0x65, 0x79,
-	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x06, 0x6c, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x3d, 0x0a,
-	0x09, 0x6c, 0x5f, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x31, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x20, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69,
-	0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69,
-	0x6e, 0x74, 0x52, 0x08, 0x6c, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x31, 0x12, 0x3d, 0x0a, 0x09,
-	0x6c, 0x5f, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x32, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x20, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e,
-	0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e,
-	0x74, 0x52, 0x08, 0x6c, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x32, 0x12, 0x3d, 0x0a, 0x09, 0x6c,
-	0x5f, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x33, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20,
-	0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74,
-	0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74,
-	0x52, 0x08, 0x6c, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x33, 0x12, 0x3b, 0x0a, 0x08, 0x6c, 0x5f,
-	0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73,
-	0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x07,
-	0x6c, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x12, 0x39, 0x0a, 0x07, 0x6c, 0x5f, 0x72, 0x69, 0x6e,
-	0x67, 0x31, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74,
-	0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x06, 0x6c, 0x52, 0x69, 0x6e,
-	0x67, 0x31, 0x12, 0x39, 0x0a, 0x07, 0x6c, 0x5f, 0x72, 0x69, 0x6e, 0x67, 0x32, 0x18, 0x0f, 0x20,
+	0x6f, 0x74, 0x6f, 0x2e, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x05, 0x6c, 0x48,
+	0x65, 0x65, 0x6c, 0x12, 0x3c, 0x0a, 0x09, 0x72, 0x5f, 0x62, 0x69, 0x67, 0x5f, 0x74, 0x6f, 0x65,
+	0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f,
+	0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x07, 0x72, 0x42, 0x69, 0x67, 0x54, 0x6f,
+	0x65, 0x12, 0x40, 0x0a, 0x0b, 0x72, 0x5f, 0x73, 0x6d, 0x61, 0x6c, 0x6c, 0x5f, 0x74, 0x6f, 0x65,
+	0x18, 0x18, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f,
+	0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x09, 0x72, 0x53, 0x6d, 0x61, 0x6c, 0x6c,
+	0x54, 0x6f, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x72, 0x5f, 0x68, 0x65, 0x65, 0x6c, 0x18, 0x19, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79,
 	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4b, 0x65, 0x79,
-	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x06, 0x6c, 0x52, 0x69, 0x6e, 0x67, 0x32, 0x12, 0x39, 0x0a,
-	0x07, 0x6c, 0x5f, 0x72, 0x69, 0x6e, 0x67, 0x33, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x05, 0x72, 0x48, 0x65, 0x65, 0x6c, 0x22, 0xff, 0x13, 0x0a,
+	0x13, 0x42, 0x6f, 0x64, 0x79, 0x32, 0x35, 0x48, 0x61, 0x6e, 0x64, 0x4b, 0x65, 0x79, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x73, 0x12, 0x39, 0x0a, 0x07, 0x6c, 0x5f, 0x77, 0x72, 0x69, 0x73, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b,
+	0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4b,
+	0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x06, 0x6c, 0x57, 0x72, 0x69, 0x73, 0x74, 0x12,
+	0x3b, 0x0a, 0x08, 0x6c, 0x5f, 0x74, 0x68, 0x75, 0x6d, 0x62, 0x31, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x20, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4b, 0x65, 0x79, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x52, 0x07, 0x6c, 0x54, 0x68, 0x75, 0x6d, 0x62, 0x31, 0x12, 0x3b, 0x0a, 0x08,
+	0x6c, 0x5f, 0x74, 0x68, 0x75, 0x6d, 0x62, 0x32, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20,
 	0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74,
 	0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74,
-	0x52, 0x06, 0x6c, 0x52, 0x69, 0x6e, 0x67, 0x33, 0x12, 0x37, 0x0a, 0x06, 0x6c, 0x5f, 0x72, 0x69,
-	0x6e, 0x67, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74,
-	0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x05, 0x6c, 0x52, 0x69, 0x6e,
-	0x67, 0x12, 0x3b, 0x0a, 0x08, 0x6c, 0x5f, 0x70, 0x69, 0x6e, 0x6b, 0x79, 0x31, 0x18, 0x12, 0x20,
+	0x52, 0x07, 0x6c, 0x54, 0x68, 0x75, 0x6d, 0x62, 0x32, 0x12, 0x3b, 0x0a, 0x08, 0x6c, 0x5f, 0x74,
+	0x68, 0x75, 0x6d, 0x62, 0x33, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x70,
+	0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x07, 0x6c,
+	0x54, 0x68, 0x75, 0x6d, 0x62, 0x33, 0x12, 0x39, 0x0a, 0x07, 0x6c, 0x5f, 0x74, 0x68, 0x75, 0x6d,
+	0x62, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73,
+	0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x06, 0x6c, 0x54, 0x68, 0x75, 0x6d,
+	0x62, 0x12, 0x3b, 0x0a, 0x08, 0x6c, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x31, 0x18, 0x06, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79,
 	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4b, 0x65, 0x79,
-	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x07, 0x6c, 0x50, 0x69, 0x6e, 0x6b, 0x79, 0x31, 0x12, 0x3b,
-	0x0a, 0x08, 0x6c, 0x5f, 0x70, 0x69, 0x6e, 0x6b, 0x79, 0x32, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x07, 0x6c, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x31, 0x12, 0x3b,
+	0x0a, 0x08, 0x6c, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x32, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x20, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69,
 	0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69,
-	0x6e, 0x74, 0x52, 0x07, 0x6c, 0x50, 0x69, 0x6e, 0x6b, 0x79, 0x32, 0x12, 0x3b, 0x0a, 0x08, 0x6c,
-	0x5f, 0x70, 0x69, 0x6e, 0x6b, 0x79, 0x33, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e,
+	0x6e, 0x74, 0x52, 0x07, 0x6c, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x32, 0x12, 0x3b, 0x0a, 0x08, 0x6c,
+	0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x33, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e,
 	0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73,
 	0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52,
-	0x07, 0x6c, 0x50, 0x69, 0x6e, 0x6b, 0x79, 0x33, 0x12, 0x39, 0x0a, 0x07, 0x6c, 0x5f, 0x70, 0x69,
-	0x6e, 0x6b, 0x79, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x70, 0x6f, 0x72,
+	0x07, 0x6c, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x33, 0x12, 0x39, 0x0a, 0x07, 0x6c, 0x5f, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x70, 0x6f, 0x72,
 	0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x06, 0x6c, 0x50, 0x69,
-	0x6e, 0x6b, 0x79, 0x12, 0x39, 0x0a, 0x07, 0x72, 0x5f, 0x77, 0x72, 0x69, 0x73, 0x74, 0x18, 0x16,
+	0x74, 0x6f, 0x2e, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x06, 0x6c, 0x49, 0x6e,
+	0x64, 0x65, 0x78, 0x12, 0x3d, 0x0a, 0x09, 0x6c, 0x5f, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x31,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f,
+	0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x08, 0x6c, 0x4d, 0x69, 0x64, 0x64, 0x6c,
+	0x65, 0x31, 0x12, 0x3d, 0x0a, 0x09, 0x6c, 0x5f, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x32, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b,
+	0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4b,
+	0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x08, 0x6c, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65,
+	0x32, 0x12, 0x3d, 0x0a, 0x09, 0x6c, 0x5f, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x33, 0x18, 0x0c,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65,
 	0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4b, 0x65,
-	0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x06, 0x72, 0x57, 0x72, 0x69, 0x73, 0x74, 0x12, 0x3b,
-	0x0a, 0x08, 0x72, 0x5f, 0x74, 0x68, 0x75, 0x6d, 0x62, 0x31, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x20, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69,
-	0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69,
-	0x6e, 0x74, 0x52, 0x07, 0x72, 0x54, 0x68, 0x75, 0x6d, 0x62, 0x31, 0x12, 0x3b, 0x0a, 0x08, 0x72,
-	0x5f, 0x74, 0x68, 0x75, 0x6d, 0x62, 0x32, 0x18, 0x18, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e,
-	0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73,
-	0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52,
-	0x07, 0x72, 0x54, 0x68, 0x75, 0x6d, 0x62, 0x32, 0x12, 0x3b, 0x0a, 0x08, 0x72, 0x5f, 0x74, 0x68,
-	0x75, 0x6d, 0x62, 0x33, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x70, 0x6f,
-	0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x07, 0x72, 0x54,
-	0x68, 0x75, 0x6d, 0x62, 0x33, 0x12, 0x39, 0x0a, 0x07, 0x72, 0x5f, 0x74, 0x68, 0x75, 0x6d, 0x62,
-	0x18, 0x1a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f,
-	0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x06, 0x72, 0x54, 0x68, 0x75, 0x6d, 0x62,
-	0x12, 0x3b, 0x0a, 0x08, 0x72, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x31, 0x18, 0x1b, 0x20, 0x01,
+	0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x08, 0x6c, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x33,
+	0x12, 0x3b, 0x0a, 0x08, 0x6c, 0x5f, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x18, 0x0d, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70,
 	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4b, 0x65, 0x79, 0x70,
-	0x6f, 0x69, 0x6e, 0x74, 0x52, 0x07, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x31, 0x12, 0x3b, 0x0a,
-	0x08, 0x72, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x32, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x20, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e,
-	0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e,
-	0x74, 0x52, 0x07, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x32, 0x12, 0x3b, 0x0a, 0x08, 0x72, 0x5f,
-	0x69, 0x6e, 0x64, 0x65, 0x78, 0x33, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73,
-	0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x07,
-	0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x33, 0x12, 0x39, 0x0a, 0x07, 0x72, 0x5f, 0x69, 0x6e, 0x64,
-	0x65, 0x78, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74,
-	0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x06, 0x72, 0x49, 0x6e, 0x64,
-	0x65, 0x78, 0x12, 0x3d, 0x0a, 0x09, 0x72, 0x5f, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x31, 0x18,
-	0x1f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b,
-	0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4b,
-	0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x08, 0x72, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65,
-	0x31, 0x12, 0x3d, 0x0a, 0x09, 0x72, 0x5f, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x32, 0x18, 0x20,
+	0x6f, 0x69, 0x6e, 0x74, 0x52, 0x07, 0x6c, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x12, 0x39, 0x0a,
+	0x07, 0x6c, 0x5f, 0x72, 0x69, 0x6e, 0x67, 0x31, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20,
+	0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x52, 0x06, 0x6c, 0x52, 0x69, 0x6e, 0x67, 0x31, 0x12, 0x39, 0x0a, 0x07, 0x6c, 0x5f, 0x72, 0x69,
+	0x6e, 0x67, 0x32, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x70, 0x6f, 0x72,
+	0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x06, 0x6c, 0x52, 0x69,
+	0x6e, 0x67, 0x32, 0x12, 0x39, 0x0a, 0x07, 0x6c, 0x5f, 0x72, 0x69, 0x6e, 0x67, 0x33, 0x18, 0x10,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65,
 	0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4b, 0x65,
-	0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x08, 0x72, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x32,
-	0x12, 0x3d, 0x0a, 0x09, 0x72, 0x5f, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x33, 0x18, 0x21, 0x20,
+	0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x06, 0x6c, 0x52, 0x69, 0x6e, 0x67, 0x33, 0x12, 0x37,
+	0x0a, 0x06, 0x6c, 0x5f, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20,
+	0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x52, 0x05, 0x6c, 0x52, 0x69, 0x6e, 0x67, 0x12, 0x3b, 0x0a, 0x08, 0x6c, 0x5f, 0x70, 0x69, 0x6e,
+	0x6b, 0x79, 0x31, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x70, 0x6f, 0x72,
+	0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x07, 0x6c, 0x50, 0x69,
+	0x6e, 0x6b, 0x79, 0x31, 0x12, 0x3b, 0x0a, 0x08, 0x6c, 0x5f, 0x70, 0x69, 0x6e, 0x6b, 0x79, 0x32,
+	0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f,
+	0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x07, 0x6c, 0x50, 0x69, 0x6e, 0x6b, 0x79,
+	0x32, 0x12, 0x3b, 0x0a, 0x08, 0x6c, 0x5f, 0x70, 0x69, 0x6e, 0x6b, 0x79, 0x33, 0x18, 0x14, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79,
 	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4b, 0x65, 0x79,
-	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x08, 0x72, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x33, 0x12,
-	0x3b, 0x0a, 0x08, 0x72, 0x5f, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x18, 0x22, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x20, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f,
-	0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4b, 0x65, 0x79, 0x70, 0x6f,
-	0x69, 0x6e, 0x74, 0x52, 0x07, 0x72, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x12, 0x39, 0x0a, 0x07,
-	0x72, 0x5f, 0x72, 0x69, 0x6e, 0x67, 0x31, 0x18, 0x23, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e,
-	0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73,
-	0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52,
-	0x06, 0x72, 0x52, 0x69, 0x6e, 0x67, 0x31, 0x12, 0x39, 0x0a, 0x07, 0x72, 0x5f, 0x72, 0x69, 0x6e,
-	0x67, 0x32, 0x18, 0x24, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74,
-	0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x06, 0x72, 0x52, 0x69, 0x6e,
-	0x67, 0x32, 0x12, 0x39, 0x0a, 0x07, 0x72, 0x5f, 0x72, 0x69, 0x6e, 0x67, 0x33, 0x18, 0x25, 0x20,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x07, 0x6c, 0x50, 0x69, 0x6e, 0x6b, 0x79, 0x33, 0x12, 0x39,
+	0x0a, 0x07, 0x6c, 0x5f, 0x70, 0x69, 0x6e, 0x6b, 0x79, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x20, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x52, 0x06, 0x6c, 0x50, 0x69, 0x6e, 0x6b, 0x79, 0x12, 0x39, 0x0a, 0x07, 0x72, 0x5f, 0x77,
+	0x72, 0x69, 0x73, 0x74, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x70, 0x6f,
+	0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x06, 0x72, 0x57,
+	0x72, 0x69, 0x73, 0x74, 0x12, 0x3b, 0x0a, 0x08, 0x72, 0x5f, 0x74, 0x68, 0x75, 0x6d, 0x62, 0x31,
+	0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f,
+	0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x07, 0x72, 0x54, 0x68, 0x75, 0x6d, 0x62,
+	0x31, 0x12, 0x3b, 0x0a, 0x08, 0x72, 0x5f, 0x74, 0x68, 0x75, 0x6d, 0x62, 0x32, 0x18, 0x18, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79,
 	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4b, 0x65, 0x79,
-	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x06, 0x72, 0x52, 0x69, 0x6e, 0x67, 0x33, 0x12, 0x37, 0x0a,
-	0x06, 0x72, 0x5f, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x26, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e,
-	0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73,
-	0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52,
-	0x05, 0x72, 0x52, 0x69, 0x6e, 0x67, 0x12, 0x3b, 0x0a, 0x08, 0x72, 0x5f, 0x70, 0x69, 0x6e, 0x6b,
-	0x79, 0x31, 0x18, 0x27, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x07, 0x72, 0x54, 0x68, 0x75, 0x6d, 0x62, 0x32, 0x12, 0x3b,
+	0x0a, 0x08, 0x72, 0x5f, 0x74, 0x68, 0x75, 0x6d, 0x62, 0x33, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x20, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x52, 0x07, 0x72, 0x54, 0x68, 0x75, 0x6d, 0x62, 0x33, 0x12, 0x39, 0x0a, 0x07, 0x72,
+	0x5f, 0x74, 0x68, 0x75, 0x6d, 0x62, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73,
+	0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x06,
+	0x72, 0x54, 0x68, 0x75, 0x6d, 0x62, 0x12, 0x3b, 0x0a, 0x08, 0x72, 0x5f, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x31, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74,
 	0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x07, 0x72, 0x50, 0x69, 0x6e,
-	0x6b, 0x79, 0x31, 0x12, 0x3b, 0x0a, 0x08, 0x72, 0x5f, 0x70, 0x69, 0x6e, 0x6b, 0x79, 0x32, 0x18,
-	0x28, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b,
+	0x6f, 0x2e, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x07, 0x72, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x31, 0x12, 0x3b, 0x0a, 0x08, 0x72, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x32, 0x18,
+	0x1c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b,
 	0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4b,
-	0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x07, 0x72, 0x50, 0x69, 0x6e, 0x6b, 0x79, 0x32,
-	0x12, 0x3b, 0x0a, 0x08, 0x72, 0x5f, 0x70, 0x69, 0x6e, 0x6b, 0x79, 0x33, 0x18, 0x29, 0x20, 0x01,
+	0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x07, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x32,
+	0x12, 0x3b, 0x0a, 0x08, 0x72, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x33, 0x18, 0x1d, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70,
 	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4b, 0x65, 0x79, 0x70,
-	0x6f, 0x69, 0x6e, 0x74, 0x52, 0x07, 0x72, 0x50, 0x69, 0x6e, 0x6b, 0x79, 0x33, 0x12, 0x39, 0x0a,
-	0x07, 0x72, 0x5f, 0x70, 0x69, 0x6e, 0x6b, 0x79, 0x18, 0x2a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20,
+	0x6f, 0x69, 0x6e, 0x74, 0x52, 0x07, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x33, 0x12, 0x39, 0x0a,
+	0x07, 0x72, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20,
 	0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74,
 	0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74,
-	0x52, 0x06, 0x72, 0x50, 0x69, 0x6e, 0x6b, 0x79, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x52, 0x06, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x3d, 0x0a, 0x09, 0x72, 0x5f, 0x6d, 0x69,
+	0x64, 0x64, 0x6c, 0x65, 0x31, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x70,
+	0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x08, 0x72,
+	0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x31, 0x12, 0x3d, 0x0a, 0x09, 0x72, 0x5f, 0x6d, 0x69, 0x64,
+	0x64, 0x6c, 0x65, 0x32, 0x18, 0x20, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x70, 0x6f,
+	0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x08, 0x72, 0x4d,
+	0x69, 0x64, 0x64, 0x6c, 0x65, 0x32, 0x12, 0x3d, 0x0a, 0x09, 0x72, 0x5f, 0x6d, 0x69, 0x64, 0x64,
+	0x6c, 0x65, 0x33, 0x18, 0x21, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x70, 0x6f, 0x72,
+	0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x08, 0x72, 0x4d, 0x69,
+	0x64, 0x64, 0x6c, 0x65, 0x33, 0x12, 0x3b, 0x0a, 0x08, 0x72, 0x5f, 0x6d, 0x69, 0x64, 0x64, 0x6c,
+	0x65, 0x18, 0x22, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73,
+	0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x07, 0x72, 0x4d, 0x69, 0x64, 0x64,
+	0x6c, 0x65, 0x12, 0x39, 0x0a, 0x07, 0x72, 0x5f, 0x72, 0x69, 0x6e, 0x67, 0x31, 0x18, 0x23, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4b, 0x65, 0x79,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x06, 0x72, 0x52, 0x69, 0x6e, 0x67, 0x31, 0x12, 0x39, 0x0a,
+	0x07, 0x72, 0x5f, 0x72, 0x69, 0x6e, 0x67, 0x32, 0x18, 0x24, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20,
+	0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x52, 0x06, 0x72, 0x52, 0x69, 0x6e, 0x67, 0x32, 0x12, 0x39, 0x0a, 0x07, 0x72, 0x5f, 0x72, 0x69,
+	0x6e, 0x67, 0x33, 0x18, 0x25, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x70, 0x6f, 0x72,
+	0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x06, 0x72, 0x52, 0x69,
+	0x6e, 0x67, 0x33, 0x12, 0x37, 0x0a, 0x06, 0x72, 0x5f, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x26, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4b, 0x65, 0x79,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x05, 0x72, 0x52, 0x69, 0x6e, 0x67, 0x12, 0x3b, 0x0a, 0x08,
+	0x72, 0x5f, 0x70, 0x69, 0x6e, 0x6b, 0x79, 0x31, 0x18, 0x27, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20,
+	0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x52, 0x07, 0x72, 0x50, 0x69, 0x6e, 0x6b, 0x79, 0x31, 0x12, 0x3b, 0x0a, 0x08, 0x72, 0x5f, 0x70,
+	0x69, 0x6e, 0x6b, 0x79, 0x32, 0x18, 0x28, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x70,
+	0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x07, 0x72,
+	0x50, 0x69, 0x6e, 0x6b, 0x79, 0x32, 0x12, 0x3b, 0x0a, 0x08, 0x72, 0x5f, 0x70, 0x69, 0x6e, 0x6b,
+	0x79, 0x33, 0x18, 0x29, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74,
+	0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x07, 0x72, 0x50, 0x69, 0x6e,
+	0x6b, 0x79, 0x33, 0x12, 0x39, 0x0a, 0x07, 0x72, 0x5f, 0x70, 0x69, 0x6e, 0x6b, 0x79, 0x18, 0x2a,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65,
+	0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4b, 0x65,
+	0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x06, 0x72, 0x50, 0x69, 0x6e, 0x6b, 0x79, 0x2a, 0x8a,
+	0x01, 0x0a, 0x0e, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x53, 0x63, 0x68, 0x65, 0x6d,
+	0x61, 0x12, 0x1f, 0x0a, 0x1b, 0x4b, 0x45, 0x59, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x5f, 0x53, 0x43,
+	0x48, 0x45, 0x4d, 0x41, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x4b, 0x45, 0x59, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x5f, 0x53,
+	0x43, 0x48, 0x45, 0x4d, 0x41, 0x5f, 0x42, 0x4f, 0x44, 0x59, 0x32, 0x35, 0x10, 0x01, 0x12, 0x1a,
+	0x0a, 0x16, 0x4b, 0x45, 0x59, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x4d,
+	0x41, 0x5f, 0x43, 0x4f, 0x43, 0x4f, 0x31, 0x37, 0x10, 0x02, 0x12, 0x1f, 0x0a, 0x1b, 0x4b, 0x45,
+	0x59, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x4d, 0x41, 0x5f, 0x4d, 0x45,
+	0x44, 0x49, 0x41, 0x50, 0x49, 0x50, 0x45, 0x33, 0x33, 0x10, 0x03, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1033,81 +1103,83 @@ func file_common_proto_rawDescGZIP() []byte {
 	return file_common_proto_rawDescData
 }
 
+var file_common_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
 var file_common_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_common_proto_goTypes = []interface{}{
-	(*Double)(nil),              // 0: sports_keypoints_proto.Double
-	(*Keypoint)(nil),            // 1: sports_keypoints_proto.Keypoint
-	(*Body25PoseKeypoints)(nil), // 2: sports_keypoints_proto.Body25PoseKeypoints
-	(*Body25HandKeypoints)(nil), // 3: sports_keypoints_proto.Body25HandKeypoints
+	(KeypointSchema)(0),         // 0: sports_keypoints_proto.KeypointSchema
+	(*Double)(nil),              // 1: sports_keypoints_proto.Double
+	(*Keypoint)(nil),            // 2: sports_keypoints_proto.Keypoint
+	(*Body25PoseKeypoints)(nil), // 3: sports_keypoints_proto.Body25PoseKeypoints
+	(*Body25HandKeypoints)(nil), // 4: sports_keypoints_proto.Body25HandKeypoints
 }
 var file_common_proto_depIdxs = []int32{
-	1,  // 0: sports_keypoints_proto.Body25PoseKeypoints.nose:type_name -> sports_keypoints_proto.Keypoint
-	1,  // 1: sports_keypoints_proto.Body25PoseKeypoints.neck:type_name -> sports_keypoints_proto.Keypoint
-	1,  // 2: sports_keypoints_proto.Body25PoseKeypoints.r_shoulder:type_name -> sports_keypoints_proto.Keypoint
-	1,  // 3: sports_keypoints_proto.Body25PoseKeypoints.r_elbow:type_name -> sports_keypoints_proto.Keypoint
-	1,  // 4: sports_keypoints_proto.Body25PoseKeypoints.r_wrist:type_name -> sports_keypoints_proto.Keypoint
-	1,  // 5: sports_keypoints_proto.Body25PoseKeypoints.l_shoulder:type_name -> sports_keypoints_proto.Keypoint
-	1,  // 6: sports_keypoints_proto.Body25PoseKeypoints.l_elbow:type_name -> sports_keypoints_proto.Keypoint
-	1,  // 7: sports_keypoints_proto.Body25PoseKeypoints.l_wrist:type_name -> sports_keypoints_proto.Keypoint
-	1,  // 8: sports_keypoints_proto.Body25PoseKeypoints.midhip:type_name -> sports_keypoints_proto.Keypoint
-	1,  // 9: sports_keypoints_proto.Body25PoseKeypoints.r_hip:type_name -> sports_keypoints_proto.Keypoint
-	1,  // 10: sports_keypoints_proto.Body25PoseKeypoints.r_knee:type_name -> sports_keypoints_proto.Keypoint
-	1,  // 11: sports_keypoints_proto.Body25PoseKeypoints.r_ankle:type_name -> sports_keypoints_proto.Keypoint
-	1,  // 12: sports_keypoints_proto.Body25PoseKeypoints.l_hip:type_name -> sports_keypoints_proto.Keypoint
-	1,  // 13: sports_keypoints_proto.Body25PoseKeypoints.l_knee:type_name -> sports_keypoints_proto.Keypoint
-	1,  // 14: sports_keypoints_proto.Body25PoseKeypoints.l_ankle:type_name -> sports_keypoints_proto.Keypoint
-	1,  // 15: sports_keypoints_proto.Body25PoseKeypoints.r_eye:type_name -> sports_keypoints_proto.Keypoint
-	1,  // 16: sports_keypoints_proto.Body25PoseKeypoints.l_eye:type_name -> sports_keypoints_proto.Keypoint
-	1,  // 17: sports_keypoints_proto.Body25PoseKeypoints.r_ear:type_name -> sports_keypoints_proto.Keypoint
-	1,  // 18: sports_keypoints_proto.Body25PoseKeypoints.l_ear:type_name -> sports_keypoints_proto.Keypoint
-	1,  // 19: sports_keypoints_proto.Body25PoseKeypoints.l_big_toe:type_name -> sports_keypoints_proto.Keypoint
-	1,  // 20: sports_keypoints_proto.Body25PoseKeypoints.l_small_toe:type_name -> sports_keypoints_proto.Keypoint
-	1,  // 21: sports_keypoints_proto.Body25PoseKeypoints.l_heel:type_name -> sports_keypoints_proto.Keypoint
-	1,  // 22: sports_keypoints_proto.Body25PoseKeypoints.r_big_toe:type_name -> sports_keypoints_proto.Keypoint
-	1,  // 23: sports_keypoints_proto.Body25PoseKeypoints.r_small_toe:type_name -> sports_keypoints_proto.Keypoint
-	1,  // 24: sports_keypoints_proto.Body25PoseKeypoints.r_heel:type_name -> sports_keypoints_proto.Keypoint
-	1,  // 25: sports_keypoints_proto.Body25HandKeypoints.l_wrist:type_name -> sports_keypoints_proto.Keypoint
-	1,  // 26: sports_keypoints_proto.Body25HandKeypoints.l_thumb1:type_name -> sports_keypoints_proto.Keypoint
-	1,  // 27: sports_keypoints_proto.Body25HandKeypoints.l_thumb2:type_name -> sports_keypoints_proto.Keypoint
-	1,  // 28: sports_keypoints_proto.Body25HandKeypoints.l_thumb3:type_name -> sports_keypoints_proto.Keypoint
-	1,  // 29: sports_keypoints_proto.Body25HandKeypoints.l_thumb:type_name -> sports_keypoints_proto.Keypoint
-	1,  // 30: sports_keypoints_proto.Body25HandKeypoints.l_index1:type_name -> sports_keypoints_proto.Keypoint
-	1,  // 31: sports_keypoints_proto.Body25HandKeypoints.l_index2:type_name -> sports_keypoints_proto.Keypoint
-	1,  // 32: sports_keypoints_proto.Body25HandKeypoints.l_index3:type_name -> sports_keypoints_proto.Keypoint
-	1,  // 33: sports_keypoints_proto.Body25HandKeypoints.l_index:type_name -> sports_keypoints_proto.Keypoint
-	1,  // 34: sports_keypoints_proto.Body25HandKeypoints.l_middle1:type_name -> sports_keypoints_proto.Keypoint
-	1,  // 35: sports_keypoints_proto.Body25HandKeypoints.l_middle2:type_name -> sports_keypoints_proto.Keypoint
-	1,  // 36: sports_keypoints_proto.Body25HandKeypoints.l_middle3:type_name -> sports_keypoints_proto.Keypoint
-	1,  // 37: sports_keypoints_proto.Body25HandKeypoints.l_middle:type_name -> sports_keypoints_proto.Keypoint
-	1,  // 38: sports_keypoints_proto.Body25HandKeypoints.l_ring1:type_name -> sports_keypoints_proto.Keypoint
-	1,  // 39: sports_keypoints_proto.Body25HandKeypoints.l_ring2:type_name -> sports_keypoints_proto.Keypoint
-	1,  // 40: sports_keypoints_proto.Body25HandKeypoints.l_ring3:type_name -> sports_keypoints_proto.Keypoint
-	1,  // 41: sports_keypoints_proto.Body25HandKeypoints.l_ring:type_name -> sports_keypoints_proto.Keypoint
-	1,  // 42: sports_keypoints_proto.Body25HandKeypoints.l_pinky1:type_name -> sports_keypoints_proto.Keypoint
-	1,  // 43: sports_keypoints_proto.Body25HandKeypoints.l_pinky2:type_name -> sports_keypoints_proto.Keypoint
-	1,  // 44: sports_keypoints_proto.Body25HandKeypoints.l_pinky3:type_name -> sports_keypoints_proto.Keypoint
-	1,  // 45: sports_keypoints_proto.Body25HandKeypoints.l_pinky:type_name -> sports_keypoints_proto.Keypoint
-	1,  // 46: sports_keypoints_proto.Body25HandKeypoints.r_wrist:type_name -> sports_keypoints_proto.Keypoint
-	1,  // 47: sports_keypoints_proto.Body25HandKeypoints.r_thumb1:type_name -> sports_keypoints_proto.Keypoint
-	1,  // 48: sports_keypoints_proto.Body25HandKeypoints.r_thumb2:type_name -> sports_keypoints_proto.Keypoint
-	1,  // 49: sports_keypoints_proto.Body25HandKeypoints.r_thumb3:type_name -> sports_keypoints_proto.Keypoint
-	1,  // 50: sports_keypoints_proto.Body25HandKeypoints.r_thumb:type_name -> sports_keypoints_proto.Keypoint
-	1,  // 51: sports_keypoints_proto.Body25HandKeypoints.r_index1:type_name -> sports_keypoints_proto.Keypoint
-	1,  // 52: sports_keypoints_proto.Body25HandKeypoints.r_index2:type_name -> sports_keypoints_proto.Keypoint
-	1,  // 53: sports_keypoints_proto.Body25HandKeypoints.r_index3:type_name -> sports_keypoints_proto.Keypoint
-	1,  // 54: sports_keypoints_proto.Body25HandKeypoints.r_index:type_name -> sports_keypoints_proto.Keypoint
-	1,  // 55: sports_keypoints_proto.Body25HandKeypoints.r_middle1:type_name -> sports_keypoints_proto.Keypoint
-	1,  // 56: sports_keypoints_proto.Body25HandKeypoints.r_middle2:type_name -> sports_keypoints_proto.Keypoint
-	1,  // 57: sports_keypoints_proto.Body25HandKeypoints.r_middle3:type_name -> sports_keypoints_proto.Keypoint
-	1,  // 58: sports_keypoints_proto.Body25HandKeypoints.r_middle:type_name -> sports_keypoints_proto.Keypoint
-	1,  // 59: sports_keypoints_proto.Body25HandKeypoints.r_ring1:type_name -> sports_keypoints_proto.Keypoint
-	1,  // 60: sports_keypoints_proto.Body25HandKeypoints.r_ring2:type_name -> sports_keypoints_proto.Keypoint
-	1,  // 61: sports_keypoints_proto.Body25HandKeypoints.r_ring3:type_name -> sports_keypoints_proto.Keypoint
-	1,  // 62: sports_keypoints_proto.Body25HandKeypoints.r_ring:type_name -> sports_keypoints_proto.Keypoint
-	1,  // 63: sports_keypoints_proto.Body25HandKeypoints.r_pinky1:type_name -> sports_keypoints_proto.Keypoint
-	1,  // 64: sports_keypoints_proto.Body25HandKeypoints.r_pinky2:type_name -> sports_keypoints_proto.Keypoint
-	1,  // 65: sports_keypoints_proto.Body25HandKeypoints.r_pinky3:type_name -> sports_keypoints_proto.Keypoint
-	1,  // 66: sports_keypoints_proto.Body25HandKeypoints.r_pinky:type_name -> sports_keypoints_proto.Keypoint
+	2,  // 0: sports_keypoints_proto.Body25PoseKeypoints.nose:type_name -> sports_keypoints_proto.Keypoint
+	2,  // 1: sports_keypoints_proto.Body25PoseKeypoints.neck:type_name -> sports_keypoints_proto.Keypoint
+	2,  // 2: sports_keypoints_proto.Body25PoseKeypoints.r_shoulder:type_name -> sports_keypoints_proto.Keypoint
+	2,  // 3: sports_keypoints_proto.Body25PoseKeypoints.r_elbow:type_name -> sports_keypoints_proto.Keypoint
+	2,  // 4: sports_keypoints_proto.Body25PoseKeypoints.r_wrist:type_name -> sports_keypoints_proto.Keypoint
+	2,  // 5: sports_keypoints_proto.Body25PoseKeypoints.l_shoulder:type_name -> sports_keypoints_proto.Keypoint
+	2,  // 6: sports_keypoints_proto.Body25PoseKeypoints.l_elbow:type_name -> sports_keypoints_proto.Keypoint
+	2,  // 7: sports_keypoints_proto.Body25PoseKeypoints.l_wrist:type_name -> sports_keypoints_proto.Keypoint
+	2,  // 8: sports_keypoints_proto.Body25PoseKeypoints.midhip:type_name -> sports_keypoints_proto.Keypoint
+	2,  // 9: sports_keypoints_proto.Body25PoseKeypoints.r_hip:type_name -> sports_keypoints_proto.Keypoint
+	2,  // 10: sports_keypoints_proto.Body25PoseKeypoints.r_knee:type_name -> sports_keypoints_proto.Keypoint
+	2,  // 11: sports_keypoints_proto.Body25PoseKeypoints.r_ankle:type_name -> sports_keypoints_proto.Keypoint
+	2,  // 12: sports_keypoints_proto.Body25PoseKeypoints.l_hip:type_name -> sports_keypoints_proto.Keypoint
+	2,  // 13: sports_keypoints_proto.Body25PoseKeypoints.l_knee:type_name -> sports_keypoints_proto.Keypoint
+	2,  // 14: sports_keypoints_proto.Body25PoseKeypoints.l_ankle:type_name -> sports_keypoints_proto.Keypoint
+	2,  // 15: sports_keypoints_proto.Body25PoseKeypoints.r_eye:type_name -> sports_keypoints_proto.Keypoint
+	2,  // 16: sports_keypoints_proto.Body25PoseKeypoints.l_eye:type_name -> sports_keypoints_proto.Keypoint
+	2,  // 17: sports_keypoints_proto.Body25PoseKeypoints.r_ear:type_name -> sports_keypoints_proto.Keypoint
+	2,  // 18: sports_keypoints_proto.Body25PoseKeypoints.l_ear:type_name -> sports_keypoints_proto.Keypoint
+	2,  // 19: sports_keypoints_proto.Body25PoseKeypoints.l_big_toe:type_name -> sports_keypoints_proto.Keypoint
+	2,  // 20: sports_keypoints_proto.Body25PoseKeypoints.l_small_toe:type_name -> sports_keypoints_proto.Keypoint
+	2,  // 21: sports_keypoints_proto.Body25PoseKeypoints.l_heel:type_name -> sports_keypoints_proto.Keypoint
+	2,  // 22: sports_keypoints_proto.Body25PoseKeypoints.r_big_toe:type_name -> sports_keypoints_proto.Keypoint
+	2,  // 23: sports_keypoints_proto.Body25PoseKeypoints.r_small_toe:type_name -> sports_keypoints_proto.Keypoint
+	2,  // 24: sports_keypoints_proto.Body25PoseKeypoints.r_heel:type_name -> sports_keypoints_proto.Keypoint
+	2,  // 25: sports_keypoints_proto.Body25HandKeypoints.l_wrist:type_name -> sports_keypoints_proto.Keypoint
+	2,  // 26: sports_keypoints_proto.Body25HandKeypoints.l_thumb1:type_name -> sports_keypoints_proto.Keypoint
+	2,  // 27: sports_keypoints_proto.Body25HandKeypoints.l_thumb2:type_name -> sports_keypoints_proto.Keypoint
+	2,  // 28: sports_keypoints_proto.Body25HandKeypoints.l_thumb3:type_name -> sports_keypoints_proto.Keypoint
+	2,  // 29: sports_keypoints_proto.Body25HandKeypoints.l_thumb:type_name -> sports_keypoints_proto.Keypoint
+	2,  // 30: sports_keypoints_proto.Body25HandKeypoints.l_index1:type_name -> sports_keypoints_proto.Keypoint
+	2,  // 31: sports_keypoints_proto.Body25HandKeypoints.l_index2:type_name -> sports_keypoints_proto.Keypoint
+	2,  // 32: sports_keypoints_proto.Body25HandKeypoints.l_index3:type_name -> sports_keypoints_proto.Keypoint
+	2,  // 33: sports_keypoints_proto.Body25HandKeypoints.l_index:type_name -> sports_keypoints_proto.Keypoint
+	2,  // 34: sports_keypoints_proto.Body25HandKeypoints.l_middle1:type_name -> sports_keypoints_proto.Keypoint
+	2,  // 35: sports_keypoints_proto.Body25HandKeypoints.l_middle2:type_name -> sports_keypoints_proto.Keypoint
+	2,  // 36: sports_keypoints_proto.Body25HandKeypoints.l_middle3:type_name -> sports_keypoints_proto.Keypoint
+	2,  // 37: sports_keypoints_proto.Body25HandKeypoints.l_middle:type_name -> sports_keypoints_proto.Keypoint
+	2,  // 38: sports_keypoints_proto.Body25HandKeypoints.l_ring1:type_name -> sports_keypoints_proto.Keypoint
+	2,  // 39: sports_keypoints_proto.Body25HandKeypoints.l_ring2:type_name -> sports_keypoints_proto.Keypoint
+	2,  // 40: sports_keypoints_proto.Body25HandKeypoints.l_ring3:type_name -> sports_keypoints_proto.Keypoint
+	2,  // 41: sports_keypoints_proto.Body25HandKeypoints.l_ring:type_name -> sports_keypoints_proto.Keypoint
+	2,  // 42: sports_keypoints_proto.Body25HandKeypoints.l_pinky1:type_name -> sports_keypoints_proto.Keypoint
+	2,  // 43: sports_keypoints_proto.Body25HandKeypoints.l_pinky2:type_name -> sports_keypoints_proto.Keypoint
+	2,  // 44: sports_keypoints_proto.Body25HandKeypoints.l_pinky3:type_name -> sports_keypoints_proto.Keypoint
+	2,  // 45: sports_keypoints_proto.Body25HandKeypoints.l_pinky:type_name -> sports_keypoints_proto.Keypoint
+	2,  // 46: sports_keypoints_proto.Body25HandKeypoints.r_wrist:type_name -> sports_keypoints_proto.Keypoint
+	2,  // 47: sports_keypoints_proto.Body25HandKeypoints.r_thumb1:type_name -> sports_keypoints_proto.Keypoint
+	2,  // 48: sports_keypoints_proto.Body25HandKeypoints.r_thumb2:type_name -> sports_keypoints_proto.Keypoint
+	2,  // 49: sports_keypoints_proto.Body25HandKeypoints.r_thumb3:type_name -> sports_keypoints_proto.Keypoint
+	2,  // 50: sports_keypoints_proto.Body25HandKeypoints.r_thumb:type_name -> sports_keypoints_proto.Keypoint
+	2,  // 51: sports_keypoints_proto.Body25HandKeypoints.r_index1:type_name -> sports_keypoints_proto.Keypoint
+	2,  // 52: sports_keypoints_proto.Body25HandKeypoints.r_index2:type_name -> sports_keypoints_proto.Keypoint
+	2,  // 53: sports_keypoints_proto.Body25HandKeypoints.r_index3:type_name -> sports_keypoints_proto.Keypoint
+	2,  // 54: sports_keypoints_proto.Body25HandKeypoints.r_index:type_name -> sports_keypoints_proto.Keypoint
+	2,  // 55: sports_keypoints_proto.Body25HandKeypoints.r_middle1:type_name -> sports_keypoints_proto.Keypoint
+	2,  // 56: sports_keypoints_proto.Body25HandKeypoints.r_middle2:type_name -> sports_keypoints_proto.Keypoint
+	2,  // 57: sports_keypoints_proto.Body25HandKeypoints.r_middle3:type_name -> sports_keypoints_proto.Keypoint
+	2,  // 58: sports_keypoints_proto.Body25HandKeypoints.r_middle:type_name -> sports_keypoints_proto.Keypoint
+	2,  // 59: sports_keypoints_proto.Body25HandKeypoints.r_ring1:type_name -> sports_keypoints_proto.Keypoint
+	2,  // 60: sports_keypoints_proto.Body25HandKeypoints.r_ring2:type_name -> sports_keypoints_proto.Keypoint
+	2,  // 61: sports_keypoints_proto.Body25HandKeypoints.r_ring3:type_name -> sports_keypoints_proto.Keypoint
+	2,  // 62: sports_keypoints_proto.Body25HandKeypoints.r_ring:type_name -> sports_keypoints_proto.Keypoint
+	2,  // 63: sports_keypoints_proto.Body25HandKeypoints.r_pinky1:type_name -> sports_keypoints_proto.Keypoint
+	2,  // 64: sports_keypoints_proto.Body25HandKeypoints.r_pinky2:type_name -> sports_keypoints_proto.Keypoint
+	2,  // 65: sports_keypoints_proto.Body25HandKeypoints.r_pinky3:type_name -> sports_keypoints_proto.Keypoint
+	2,  // 66: sports_keypoints_proto.Body25HandKeypoints.r_pinky:type_name -> sports_keypoints_proto.Keypoint
 	67, // [67:67] is the sub-list for method output_type
 	67, // [67:67] is the sub-list for method input_type
 	67, // [67:67] is the sub-list for extension type_name
@@ -1120,6 +1192,7 @@ func file_common_proto_init() {
 	if File_common_proto != nil {
 		return
 	}
+	file_validate_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_common_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Double); i {
@@ -1175,13 +1248,14 @@ func file_common_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_common_proto_rawDesc,
-			NumEnums:      0,
+			NumEnums:      1,
 			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_common_proto_goTypes,
 		DependencyIndexes: file_common_proto_depIdxs,
+		EnumInfos:         file_common_proto_enumTypes,
 		MessageInfos:      file_common_proto_msgTypes,
 	}.Build()
 	File_common_proto = out.File
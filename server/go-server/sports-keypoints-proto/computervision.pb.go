@@ -1,6 +1,6 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.25.0-devel
+// 	protoc-gen-go v1.27.1
 // 	protoc        v3.13.0
 // source: computervision.proto
 
@@ -176,6 +176,10 @@ type GetPoseDataResponse struct {
 
 	Success   bool                 `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	Keypoints *Body25PoseKeypoints `protobuf:"bytes,2,opt,name=keypoints,proto3" json:"keypoints,omitempty"`
+	// unspecified is body25. Other models set landmarks (in the order of the schema, x and y in pixels) instead of
+	// keypoints, a missing landmark is a keypoint at 0,0
+	KeypointSchema KeypointSchema `protobuf:"varint,3,opt,name=keypoint_schema,json=keypointSchema,proto3,enum=sports_keypoints_proto.KeypointSchema" json:"keypoint_schema,omitempty"`
+	Landmarks      []*Keypoint    `protobuf:"bytes,4,rep,name=landmarks,proto3" json:"landmarks,omitempty"`
 }
 
 func (x *GetPoseDataResponse) Reset() {
@@ -224,6 +228,20 @@ func (x *GetPoseDataResponse) GetKeypoints() *Body25PoseKeypoints {
 	return nil
 }
 
+func (x *GetPoseDataResponse) GetKeypointSchema() KeypointSchema {
+	if x != nil {
+		return x.KeypointSchema
+	}
+	return KeypointSchema_KEYPOINT_SCHEMA_UNSPECIFIED
+}
+
+func (x *GetPoseDataResponse) GetLandmarks() []*Keypoint {
+	if x != nil {
+		return x.Landmarks
+	}
+	return nil
+}
+
 type GetPoseHandImageRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -484,6 +502,9 @@ type GetPoseAllResponse struct {
 	Image         []byte               `protobuf:"bytes,2,opt,name=image,proto3" json:"image,omitempty"`
 	PoseKeypoints *Body25PoseKeypoints `protobuf:"bytes,3,opt,name=pose_keypoints,json=poseKeypoints,proto3" json:"pose_keypoints,omitempty"`
 	HandKeypoints *Body25HandKeypoints `protobuf:"bytes,4,opt,name=hand_keypoints,json=handKeypoints,proto3" json:"hand_keypoints,omitempty"`
+	// same as in GetPoseDataResponse, landmarks are set instead of pose_keypoints
+	KeypointSchema KeypointSchema `protobuf:"varint,5,opt,name=keypoint_schema,json=keypointSchema,proto3,enum=sports_keypoints_proto.KeypointSchema" json:"keypoint_schema,omitempty"`
+	Landmarks      []*Keypoint    `protobuf:"bytes,6,rep,name=landmarks,proto3" json:"landmarks,omitempty"`
 }
 
 func (x *GetPoseAllResponse) Reset() {
@@ -546,6 +567,20 @@ func (x *GetPoseAllResponse) GetHandKeypoints() *Body25HandKeypoints {
 	return nil
 }
 
+func (x *GetPoseAllResponse) GetKeypointSchema() KeypointSchema {
+	if x != nil {
+		return x.KeypointSchema
+	}
+	return KeypointSchema_KEYPOINT_SCHEMA_UNSPECIFIED
+}
+
+func (x *GetPoseAllResponse) GetLandmarks() []*Keypoint {
+	if x != nil {
+		return x.Landmarks
+	}
+	return nil
+}
+
 var File_computervision_proto protoreflect.FileDescriptor
 
 var file_computervision_proto_rawDesc = []byte{
@@ -562,128 +597,146 @@ var file_computervision_proto_rawDesc = []byte{
 	0x6d, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67,
 	0x65, 0x22, 0x2a, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x65, 0x44, 0x61, 0x74, 0x61,
 	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x22, 0x7a, 0x0a,
-	0x13, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x49,
-	0x0a, 0x09, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x2b, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f,
-	0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6f, 0x64, 0x79, 0x32,
-	0x35, 0x50, 0x6f, 0x73, 0x65, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x09,
-	0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22, 0x2f, 0x0a, 0x17, 0x47, 0x65, 0x74,
-	0x50, 0x6f, 0x73, 0x65, 0x48, 0x61, 0x6e, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x22, 0x4a, 0x0a, 0x18, 0x47, 0x65,
-	0x74, 0x50, 0x6f, 0x73, 0x65, 0x48, 0x61, 0x6e, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x12, 0x14, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x22, 0x2e, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73,
-	0x65, 0x48, 0x61, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x14, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x22, 0x7e, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73,
-	0x65, 0x48, 0x61, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x49, 0x0a, 0x09, 0x6b,
-	0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x22, 0x8b, 0x02,
+	0x0a, 0x13, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12,
+	0x49, 0x0a, 0x09, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6f, 0x64, 0x79,
+	0x32, 0x35, 0x50, 0x6f, 0x73, 0x65, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52,
+	0x09, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x4f, 0x0a, 0x0f, 0x6b, 0x65,
+	0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4b, 0x65, 0x79,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x0e, 0x6b, 0x65, 0x79,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x3e, 0x0a, 0x09, 0x6c,
+	0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20,
 	0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74,
-	0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6f, 0x64, 0x79, 0x32, 0x35, 0x48, 0x61,
-	0x6e, 0x64, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x09, 0x6b, 0x65, 0x79,
-	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22, 0x29, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73,
-	0x65, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x69,
-	0x6d, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67,
-	0x65, 0x22, 0xec, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x65, 0x41, 0x6c, 0x6c,
+	0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x52, 0x09, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x22, 0x2f, 0x0a, 0x17, 0x47,
+	0x65, 0x74, 0x50, 0x6f, 0x73, 0x65, 0x48, 0x61, 0x6e, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x22, 0x4a, 0x0a, 0x18,
+	0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x65, 0x48, 0x61, 0x6e, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65,
 	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63,
 	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
 	0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x52, 0x0a, 0x0e, 0x70, 0x6f, 0x73, 0x65,
-	0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x0c, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x22, 0x2e, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x50,
+	0x6f, 0x73, 0x65, 0x48, 0x61, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x22, 0x7e, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x50,
+	0x6f, 0x73, 0x65, 0x48, 0x61, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x49, 0x0a,
+	0x09, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x2b, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69,
 	0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6f, 0x64, 0x79, 0x32, 0x35,
-	0x50, 0x6f, 0x73, 0x65, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x0d, 0x70,
-	0x6f, 0x73, 0x65, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x52, 0x0a, 0x0e,
-	0x68, 0x61, 0x6e, 0x64, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65,
-	0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6f,
-	0x64, 0x79, 0x32, 0x35, 0x48, 0x61, 0x6e, 0x64, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74,
-	0x73, 0x52, 0x0d, 0x68, 0x61, 0x6e, 0x64, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73,
-	0x32, 0xb6, 0x09, 0x0a, 0x15, 0x43, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x72, 0x56, 0x69, 0x73,
-	0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x6b, 0x0a, 0x0c, 0x47, 0x65,
-	0x74, 0x50, 0x6f, 0x73, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x2b, 0x2e, 0x73, 0x70, 0x6f,
-	0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73,
-	0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x68, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x50, 0x6f,
-	0x73, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x2a, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f,
+	0x48, 0x61, 0x6e, 0x64, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x09, 0x6b,
+	0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22, 0x29, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x50,
+	0x6f, 0x73, 0x65, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x69, 0x6d,
+	0x61, 0x67, 0x65, 0x22, 0xfd, 0x02, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x65, 0x41,
+	0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x52, 0x0a, 0x0e, 0x70, 0x6f,
+	0x73, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6f, 0x64, 0x79,
+	0x32, 0x35, 0x50, 0x6f, 0x73, 0x65, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52,
+	0x0d, 0x70, 0x6f, 0x73, 0x65, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x52,
+	0x0a, 0x0e, 0x68, 0x61, 0x6e, 0x64, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f,
 	0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70,
-	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x50,
-	0x6f, 0x73, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x77, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x65, 0x48, 0x61, 0x6e, 0x64,
-	0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x2f, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b,
-	0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47,
-	0x65, 0x74, 0x50, 0x6f, 0x73, 0x65, 0x48, 0x61, 0x6e, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f,
+	0x42, 0x6f, 0x64, 0x79, 0x32, 0x35, 0x48, 0x61, 0x6e, 0x64, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x73, 0x52, 0x0d, 0x68, 0x61, 0x6e, 0x64, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x73, 0x12, 0x4f, 0x0a, 0x0f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x73,
+	0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x73, 0x70,
+	0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x53, 0x63, 0x68,
+	0x65, 0x6d, 0x61, 0x52, 0x0e, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x53, 0x63, 0x68,
+	0x65, 0x6d, 0x61, 0x12, 0x3e, 0x0a, 0x09, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x73,
+	0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f,
 	0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x65, 0x48, 0x61, 0x6e, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x74, 0x0a, 0x0f, 0x47, 0x65,
-	0x74, 0x50, 0x6f, 0x73, 0x65, 0x48, 0x61, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x61, 0x12, 0x2e, 0x2e,
+	0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x09, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61,
+	0x72, 0x6b, 0x73, 0x32, 0xb6, 0x09, 0x0a, 0x15, 0x43, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x72,
+	0x56, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x6b, 0x0a,
+	0x0c, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x2b, 0x2e,
 	0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73,
-	0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x65, 0x48, 0x61,
-	0x6e, 0x64, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e,
-	0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73,
-	0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x65, 0x48, 0x61,
-	0x6e, 0x64, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x65, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x65, 0x41, 0x6c, 0x6c, 0x12, 0x29,
-	0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74,
-	0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x65, 0x41,
-	0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x73, 0x70, 0x6f, 0x72,
-	0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x65, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x79, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x50, 0x6f,
-	0x73, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x46, 0x72, 0x6f, 0x6d, 0x56, 0x69, 0x64, 0x65,
-	0x6f, 0x12, 0x2b, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f,
-	0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f,
-	0x73, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c,
-	0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74,
-	0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x65, 0x49,
-	0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01,
-	0x30, 0x01, 0x12, 0x75, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x65, 0x44, 0x61, 0x74,
-	0x61, 0x46, 0x72, 0x6f, 0x6d, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x12, 0x2a, 0x2e, 0x73, 0x70, 0x6f,
+	0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x65, 0x49, 0x6d,
+	0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x73, 0x70, 0x6f,
 	0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f,
-	0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12, 0x85, 0x01, 0x0a, 0x1a, 0x47, 0x65,
-	0x74, 0x50, 0x6f, 0x73, 0x65, 0x48, 0x61, 0x6e, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x46,
-	0x72, 0x6f, 0x6d, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x12, 0x2f, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74,
+	0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x68, 0x0a, 0x0b, 0x47, 0x65,
+	0x74, 0x50, 0x6f, 0x73, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x2a, 0x2e, 0x73, 0x70, 0x6f, 0x72,
+	0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b,
+	0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47,
+	0x65, 0x74, 0x50, 0x6f, 0x73, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x77, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x65, 0x48,
+	0x61, 0x6e, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x2f, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74,
 	0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x65, 0x48, 0x61, 0x6e, 0x64, 0x49, 0x6d, 0x61,
 	0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x73, 0x70, 0x6f, 0x72,
 	0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x65, 0x48, 0x61, 0x6e, 0x64, 0x49, 0x6d,
-	0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x30,
-	0x01, 0x12, 0x81, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x65, 0x48, 0x61, 0x6e,
-	0x64, 0x44, 0x61, 0x74, 0x61, 0x46, 0x72, 0x6f, 0x6d, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x12, 0x2e,
-	0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74,
-	0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x65, 0x48,
-	0x61, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f,
-	0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74,
-	0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x65, 0x48,
-	0x61, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x28, 0x01, 0x30, 0x01, 0x12, 0x72, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x65,
-	0x41, 0x6c, 0x6c, 0x46, 0x72, 0x6f, 0x6d, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x12, 0x29, 0x2e, 0x73,
+	0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x74, 0x0a,
+	0x0f, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x65, 0x48, 0x61, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x61,
+	0x12, 0x2e, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73,
+	0x65, 0x48, 0x61, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2f, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73,
+	0x65, 0x48, 0x61, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x65, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x65, 0x41, 0x6c,
+	0x6c, 0x12, 0x29, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f,
+	0x73, 0x65, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x73,
 	0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x65, 0x41, 0x6c, 0x6c,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73,
-	0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x65, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x79, 0x0a, 0x16, 0x47, 0x65,
+	0x74, 0x50, 0x6f, 0x73, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x46, 0x72, 0x6f, 0x6d, 0x56,
+	0x69, 0x64, 0x65, 0x6f, 0x12, 0x2b, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65,
+	0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65,
+	0x74, 0x50, 0x6f, 0x73, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2c, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f,
+	0x73, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x28, 0x01, 0x30, 0x01, 0x12, 0x75, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x65,
+	0x44, 0x61, 0x74, 0x61, 0x46, 0x72, 0x6f, 0x6d, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x12, 0x2a, 0x2e,
+	0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73,
+	0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x65, 0x44, 0x61,
+	0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x73, 0x70, 0x6f, 0x72,
+	0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12, 0x85, 0x01, 0x0a,
+	0x1a, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x65, 0x48, 0x61, 0x6e, 0x64, 0x49, 0x6d, 0x61, 0x67,
+	0x65, 0x73, 0x46, 0x72, 0x6f, 0x6d, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x12, 0x2f, 0x2e, 0x73, 0x70,
+	0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x65, 0x48, 0x61, 0x6e, 0x64,
+	0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x73,
+	0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x65, 0x48, 0x61, 0x6e,
+	0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x28, 0x01, 0x30, 0x01, 0x12, 0x81, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x65,
+	0x48, 0x61, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x61, 0x46, 0x72, 0x6f, 0x6d, 0x56, 0x69, 0x64, 0x65,
+	0x6f, 0x12, 0x2e, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f,
+	0x73, 0x65, 0x48, 0x61, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2f, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f,
+	0x73, 0x65, 0x48, 0x61, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12, 0x72, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x50,
+	0x6f, 0x73, 0x65, 0x41, 0x6c, 0x6c, 0x46, 0x72, 0x6f, 0x6d, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x12,
+	0x29, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x65,
+	0x41, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x73, 0x70, 0x6f,
+	0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x65, 0x41, 0x6c, 0x6c, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -711,38 +764,44 @@ var file_computervision_proto_goTypes = []interface{}{
 	(*GetPoseAllRequest)(nil),        // 8: sports_keypoints_proto.GetPoseAllRequest
 	(*GetPoseAllResponse)(nil),       // 9: sports_keypoints_proto.GetPoseAllResponse
 	(*Body25PoseKeypoints)(nil),      // 10: sports_keypoints_proto.Body25PoseKeypoints
-	(*Body25HandKeypoints)(nil),      // 11: sports_keypoints_proto.Body25HandKeypoints
+	(KeypointSchema)(0),              // 11: sports_keypoints_proto.KeypointSchema
+	(*Keypoint)(nil),                 // 12: sports_keypoints_proto.Keypoint
+	(*Body25HandKeypoints)(nil),      // 13: sports_keypoints_proto.Body25HandKeypoints
 }
 var file_computervision_proto_depIdxs = []int32{
 	10, // 0: sports_keypoints_proto.GetPoseDataResponse.keypoints:type_name -> sports_keypoints_proto.Body25PoseKeypoints
-	11, // 1: sports_keypoints_proto.GetPoseHandDataResponse.keypoints:type_name -> sports_keypoints_proto.Body25HandKeypoints
-	10, // 2: sports_keypoints_proto.GetPoseAllResponse.pose_keypoints:type_name -> sports_keypoints_proto.Body25PoseKeypoints
-	11, // 3: sports_keypoints_proto.GetPoseAllResponse.hand_keypoints:type_name -> sports_keypoints_proto.Body25HandKeypoints
-	0,  // 4: sports_keypoints_proto.ComputerVisionService.GetPoseImage:input_type -> sports_keypoints_proto.GetPoseImageRequest
-	2,  // 5: sports_keypoints_proto.ComputerVisionService.GetPoseData:input_type -> sports_keypoints_proto.GetPoseDataRequest
-	4,  // 6: sports_keypoints_proto.ComputerVisionService.GetPoseHandImage:input_type -> sports_keypoints_proto.GetPoseHandImageRequest
-	6,  // 7: sports_keypoints_proto.ComputerVisionService.GetPoseHandData:input_type -> sports_keypoints_proto.GetPoseHandDataRequest
-	8,  // 8: sports_keypoints_proto.ComputerVisionService.GetPoseAll:input_type -> sports_keypoints_proto.GetPoseAllRequest
-	0,  // 9: sports_keypoints_proto.ComputerVisionService.GetPoseImagesFromVideo:input_type -> sports_keypoints_proto.GetPoseImageRequest
-	2,  // 10: sports_keypoints_proto.ComputerVisionService.GetPoseDataFromVideo:input_type -> sports_keypoints_proto.GetPoseDataRequest
-	4,  // 11: sports_keypoints_proto.ComputerVisionService.GetPoseHandImagesFromVideo:input_type -> sports_keypoints_proto.GetPoseHandImageRequest
-	6,  // 12: sports_keypoints_proto.ComputerVisionService.GetPoseHandDataFromVideo:input_type -> sports_keypoints_proto.GetPoseHandDataRequest
-	8,  // 13: sports_keypoints_proto.ComputerVisionService.GetPoseAllFromVideo:input_type -> sports_keypoints_proto.GetPoseAllRequest
-	1,  // 14: sports_keypoints_proto.ComputerVisionService.GetPoseImage:output_type -> sports_keypoints_proto.GetPoseImageResponse
-	3,  // 15: sports_keypoints_proto.ComputerVisionService.GetPoseData:output_type -> sports_keypoints_proto.GetPoseDataResponse
-	5,  // 16: sports_keypoints_proto.ComputerVisionService.GetPoseHandImage:output_type -> sports_keypoints_proto.GetPoseHandImageResponse
-	7,  // 17: sports_keypoints_proto.ComputerVisionService.GetPoseHandData:output_type -> sports_keypoints_proto.GetPoseHandDataResponse
-	9,  // 18: sports_keypoints_proto.ComputerVisionService.GetPoseAll:output_type -> sports_keypoints_proto.GetPoseAllResponse
-	1,  // 19: sports_keypoints_proto.ComputerVisionService.GetPoseImagesFromVideo:output_type -> sports_keypoints_proto.GetPoseImageResponse
-	3,  // 20: sports_keypoints_proto.ComputerVisionService.GetPoseDataFromVideo:output_type -> sports_keypoints_proto.GetPoseDataResponse
-	5,  // 21: sports_keypoints_proto.ComputerVisionService.GetPoseHandImagesFromVideo:output_type -> sports_keypoints_proto.GetPoseHandImageResponse
-	7,  // 22: sports_keypoints_proto.ComputerVisionService.GetPoseHandDataFromVideo:output_type -> sports_keypoints_proto.GetPoseHandDataResponse
-	9,  // 23: sports_keypoints_proto.ComputerVisionService.GetPoseAllFromVideo:output_type -> sports_keypoints_proto.GetPoseAllResponse
-	14, // [14:24] is the sub-list for method output_type
-	4,  // [4:14] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+	11, // 1: sports_keypoints_proto.GetPoseDataResponse.keypoint_schema:type_name -> sports_keypoints_proto.KeypointSchema
+	12, // 2: sports_keypoints_proto.GetPoseDataResponse.landmarks:type_name -> sports_keypoints_proto.Keypoint
+	13, // 3: sports_keypoints_proto.GetPoseHandDataResponse.keypoints:type_name -> sports_keypoints_proto.Body25HandKeypoints
+	10, // 4: sports_keypoints_proto.GetPoseAllResponse.pose_keypoints:type_name -> sports_keypoints_proto.Body25PoseKeypoints
+	13, // 5: sports_keypoints_proto.GetPoseAllResponse.hand_keypoints:type_name -> sports_keypoints_proto.Body25HandKeypoints
+	11, // 6: sports_keypoints_proto.GetPoseAllResponse.keypoint_schema:type_name -> sports_keypoints_proto.KeypointSchema
+	12, // 7: sports_keypoints_proto.GetPoseAllResponse.landmarks:type_name -> sports_keypoints_proto.Keypoint
+	0,  // 8: sports_keypoints_proto.ComputerVisionService.GetPoseImage:input_type -> sports_keypoints_proto.GetPoseImageRequest
+	2,  // 9: sports_keypoints_proto.ComputerVisionService.GetPoseData:input_type -> sports_keypoints_proto.GetPoseDataRequest
+	4,  // 10: sports_keypoints_proto.ComputerVisionService.GetPoseHandImage:input_type -> sports_keypoints_proto.GetPoseHandImageRequest
+	6,  // 11: sports_keypoints_proto.ComputerVisionService.GetPoseHandData:input_type -> sports_keypoints_proto.GetPoseHandDataRequest
+	8,  // 12: sports_keypoints_proto.ComputerVisionService.GetPoseAll:input_type -> sports_keypoints_proto.GetPoseAllRequest
+	0,  // 13: sports_keypoints_proto.ComputerVisionService.GetPoseImagesFromVideo:input_type -> sports_keypoints_proto.GetPoseImageRequest
+	2,  // 14: sports_keypoints_proto.ComputerVisionService.GetPoseDataFromVideo:input_type -> sports_keypoints_proto.GetPoseDataRequest
+	4,  // 15: sports_keypoints_proto.ComputerVisionService.GetPoseHandImagesFromVideo:input_type -> sports_keypoints_proto.GetPoseHandImageRequest
+	6,  // 16: sports_keypoints_proto.ComputerVisionService.GetPoseHandDataFromVideo:input_type -> sports_keypoints_proto.GetPoseHandDataRequest
+	8,  // 17: sports_keypoints_proto.ComputerVisionService.GetPoseAllFromVideo:input_type -> sports_keypoints_proto.GetPoseAllRequest
+	1,  // 18: sports_keypoints_proto.ComputerVisionService.GetPoseImage:output_type -> sports_keypoints_proto.GetPoseImageResponse
+	3,  // 19: sports_keypoints_proto.ComputerVisionService.GetPoseData:output_type -> sports_keypoints_proto.GetPoseDataResponse
+	5,  // 20: sports_keypoints_proto.ComputerVisionService.GetPoseHandImage:output_type -> sports_keypoints_proto.GetPoseHandImageResponse
+	7,  // 21: sports_keypoints_proto.ComputerVisionService.GetPoseHandData:output_type -> sports_keypoints_proto.GetPoseHandDataResponse
+	9,  // 22: sports_keypoints_proto.ComputerVisionService.GetPoseAll:output_type -> sports_keypoints_proto.GetPoseAllResponse
+	1,  // 23: sports_keypoints_proto.ComputerVisionService.GetPoseImagesFromVideo:output_type -> sports_keypoints_proto.GetPoseImageResponse
+	3,  // 24: sports_keypoints_proto.ComputerVisionService.GetPoseDataFromVideo:output_type -> sports_keypoints_proto.GetPoseDataResponse
+	5,  // 25: sports_keypoints_proto.ComputerVisionService.GetPoseHandImagesFromVideo:output_type -> sports_keypoints_proto.GetPoseHandImageResponse
+	7,  // 26: sports_keypoints_proto.ComputerVisionService.GetPoseHandDataFromVideo:output_type -> sports_keypoints_proto.GetPoseHandDataResponse
+	9,  // 27: sports_keypoints_proto.ComputerVisionService.GetPoseAllFromVideo:output_type -> sports_keypoints_proto.GetPoseAllResponse
+	18, // [18:28] is the sub-list for method output_type
+	8,  // [8:18] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_computervision_proto_init() }
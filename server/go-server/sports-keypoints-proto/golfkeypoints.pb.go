@@ -1,15 +1,15 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.25.0-devel
+// 	protoc-gen-go v1.27.1
 // 	protoc        v3.13.0
 // source: golfkeypoints.proto
 
 package sports_keypoints_proto
 
 import (
-	timestamp "github.com/golang/protobuf/ptypes/timestamp"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -21,6 +21,61 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type AnalysisJobState int32
+
+const (
+	AnalysisJobState_ANALYSIS_JOB_STATE_UNSPECIFIED AnalysisJobState = 0
+	AnalysisJobState_ANALYSIS_JOB_QUEUED            AnalysisJobState = 1
+	AnalysisJobState_ANALYSIS_JOB_RUNNING           AnalysisJobState = 2
+	AnalysisJobState_ANALYSIS_JOB_DONE              AnalysisJobState = 3
+	AnalysisJobState_ANALYSIS_JOB_FAILED            AnalysisJobState = 4
+)
+
+// Enum value maps for AnalysisJobState.
+var (
+	AnalysisJobState_name = map[int32]string{
+		0: "ANALYSIS_JOB_STATE_UNSPECIFIED",
+		1: "ANALYSIS_JOB_QUEUED",
+		2: "ANALYSIS_JOB_RUNNING",
+		3: "ANALYSIS_JOB_DONE",
+		4: "ANALYSIS_JOB_FAILED",
+	}
+	AnalysisJobState_value = map[string]int32{
+		"ANALYSIS_JOB_STATE_UNSPECIFIED": 0,
+		"ANALYSIS_JOB_QUEUED":            1,
+		"ANALYSIS_JOB_RUNNING":           2,
+		"ANALYSIS_JOB_DONE":              3,
+		"ANALYSIS_JOB_FAILED":            4,
+	}
+)
+
+func (x AnalysisJobState) Enum() *AnalysisJobState {
+	p := new(AnalysisJobState)
+	*p = x
+	return p
+}
+
+func (x AnalysisJobState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AnalysisJobState) Descriptor() protoreflect.EnumDescriptor {
+	return file_golfkeypoints_proto_enumTypes[0].Descriptor()
+}
+
+func (AnalysisJobState) Type() protoreflect.EnumType {
+	return &file_golfkeypoints_proto_enumTypes[0]
+}
+
+func (x AnalysisJobState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AnalysisJobState.Descriptor instead.
+func (AnalysisJobState) EnumDescriptor() ([]byte, []int) {
+	return file_golfkeypoints_proto_rawDescGZIP(), []int{0}
+}
+
 type ImageType int32
 
 const (
@@ -54,11 +109,11 @@ func (x ImageType) String() string {
 }
 
 func (ImageType) Descriptor() protoreflect.EnumDescriptor {
-	return file_golfkeypoints_proto_enumTypes[0].Descriptor()
+	return file_golfkeypoints_proto_enumTypes[1].Descriptor()
 }
 
 func (ImageType) Type() protoreflect.EnumType {
-	return &file_golfkeypoints_proto_enumTypes[0]
+	return &file_golfkeypoints_proto_enumTypes[1]
 }
 
 func (x ImageType) Number() protoreflect.EnumNumber {
@@ -67,7 +122,7 @@ func (x ImageType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ImageType.Descriptor instead.
 func (ImageType) EnumDescriptor() ([]byte, []int) {
-	return file_golfkeypoints_proto_rawDescGZIP(), []int{0}
+	return file_golfkeypoints_proto_rawDescGZIP(), []int{1}
 }
 
 type CalibrationType int32
@@ -108,11 +163,11 @@ func (x CalibrationType) String() string {
 }
 
 func (CalibrationType) Descriptor() protoreflect.EnumDescriptor {
-	return file_golfkeypoints_proto_enumTypes[1].Descriptor()
+	return file_golfkeypoints_proto_enumTypes[2].Descriptor()
 }
 
 func (CalibrationType) Type() protoreflect.EnumType {
-	return &file_golfkeypoints_proto_enumTypes[1]
+	return &file_golfkeypoints_proto_enumTypes[2]
 }
 
 func (x CalibrationType) Number() protoreflect.EnumNumber {
@@ -121,7 +176,56 @@ func (x CalibrationType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use CalibrationType.Descriptor instead.
 func (CalibrationType) EnumDescriptor() ([]byte, []int) {
-	return file_golfkeypoints_proto_rawDescGZIP(), []int{1}
+	return file_golfkeypoints_proto_rawDescGZIP(), []int{2}
+}
+
+type CalibrationImage int32
+
+const (
+	CalibrationImage_CALIBRATION_IMAGE_UNSPECIFIED     CalibrationImage = 0
+	CalibrationImage_CALIBRATION_IMAGE_AXES            CalibrationImage = 1
+	CalibrationImage_CALIBRATION_IMAGE_VANISHING_POINT CalibrationImage = 2
+)
+
+// Enum value maps for CalibrationImage.
+var (
+	CalibrationImage_name = map[int32]string{
+		0: "CALIBRATION_IMAGE_UNSPECIFIED",
+		1: "CALIBRATION_IMAGE_AXES",
+		2: "CALIBRATION_IMAGE_VANISHING_POINT",
+	}
+	CalibrationImage_value = map[string]int32{
+		"CALIBRATION_IMAGE_UNSPECIFIED":     0,
+		"CALIBRATION_IMAGE_AXES":            1,
+		"CALIBRATION_IMAGE_VANISHING_POINT": 2,
+	}
+)
+
+func (x CalibrationImage) Enum() *CalibrationImage {
+	p := new(CalibrationImage)
+	*p = x
+	return p
+}
+
+func (x CalibrationImage) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CalibrationImage) Descriptor() protoreflect.EnumDescriptor {
+	return file_golfkeypoints_proto_enumTypes[3].Descriptor()
+}
+
+func (CalibrationImage) Type() protoreflect.EnumType {
+	return &file_golfkeypoints_proto_enumTypes[3]
+}
+
+func (x CalibrationImage) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CalibrationImage.Descriptor instead.
+func (CalibrationImage) EnumDescriptor() ([]byte, []int) {
+	return file_golfkeypoints_proto_rawDescGZIP(), []int{3}
 }
 
 type FeetLineMethod int32
@@ -158,11 +262,11 @@ func (x FeetLineMethod) String() string {
 }
 
 func (FeetLineMethod) Descriptor() protoreflect.EnumDescriptor {
-	return file_golfkeypoints_proto_enumTypes[2].Descriptor()
+	return file_golfkeypoints_proto_enumTypes[4].Descriptor()
 }
 
 func (FeetLineMethod) Type() protoreflect.EnumType {
-	return &file_golfkeypoints_proto_enumTypes[2]
+	return &file_golfkeypoints_proto_enumTypes[4]
 }
 
 func (x FeetLineMethod) Number() protoreflect.EnumNumber {
@@ -171,7 +275,7 @@ func (x FeetLineMethod) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use FeetLineMethod.Descriptor instead.
 func (FeetLineMethod) EnumDescriptor() ([]byte, []int) {
-	return file_golfkeypoints_proto_rawDescGZIP(), []int{2}
+	return file_golfkeypoints_proto_rawDescGZIP(), []int{4}
 }
 
 type UploadInputImageRequest struct {
@@ -184,7 +288,7 @@ type UploadInputImageRequest struct {
 	Image        []byte    `protobuf:"bytes,3,opt,name=image,proto3" json:"image,omitempty"`
 	Description  string    `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
 	// timestamp for when this input image was uploaded
-	Timestamp *timestamp.Timestamp `protobuf:"bytes,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 }
 
 func (x *UploadInputImageRequest) Reset() {
@@ -247,7 +351,7 @@ func (x *UploadInputImageRequest) GetDescription() string {
 	return ""
 }
 
-func (x *UploadInputImageRequest) GetTimestamp() *timestamp.Timestamp {
+func (x *UploadInputImageRequest) GetTimestamp() *timestamppb.Timestamp {
 	if x != nil {
 		return x.Timestamp
 	}
@@ -478,7 +582,7 @@ type ReadInputImageResponse struct {
 	FeetLineMethod  FeetLineMethod  `protobuf:"varint,5,opt,name=feet_line_method,json=feetLineMethod,proto3,enum=sports_keypoints_proto.FeetLineMethod" json:"feet_line_method,omitempty"`
 	Description     string          `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
 	// timestamp for when this input image was uploaded
-	Timestamp *timestamp.Timestamp `protobuf:"bytes,7,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 }
 
 func (x *ReadInputImageResponse) Reset() {
@@ -555,7 +659,7 @@ func (x *ReadInputImageResponse) GetDescription() string {
 	return ""
 }
 
-func (x *ReadInputImageResponse) GetTimestamp() *timestamp.Timestamp {
+func (x *ReadInputImageResponse) GetTimestamp() *timestamppb.Timestamp {
 	if x != nil {
 		return x.Timestamp
 	}
@@ -1190,17 +1294,21 @@ func (x *UpdateBodyKeypointsResponse) GetUpdatedGolfKeypoints() *GolfKeypoints {
 	return nil
 }
 
-type DeleteGolfKeypointsRequest struct {
+type ImportGolfKeypointsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	SessionToken string `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
 	InputImageId string `protobuf:"bytes,2,opt,name=input_image_id,json=inputImageId,proto3" json:"input_image_id,omitempty"`
+	// Types that are assignable to Keypoints:
+	//	*ImportGolfKeypointsRequest_OpenposeJson
+	//	*ImportGolfKeypointsRequest_BodyKeypoints
+	Keypoints isImportGolfKeypointsRequest_Keypoints `protobuf_oneof:"keypoints"`
 }
 
-func (x *DeleteGolfKeypointsRequest) Reset() {
-	*x = DeleteGolfKeypointsRequest{}
+func (x *ImportGolfKeypointsRequest) Reset() {
+	*x = ImportGolfKeypointsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_golfkeypoints_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1208,13 +1316,13 @@ func (x *DeleteGolfKeypointsRequest) Reset() {
 	}
 }
 
-func (x *DeleteGolfKeypointsRequest) String() string {
+func (x *ImportGolfKeypointsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteGolfKeypointsRequest) ProtoMessage() {}
+func (*ImportGolfKeypointsRequest) ProtoMessage() {}
 
-func (x *DeleteGolfKeypointsRequest) ProtoReflect() protoreflect.Message {
+func (x *ImportGolfKeypointsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_golfkeypoints_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1226,35 +1334,74 @@ func (x *DeleteGolfKeypointsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteGolfKeypointsRequest.ProtoReflect.Descriptor instead.
-func (*DeleteGolfKeypointsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ImportGolfKeypointsRequest.ProtoReflect.Descriptor instead.
+func (*ImportGolfKeypointsRequest) Descriptor() ([]byte, []int) {
 	return file_golfkeypoints_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *DeleteGolfKeypointsRequest) GetSessionToken() string {
+func (x *ImportGolfKeypointsRequest) GetSessionToken() string {
 	if x != nil {
 		return x.SessionToken
 	}
 	return ""
 }
 
-func (x *DeleteGolfKeypointsRequest) GetInputImageId() string {
+func (x *ImportGolfKeypointsRequest) GetInputImageId() string {
 	if x != nil {
 		return x.InputImageId
 	}
 	return ""
 }
 
-type DeleteGolfKeypointsResponse struct {
+func (m *ImportGolfKeypointsRequest) GetKeypoints() isImportGolfKeypointsRequest_Keypoints {
+	if m != nil {
+		return m.Keypoints
+	}
+	return nil
+}
+
+func (x *ImportGolfKeypointsRequest) GetOpenposeJson() []byte {
+	if x, ok := x.GetKeypoints().(*ImportGolfKeypointsRequest_OpenposeJson); ok {
+		return x.OpenposeJson
+	}
+	return nil
+}
+
+func (x *ImportGolfKeypointsRequest) GetBodyKeypoints() *Body25PoseKeypoints {
+	if x, ok := x.GetKeypoints().(*ImportGolfKeypointsRequest_BodyKeypoints); ok {
+		return x.BodyKeypoints
+	}
+	return nil
+}
+
+type isImportGolfKeypointsRequest_Keypoints interface {
+	isImportGolfKeypointsRequest_Keypoints()
+}
+
+type ImportGolfKeypointsRequest_OpenposeJson struct {
+	// openpose *_keypoints.json output for the input image, the person with the highest total confidence is used
+	OpenposeJson []byte `protobuf:"bytes,3,opt,name=openpose_json,json=openposeJson,proto3,oneof"`
+}
+
+type ImportGolfKeypointsRequest_BodyKeypoints struct {
+	BodyKeypoints *Body25PoseKeypoints `protobuf:"bytes,4,opt,name=body_keypoints,json=bodyKeypoints,proto3,oneof"`
+}
+
+func (*ImportGolfKeypointsRequest_OpenposeJson) isImportGolfKeypointsRequest_Keypoints() {}
+
+func (*ImportGolfKeypointsRequest_BodyKeypoints) isImportGolfKeypointsRequest_Keypoints() {}
+
+type ImportGolfKeypointsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Success       bool           `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	GolfKeypoints *GolfKeypoints `protobuf:"bytes,2,opt,name=golf_keypoints,json=golfKeypoints,proto3" json:"golf_keypoints,omitempty"`
 }
 
-func (x *DeleteGolfKeypointsResponse) Reset() {
-	*x = DeleteGolfKeypointsResponse{}
+func (x *ImportGolfKeypointsResponse) Reset() {
+	*x = ImportGolfKeypointsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_golfkeypoints_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1262,13 +1409,13 @@ func (x *DeleteGolfKeypointsResponse) Reset() {
 	}
 }
 
-func (x *DeleteGolfKeypointsResponse) String() string {
+func (x *ImportGolfKeypointsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteGolfKeypointsResponse) ProtoMessage() {}
+func (*ImportGolfKeypointsResponse) ProtoMessage() {}
 
-func (x *DeleteGolfKeypointsResponse) ProtoReflect() protoreflect.Message {
+func (x *ImportGolfKeypointsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_golfkeypoints_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1280,30 +1427,36 @@ func (x *DeleteGolfKeypointsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteGolfKeypointsResponse.ProtoReflect.Descriptor instead.
-func (*DeleteGolfKeypointsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ImportGolfKeypointsResponse.ProtoReflect.Descriptor instead.
+func (*ImportGolfKeypointsResponse) Descriptor() ([]byte, []int) {
 	return file_golfkeypoints_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *DeleteGolfKeypointsResponse) GetSuccess() bool {
+func (x *ImportGolfKeypointsResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-type GolfKeypoints struct {
+func (x *ImportGolfKeypointsResponse) GetGolfKeypoints() *GolfKeypoints {
+	if x != nil {
+		return x.GolfKeypoints
+	}
+	return nil
+}
+
+type DeleteGolfKeypointsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	DtlGolfSetupPoints    *DTLGolfSetupPoints    `protobuf:"bytes,1,opt,name=dtl_golf_setup_points,json=dtlGolfSetupPoints,proto3" json:"dtl_golf_setup_points,omitempty"`
-	FaceonGolfSetupPoints *FaceOnGolfSetupPoints `protobuf:"bytes,2,opt,name=faceon_golf_setup_points,json=faceonGolfSetupPoints,proto3" json:"faceon_golf_setup_points,omitempty"`
-	BodyKeypoints         *Body25PoseKeypoints   `protobuf:"bytes,3,opt,name=body_keypoints,json=bodyKeypoints,proto3" json:"body_keypoints,omitempty"`
+	SessionToken string `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	InputImageId string `protobuf:"bytes,2,opt,name=input_image_id,json=inputImageId,proto3" json:"input_image_id,omitempty"`
 }
 
-func (x *GolfKeypoints) Reset() {
-	*x = GolfKeypoints{}
+func (x *DeleteGolfKeypointsRequest) Reset() {
+	*x = DeleteGolfKeypointsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_golfkeypoints_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1311,13 +1464,13 @@ func (x *GolfKeypoints) Reset() {
 	}
 }
 
-func (x *GolfKeypoints) String() string {
+func (x *DeleteGolfKeypointsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GolfKeypoints) ProtoMessage() {}
+func (*DeleteGolfKeypointsRequest) ProtoMessage() {}
 
-func (x *GolfKeypoints) ProtoReflect() protoreflect.Message {
+func (x *DeleteGolfKeypointsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_golfkeypoints_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1329,59 +1482,35 @@ func (x *GolfKeypoints) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GolfKeypoints.ProtoReflect.Descriptor instead.
-func (*GolfKeypoints) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteGolfKeypointsRequest.ProtoReflect.Descriptor instead.
+func (*DeleteGolfKeypointsRequest) Descriptor() ([]byte, []int) {
 	return file_golfkeypoints_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *GolfKeypoints) GetDtlGolfSetupPoints() *DTLGolfSetupPoints {
-	if x != nil {
-		return x.DtlGolfSetupPoints
-	}
-	return nil
-}
-
-func (x *GolfKeypoints) GetFaceonGolfSetupPoints() *FaceOnGolfSetupPoints {
+func (x *DeleteGolfKeypointsRequest) GetSessionToken() string {
 	if x != nil {
-		return x.FaceonGolfSetupPoints
+		return x.SessionToken
 	}
-	return nil
+	return ""
 }
 
-func (x *GolfKeypoints) GetBodyKeypoints() *Body25PoseKeypoints {
+func (x *DeleteGolfKeypointsRequest) GetInputImageId() string {
 	if x != nil {
-		return x.BodyKeypoints
+		return x.InputImageId
 	}
-	return nil
+	return ""
 }
 
-type DTLGolfSetupPoints struct {
+type DeleteGolfKeypointsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// degrees from vertical, requires axes calibration
-	SpineAngle *Double `protobuf:"bytes,1,opt,name=spine_angle,json=spineAngle,proto3" json:"spine_angle,omitempty"`
-	// degrees from target (negative is open, positive is closed), requires axes and vanishing point calibration
-	FeetAlignment *Double `protobuf:"bytes,2,opt,name=feet_alignment,json=feetAlignment,proto3" json:"feet_alignment,omitempty"`
-	// degrees from target (negative is open, positive is closed), requires axes and vanishing point calibration
-	HeelAlignment *Double `protobuf:"bytes,3,opt,name=heel_alignment,json=heelAlignment,proto3" json:"heel_alignment,omitempty"`
-	// degrees from target (negative is open, positive is closed), requires axes and vanishing point calibration
-	ToeAlignment *Double `protobuf:"bytes,4,opt,name=toe_alignment,json=toeAlignment,proto3" json:"toe_alignment,omitempty"`
-	// degrees from target (negative is open, positive is closed), requires axes and vanishing point calibration (note: shoulder alignment is very sensitive to detection)
-	ShoulderAlignment *Double `protobuf:"bytes,5,opt,name=shoulder_alignment,json=shoulderAlignment,proto3" json:"shoulder_alignment,omitempty"`
-	// degrees from target (negative is open, positive is closed), requires axes and vanishing point calibration (note: waist alignment is very sensitive to detection)
-	WaistAlignment *Double `protobuf:"bytes,6,opt,name=waist_alignment,json=waistAlignment,proto3" json:"waist_alignment,omitempty"`
-	// degrees off from straight legs, the line from hip to knee and line from knee to ankle, for best results wear shorts/tighter pants
-	KneeBend *Double `protobuf:"bytes,7,opt,name=knee_bend,json=kneeBend,proto3" json:"knee_bend,omitempty"`
-	// ratio of distance from toe line to ball and line from midhip to neck, the larger the number the farther from the ball, requires golf ball calibration
-	DistanceFromBall *Double `protobuf:"bytes,8,opt,name=distance_from_ball,json=distanceFromBall,proto3" json:"distance_from_ball,omitempty"`
-	// degrees from line running through right elbow to right wrist and the line from right wrist to club head, the bigger the angle the more ulnar deviation (ie. higher hands), requires club head calibration
-	UlnarDeviation *Double `protobuf:"bytes,9,opt,name=ulnar_deviation,json=ulnarDeviation,proto3" json:"ulnar_deviation,omitempty"` // TODO: waist_bend: (same as spine angle??), neck_angle, chin_position/eye_gaze_position, spine_bend (requires a mid spine point), elbow_bend, arm stuff
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 }
 
-func (x *DTLGolfSetupPoints) Reset() {
-	*x = DTLGolfSetupPoints{}
+func (x *DeleteGolfKeypointsResponse) Reset() {
+	*x = DeleteGolfKeypointsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_golfkeypoints_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1389,13 +1518,13 @@ func (x *DTLGolfSetupPoints) Reset() {
 	}
 }
 
-func (x *DTLGolfSetupPoints) String() string {
+func (x *DeleteGolfKeypointsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DTLGolfSetupPoints) ProtoMessage() {}
+func (*DeleteGolfKeypointsResponse) ProtoMessage() {}
 
-func (x *DTLGolfSetupPoints) ProtoReflect() protoreflect.Message {
+func (x *DeleteGolfKeypointsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_golfkeypoints_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1407,78 +1536,1218 @@ func (x *DTLGolfSetupPoints) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DTLGolfSetupPoints.ProtoReflect.Descriptor instead.
-func (*DTLGolfSetupPoints) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteGolfKeypointsResponse.ProtoReflect.Descriptor instead.
+func (*DeleteGolfKeypointsResponse) Descriptor() ([]byte, []int) {
 	return file_golfkeypoints_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *DTLGolfSetupPoints) GetSpineAngle() *Double {
+func (x *DeleteGolfKeypointsResponse) GetSuccess() bool {
 	if x != nil {
-		return x.SpineAngle
+		return x.Success
 	}
-	return nil
+	return false
 }
 
-func (x *DTLGolfSetupPoints) GetFeetAlignment() *Double {
-	if x != nil {
-		return x.FeetAlignment
-	}
-	return nil
+type UploadInputImageStreamRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Data:
+	//	*UploadInputImageStreamRequest_Metadata
+	//	*UploadInputImageStreamRequest_Chunk
+	//	*UploadInputImageStreamRequest_Sha256
+	Data isUploadInputImageStreamRequest_Data `protobuf_oneof:"data"`
 }
 
-func (x *DTLGolfSetupPoints) GetHeelAlignment() *Double {
-	if x != nil {
-		return x.HeelAlignment
+func (x *UploadInputImageStreamRequest) Reset() {
+	*x = UploadInputImageStreamRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_golfkeypoints_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *DTLGolfSetupPoints) GetToeAlignment() *Double {
-	if x != nil {
-		return x.ToeAlignment
-	}
-	return nil
+func (x *UploadInputImageStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *DTLGolfSetupPoints) GetShoulderAlignment() *Double {
-	if x != nil {
-		return x.ShoulderAlignment
+func (*UploadInputImageStreamRequest) ProtoMessage() {}
+
+func (x *UploadInputImageStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_golfkeypoints_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *DTLGolfSetupPoints) GetWaistAlignment() *Double {
-	if x != nil {
-		return x.WaistAlignment
+// Deprecated: Use UploadInputImageStreamRequest.ProtoReflect.Descriptor instead.
+func (*UploadInputImageStreamRequest) Descriptor() ([]byte, []int) {
+	return file_golfkeypoints_proto_rawDescGZIP(), []int{20}
+}
+
+func (m *UploadInputImageStreamRequest) GetData() isUploadInputImageStreamRequest_Data {
+	if m != nil {
+		return m.Data
 	}
 	return nil
 }
 
-func (x *DTLGolfSetupPoints) GetKneeBend() *Double {
-	if x != nil {
-		return x.KneeBend
+func (x *UploadInputImageStreamRequest) GetMetadata() *UploadInputImageRequest {
+	if x, ok := x.GetData().(*UploadInputImageStreamRequest_Metadata); ok {
+		return x.Metadata
 	}
 	return nil
 }
 
-func (x *DTLGolfSetupPoints) GetDistanceFromBall() *Double {
-	if x != nil {
-		return x.DistanceFromBall
+func (x *UploadInputImageStreamRequest) GetChunk() []byte {
+	if x, ok := x.GetData().(*UploadInputImageStreamRequest_Chunk); ok {
+		return x.Chunk
 	}
 	return nil
 }
 
-func (x *DTLGolfSetupPoints) GetUlnarDeviation() *Double {
-	if x != nil {
-		return x.UlnarDeviation
+func (x *UploadInputImageStreamRequest) GetSha256() string {
+	if x, ok := x.GetData().(*UploadInputImageStreamRequest_Sha256); ok {
+		return x.Sha256
 	}
-	return nil
+	return ""
 }
 
-type FaceOnGolfSetupPoints struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type isUploadInputImageStreamRequest_Data interface {
+	isUploadInputImageStreamRequest_Data()
+}
+
+type UploadInputImageStreamRequest_Metadata struct {
+	// first message, image is left empty
+	Metadata *UploadInputImageRequest `protobuf:"bytes,1,opt,name=metadata,proto3,oneof"`
+}
+
+type UploadInputImageStreamRequest_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+type UploadInputImageStreamRequest_Sha256 struct {
+	// last message, hex encoded sha256 of the image
+	Sha256 string `protobuf:"bytes,3,opt,name=sha256,proto3,oneof"`
+}
+
+func (*UploadInputImageStreamRequest_Metadata) isUploadInputImageStreamRequest_Data() {}
+
+func (*UploadInputImageStreamRequest_Chunk) isUploadInputImageStreamRequest_Data() {}
+
+func (*UploadInputImageStreamRequest_Sha256) isUploadInputImageStreamRequest_Data() {}
+
+type CalibrateInputImageStreamRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Data:
+	//	*CalibrateInputImageStreamRequest_Metadata
+	//	*CalibrateInputImageStreamRequest_Chunk
+	//	*CalibrateInputImageStreamRequest_Checksums
+	Data isCalibrateInputImageStreamRequest_Data `protobuf_oneof:"data"`
+}
+
+func (x *CalibrateInputImageStreamRequest) Reset() {
+	*x = CalibrateInputImageStreamRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_golfkeypoints_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CalibrateInputImageStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalibrateInputImageStreamRequest) ProtoMessage() {}
+
+func (x *CalibrateInputImageStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_golfkeypoints_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalibrateInputImageStreamRequest.ProtoReflect.Descriptor instead.
+func (*CalibrateInputImageStreamRequest) Descriptor() ([]byte, []int) {
+	return file_golfkeypoints_proto_rawDescGZIP(), []int{21}
+}
+
+func (m *CalibrateInputImageStreamRequest) GetData() isCalibrateInputImageStreamRequest_Data {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (x *CalibrateInputImageStreamRequest) GetMetadata() *CalibrateInputImageRequest {
+	if x, ok := x.GetData().(*CalibrateInputImageStreamRequest_Metadata); ok {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *CalibrateInputImageStreamRequest) GetChunk() *CalibrationImageChunk {
+	if x, ok := x.GetData().(*CalibrateInputImageStreamRequest_Chunk); ok {
+		return x.Chunk
+	}
+	return nil
+}
+
+func (x *CalibrateInputImageStreamRequest) GetChecksums() *CalibrationImageChecksums {
+	if x, ok := x.GetData().(*CalibrateInputImageStreamRequest_Checksums); ok {
+		return x.Checksums
+	}
+	return nil
+}
+
+type isCalibrateInputImageStreamRequest_Data interface {
+	isCalibrateInputImageStreamRequest_Data()
+}
+
+type CalibrateInputImageStreamRequest_Metadata struct {
+	// first message, calibration_image_axes and calibration_image_vanishing_point are left empty
+	Metadata *CalibrateInputImageRequest `protobuf:"bytes,1,opt,name=metadata,proto3,oneof"`
+}
+
+type CalibrateInputImageStreamRequest_Chunk struct {
+	Chunk *CalibrationImageChunk `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+type CalibrateInputImageStreamRequest_Checksums struct {
+	// last message
+	Checksums *CalibrationImageChecksums `protobuf:"bytes,3,opt,name=checksums,proto3,oneof"`
+}
+
+func (*CalibrateInputImageStreamRequest_Metadata) isCalibrateInputImageStreamRequest_Data() {}
+
+func (*CalibrateInputImageStreamRequest_Chunk) isCalibrateInputImageStreamRequest_Data() {}
+
+func (*CalibrateInputImageStreamRequest_Checksums) isCalibrateInputImageStreamRequest_Data() {}
+
+type CalibrationImageChunk struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CalibrationImage CalibrationImage `protobuf:"varint,1,opt,name=calibration_image,json=calibrationImage,proto3,enum=sports_keypoints_proto.CalibrationImage" json:"calibration_image,omitempty"`
+	Data             []byte           `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *CalibrationImageChunk) Reset() {
+	*x = CalibrationImageChunk{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_golfkeypoints_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CalibrationImageChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalibrationImageChunk) ProtoMessage() {}
+
+func (x *CalibrationImageChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_golfkeypoints_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalibrationImageChunk.ProtoReflect.Descriptor instead.
+func (*CalibrationImageChunk) Descriptor() ([]byte, []int) {
+	return file_golfkeypoints_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *CalibrationImageChunk) GetCalibrationImage() CalibrationImage {
+	if x != nil {
+		return x.CalibrationImage
+	}
+	return CalibrationImage_CALIBRATION_IMAGE_UNSPECIFIED
+}
+
+func (x *CalibrationImageChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type CalibrationImageChecksums struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// hex encoded sha256 of each calibration image that was sent
+	CalibrationImageAxesSha256           string `protobuf:"bytes,1,opt,name=calibration_image_axes_sha256,json=calibrationImageAxesSha256,proto3" json:"calibration_image_axes_sha256,omitempty"`
+	CalibrationImageVanishingPointSha256 string `protobuf:"bytes,2,opt,name=calibration_image_vanishing_point_sha256,json=calibrationImageVanishingPointSha256,proto3" json:"calibration_image_vanishing_point_sha256,omitempty"`
+}
+
+func (x *CalibrationImageChecksums) Reset() {
+	*x = CalibrationImageChecksums{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_golfkeypoints_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CalibrationImageChecksums) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalibrationImageChecksums) ProtoMessage() {}
+
+func (x *CalibrationImageChecksums) ProtoReflect() protoreflect.Message {
+	mi := &file_golfkeypoints_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalibrationImageChecksums.ProtoReflect.Descriptor instead.
+func (*CalibrationImageChecksums) Descriptor() ([]byte, []int) {
+	return file_golfkeypoints_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CalibrationImageChecksums) GetCalibrationImageAxesSha256() string {
+	if x != nil {
+		return x.CalibrationImageAxesSha256
+	}
+	return ""
+}
+
+func (x *CalibrationImageChecksums) GetCalibrationImageVanishingPointSha256() string {
+	if x != nil {
+		return x.CalibrationImageVanishingPointSha256
+	}
+	return ""
+}
+
+type DownloadInputImageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionToken string `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	InputImageId string `protobuf:"bytes,2,opt,name=input_image_id,json=inputImageId,proto3" json:"input_image_id,omitempty"`
+}
+
+func (x *DownloadInputImageRequest) Reset() {
+	*x = DownloadInputImageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_golfkeypoints_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DownloadInputImageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadInputImageRequest) ProtoMessage() {}
+
+func (x *DownloadInputImageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_golfkeypoints_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadInputImageRequest.ProtoReflect.Descriptor instead.
+func (*DownloadInputImageRequest) Descriptor() ([]byte, []int) {
+	return file_golfkeypoints_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *DownloadInputImageRequest) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *DownloadInputImageRequest) GetInputImageId() string {
+	if x != nil {
+		return x.InputImageId
+	}
+	return ""
+}
+
+type DownloadCalibrationImageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionToken     string           `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	InputImageId     string           `protobuf:"bytes,2,opt,name=input_image_id,json=inputImageId,proto3" json:"input_image_id,omitempty"`
+	CalibrationImage CalibrationImage `protobuf:"varint,3,opt,name=calibration_image,json=calibrationImage,proto3,enum=sports_keypoints_proto.CalibrationImage" json:"calibration_image,omitempty"`
+}
+
+func (x *DownloadCalibrationImageRequest) Reset() {
+	*x = DownloadCalibrationImageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_golfkeypoints_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DownloadCalibrationImageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadCalibrationImageRequest) ProtoMessage() {}
+
+func (x *DownloadCalibrationImageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_golfkeypoints_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadCalibrationImageRequest.ProtoReflect.Descriptor instead.
+func (*DownloadCalibrationImageRequest) Descriptor() ([]byte, []int) {
+	return file_golfkeypoints_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *DownloadCalibrationImageRequest) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *DownloadCalibrationImageRequest) GetInputImageId() string {
+	if x != nil {
+		return x.InputImageId
+	}
+	return ""
+}
+
+func (x *DownloadCalibrationImageRequest) GetCalibrationImage() CalibrationImage {
+	if x != nil {
+		return x.CalibrationImage
+	}
+	return CalibrationImage_CALIBRATION_IMAGE_UNSPECIFIED
+}
+
+type DownloadOutputImageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionToken string `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	InputImageId string `protobuf:"bytes,2,opt,name=input_image_id,json=inputImageId,proto3" json:"input_image_id,omitempty"`
+}
+
+func (x *DownloadOutputImageRequest) Reset() {
+	*x = DownloadOutputImageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_golfkeypoints_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DownloadOutputImageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadOutputImageRequest) ProtoMessage() {}
+
+func (x *DownloadOutputImageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_golfkeypoints_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadOutputImageRequest.ProtoReflect.Descriptor instead.
+func (*DownloadOutputImageRequest) Descriptor() ([]byte, []int) {
+	return file_golfkeypoints_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *DownloadOutputImageRequest) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *DownloadOutputImageRequest) GetInputImageId() string {
+	if x != nil {
+		return x.InputImageId
+	}
+	return ""
+}
+
+type DownloadImageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Data:
+	//	*DownloadImageResponse_Info
+	//	*DownloadImageResponse_Chunk
+	Data isDownloadImageResponse_Data `protobuf_oneof:"data"`
+}
+
+func (x *DownloadImageResponse) Reset() {
+	*x = DownloadImageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_golfkeypoints_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DownloadImageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadImageResponse) ProtoMessage() {}
+
+func (x *DownloadImageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_golfkeypoints_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadImageResponse.ProtoReflect.Descriptor instead.
+func (*DownloadImageResponse) Descriptor() ([]byte, []int) {
+	return file_golfkeypoints_proto_rawDescGZIP(), []int{27}
+}
+
+func (m *DownloadImageResponse) GetData() isDownloadImageResponse_Data {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (x *DownloadImageResponse) GetInfo() *ImageInfo {
+	if x, ok := x.GetData().(*DownloadImageResponse_Info); ok {
+		return x.Info
+	}
+	return nil
+}
+
+func (x *DownloadImageResponse) GetChunk() []byte {
+	if x, ok := x.GetData().(*DownloadImageResponse_Chunk); ok {
+		return x.Chunk
+	}
+	return nil
+}
+
+type isDownloadImageResponse_Data interface {
+	isDownloadImageResponse_Data()
+}
+
+type DownloadImageResponse_Info struct {
+	// first message
+	Info *ImageInfo `protobuf:"bytes,1,opt,name=info,proto3,oneof"`
+}
+
+type DownloadImageResponse_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*DownloadImageResponse_Info) isDownloadImageResponse_Data() {}
+
+func (*DownloadImageResponse_Chunk) isDownloadImageResponse_Data() {}
+
+type ImageInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// in bytes
+	Size int64 `protobuf:"varint,1,opt,name=size,proto3" json:"size,omitempty"`
+	// hex encoded sha256 of the image
+	Sha256 string `protobuf:"bytes,2,opt,name=sha256,proto3" json:"sha256,omitempty"`
+}
+
+func (x *ImageInfo) Reset() {
+	*x = ImageInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_golfkeypoints_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImageInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImageInfo) ProtoMessage() {}
+
+func (x *ImageInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_golfkeypoints_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImageInfo.ProtoReflect.Descriptor instead.
+func (*ImageInfo) Descriptor() ([]byte, []int) {
+	return file_golfkeypoints_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ImageInfo) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *ImageInfo) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+type SubmitAnalysisRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionToken string `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	InputImageId string `protobuf:"bytes,2,opt,name=input_image_id,json=inputImageId,proto3" json:"input_image_id,omitempty"`
+}
+
+func (x *SubmitAnalysisRequest) Reset() {
+	*x = SubmitAnalysisRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_golfkeypoints_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmitAnalysisRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitAnalysisRequest) ProtoMessage() {}
+
+func (x *SubmitAnalysisRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_golfkeypoints_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitAnalysisRequest.ProtoReflect.Descriptor instead.
+func (*SubmitAnalysisRequest) Descriptor() ([]byte, []int) {
+	return file_golfkeypoints_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *SubmitAnalysisRequest) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *SubmitAnalysisRequest) GetInputImageId() string {
+	if x != nil {
+		return x.InputImageId
+	}
+	return ""
+}
+
+type SubmitAnalysisResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	JobId   string `protobuf:"bytes,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+}
+
+func (x *SubmitAnalysisResponse) Reset() {
+	*x = SubmitAnalysisResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_golfkeypoints_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmitAnalysisResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitAnalysisResponse) ProtoMessage() {}
+
+func (x *SubmitAnalysisResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_golfkeypoints_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitAnalysisResponse.ProtoReflect.Descriptor instead.
+func (*SubmitAnalysisResponse) Descriptor() ([]byte, []int) {
+	return file_golfkeypoints_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *SubmitAnalysisResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *SubmitAnalysisResponse) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+type GetAnalysisJobRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionToken string `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	JobId        string `protobuf:"bytes,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+}
+
+func (x *GetAnalysisJobRequest) Reset() {
+	*x = GetAnalysisJobRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_golfkeypoints_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAnalysisJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAnalysisJobRequest) ProtoMessage() {}
+
+func (x *GetAnalysisJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_golfkeypoints_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAnalysisJobRequest.ProtoReflect.Descriptor instead.
+func (*GetAnalysisJobRequest) Descriptor() ([]byte, []int) {
+	return file_golfkeypoints_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetAnalysisJobRequest) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *GetAnalysisJobRequest) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+type GetAnalysisJobResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool         `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Job     *AnalysisJob `protobuf:"bytes,2,opt,name=job,proto3" json:"job,omitempty"`
+}
+
+func (x *GetAnalysisJobResponse) Reset() {
+	*x = GetAnalysisJobResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_golfkeypoints_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAnalysisJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAnalysisJobResponse) ProtoMessage() {}
+
+func (x *GetAnalysisJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_golfkeypoints_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAnalysisJobResponse.ProtoReflect.Descriptor instead.
+func (*GetAnalysisJobResponse) Descriptor() ([]byte, []int) {
+	return file_golfkeypoints_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetAnalysisJobResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetAnalysisJobResponse) GetJob() *AnalysisJob {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+type WatchAnalysisJobRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionToken string `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	JobId        string `protobuf:"bytes,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+}
+
+func (x *WatchAnalysisJobRequest) Reset() {
+	*x = WatchAnalysisJobRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_golfkeypoints_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchAnalysisJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchAnalysisJobRequest) ProtoMessage() {}
+
+func (x *WatchAnalysisJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_golfkeypoints_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchAnalysisJobRequest.ProtoReflect.Descriptor instead.
+func (*WatchAnalysisJobRequest) Descriptor() ([]byte, []int) {
+	return file_golfkeypoints_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *WatchAnalysisJobRequest) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *WatchAnalysisJobRequest) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+type AnalysisJob struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JobId        string           `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	InputImageId string           `protobuf:"bytes,2,opt,name=input_image_id,json=inputImageId,proto3" json:"input_image_id,omitempty"`
+	State        AnalysisJobState `protobuf:"varint,3,opt,name=state,proto3,enum=sports_keypoints_proto.AnalysisJobState" json:"state,omitempty"`
+	// times the job was started, jobs that failed with a transient error are queued again
+	Attempts int32 `protobuf:"varint,4,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	// set if the job failed
+	Error string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	// grpc status code of error
+	ErrorCode int32 `protobuf:"varint,6,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	// set once the job is done
+	GolfKeypoints *GolfKeypoints         `protobuf:"bytes,7,opt,name=golf_keypoints,json=golfKeypoints,proto3" json:"golf_keypoints,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *AnalysisJob) Reset() {
+	*x = AnalysisJob{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_golfkeypoints_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AnalysisJob) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnalysisJob) ProtoMessage() {}
+
+func (x *AnalysisJob) ProtoReflect() protoreflect.Message {
+	mi := &file_golfkeypoints_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnalysisJob.ProtoReflect.Descriptor instead.
+func (*AnalysisJob) Descriptor() ([]byte, []int) {
+	return file_golfkeypoints_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *AnalysisJob) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *AnalysisJob) GetInputImageId() string {
+	if x != nil {
+		return x.InputImageId
+	}
+	return ""
+}
+
+func (x *AnalysisJob) GetState() AnalysisJobState {
+	if x != nil {
+		return x.State
+	}
+	return AnalysisJobState_ANALYSIS_JOB_STATE_UNSPECIFIED
+}
+
+func (x *AnalysisJob) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *AnalysisJob) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *AnalysisJob) GetErrorCode() int32 {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return 0
+}
+
+func (x *AnalysisJob) GetGolfKeypoints() *GolfKeypoints {
+	if x != nil {
+		return x.GolfKeypoints
+	}
+	return nil
+}
+
+func (x *AnalysisJob) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *AnalysisJob) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type GolfKeypoints struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DtlGolfSetupPoints    *DTLGolfSetupPoints    `protobuf:"bytes,1,opt,name=dtl_golf_setup_points,json=dtlGolfSetupPoints,proto3" json:"dtl_golf_setup_points,omitempty"`
+	FaceonGolfSetupPoints *FaceOnGolfSetupPoints `protobuf:"bytes,2,opt,name=faceon_golf_setup_points,json=faceonGolfSetupPoints,proto3" json:"faceon_golf_setup_points,omitempty"`
+	BodyKeypoints         *Body25PoseKeypoints   `protobuf:"bytes,3,opt,name=body_keypoints,json=bodyKeypoints,proto3" json:"body_keypoints,omitempty"`
+	// pose model the body keypoints were converted from, keypoints it does not have (eg. heels in coco17) are missing
+	KeypointSchema KeypointSchema `protobuf:"varint,4,opt,name=keypoint_schema,json=keypointSchema,proto3,enum=sports_keypoints_proto.KeypointSchema" json:"keypoint_schema,omitempty"`
+}
+
+func (x *GolfKeypoints) Reset() {
+	*x = GolfKeypoints{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_golfkeypoints_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GolfKeypoints) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GolfKeypoints) ProtoMessage() {}
+
+func (x *GolfKeypoints) ProtoReflect() protoreflect.Message {
+	mi := &file_golfkeypoints_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GolfKeypoints.ProtoReflect.Descriptor instead.
+func (*GolfKeypoints) Descriptor() ([]byte, []int) {
+	return file_golfkeypoints_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GolfKeypoints) GetDtlGolfSetupPoints() *DTLGolfSetupPoints {
+	if x != nil {
+		return x.DtlGolfSetupPoints
+	}
+	return nil
+}
+
+func (x *GolfKeypoints) GetFaceonGolfSetupPoints() *FaceOnGolfSetupPoints {
+	if x != nil {
+		return x.FaceonGolfSetupPoints
+	}
+	return nil
+}
+
+func (x *GolfKeypoints) GetBodyKeypoints() *Body25PoseKeypoints {
+	if x != nil {
+		return x.BodyKeypoints
+	}
+	return nil
+}
+
+func (x *GolfKeypoints) GetKeypointSchema() KeypointSchema {
+	if x != nil {
+		return x.KeypointSchema
+	}
+	return KeypointSchema_KEYPOINT_SCHEMA_UNSPECIFIED
+}
+
+type DTLGolfSetupPoints struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// degrees from vertical, requires axes calibration
+	SpineAngle *Double `protobuf:"bytes,1,opt,name=spine_angle,json=spineAngle,proto3" json:"spine_angle,omitempty"`
+	// degrees from target (negative is open, positive is closed), requires axes and vanishing point calibration
+	FeetAlignment *Double `protobuf:"bytes,2,opt,name=feet_alignment,json=feetAlignment,proto3" json:"feet_alignment,omitempty"`
+	// degrees from target (negative is open, positive is closed), requires axes and vanishing point calibration
+	HeelAlignment *Double `protobuf:"bytes,3,opt,name=heel_alignment,json=heelAlignment,proto3" json:"heel_alignment,omitempty"`
+	// degrees from target (negative is open, positive is closed), requires axes and vanishing point calibration
+	ToeAlignment *Double `protobuf:"bytes,4,opt,name=toe_alignment,json=toeAlignment,proto3" json:"toe_alignment,omitempty"`
+	// degrees from target (negative is open, positive is closed), requires axes and vanishing point calibration (note: shoulder alignment is very sensitive to detection)
+	ShoulderAlignment *Double `protobuf:"bytes,5,opt,name=shoulder_alignment,json=shoulderAlignment,proto3" json:"shoulder_alignment,omitempty"`
+	// degrees from target (negative is open, positive is closed), requires axes and vanishing point calibration (note: waist alignment is very sensitive to detection)
+	WaistAlignment *Double `protobuf:"bytes,6,opt,name=waist_alignment,json=waistAlignment,proto3" json:"waist_alignment,omitempty"`
+	// degrees off from straight legs, the line from hip to knee and line from knee to ankle, for best results wear shorts/tighter pants
+	KneeBend *Double `protobuf:"bytes,7,opt,name=knee_bend,json=kneeBend,proto3" json:"knee_bend,omitempty"`
+	// ratio of distance from toe line to ball and line from midhip to neck, the larger the number the farther from the ball, requires golf ball calibration
+	DistanceFromBall *Double `protobuf:"bytes,8,opt,name=distance_from_ball,json=distanceFromBall,proto3" json:"distance_from_ball,omitempty"`
+	// degrees from line running through right elbow to right wrist and the line from right wrist to club head, the bigger the angle the more ulnar deviation (ie. higher hands), requires club head calibration
+	UlnarDeviation *Double `protobuf:"bytes,9,opt,name=ulnar_deviation,json=ulnarDeviation,proto3" json:"ulnar_deviation,omitempty"` // TODO: waist_bend: (same as spine angle??), neck_angle, chin_position/eye_gaze_position, spine_bend (requires a mid spine point), elbow_bend, arm stuff
+}
+
+func (x *DTLGolfSetupPoints) Reset() {
+	*x = DTLGolfSetupPoints{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_golfkeypoints_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DTLGolfSetupPoints) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DTLGolfSetupPoints) ProtoMessage() {}
+
+func (x *DTLGolfSetupPoints) ProtoReflect() protoreflect.Message {
+	mi := &file_golfkeypoints_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DTLGolfSetupPoints.ProtoReflect.Descriptor instead.
+func (*DTLGolfSetupPoints) Descriptor() ([]byte, []int) {
+	return file_golfkeypoints_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *DTLGolfSetupPoints) GetSpineAngle() *Double {
+	if x != nil {
+		return x.SpineAngle
+	}
+	return nil
+}
+
+func (x *DTLGolfSetupPoints) GetFeetAlignment() *Double {
+	if x != nil {
+		return x.FeetAlignment
+	}
+	return nil
+}
+
+func (x *DTLGolfSetupPoints) GetHeelAlignment() *Double {
+	if x != nil {
+		return x.HeelAlignment
+	}
+	return nil
+}
+
+func (x *DTLGolfSetupPoints) GetToeAlignment() *Double {
+	if x != nil {
+		return x.ToeAlignment
+	}
+	return nil
+}
+
+func (x *DTLGolfSetupPoints) GetShoulderAlignment() *Double {
+	if x != nil {
+		return x.ShoulderAlignment
+	}
+	return nil
+}
+
+func (x *DTLGolfSetupPoints) GetWaistAlignment() *Double {
+	if x != nil {
+		return x.WaistAlignment
+	}
+	return nil
+}
+
+func (x *DTLGolfSetupPoints) GetKneeBend() *Double {
+	if x != nil {
+		return x.KneeBend
+	}
+	return nil
+}
+
+func (x *DTLGolfSetupPoints) GetDistanceFromBall() *Double {
+	if x != nil {
+		return x.DistanceFromBall
+	}
+	return nil
+}
+
+func (x *DTLGolfSetupPoints) GetUlnarDeviation() *Double {
+	if x != nil {
+		return x.UlnarDeviation
+	}
+	return nil
+}
+
+type FaceOnGolfSetupPoints struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
 	// degrees from vertical (positive is right side bend, negative is left side bend), requires axes calibration
 	SideBend *Double `protobuf:"bytes,1,opt,name=side_bend,json=sideBend,proto3" json:"side_bend,omitempty"`
@@ -1507,7 +2776,7 @@ type FaceOnGolfSetupPoints struct {
 func (x *FaceOnGolfSetupPoints) Reset() {
 	*x = FaceOnGolfSetupPoints{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_golfkeypoints_proto_msgTypes[20]
+		mi := &file_golfkeypoints_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1520,7 +2789,7 @@ func (x *FaceOnGolfSetupPoints) String() string {
 func (*FaceOnGolfSetupPoints) ProtoMessage() {}
 
 func (x *FaceOnGolfSetupPoints) ProtoReflect() protoreflect.Message {
-	mi := &file_golfkeypoints_proto_msgTypes[20]
+	mi := &file_golfkeypoints_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1533,7 +2802,7 @@ func (x *FaceOnGolfSetupPoints) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FaceOnGolfSetupPoints.ProtoReflect.Descriptor instead.
 func (*FaceOnGolfSetupPoints) Descriptor() ([]byte, []int) {
-	return file_golfkeypoints_proto_rawDescGZIP(), []int{20}
+	return file_golfkeypoints_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *FaceOnGolfSetupPoints) GetSideBend() *Double {
@@ -1619,393 +2888,662 @@ var file_golfkeypoints_proto_rawDesc = []byte{
 	0x0a, 0x13, 0x67, 0x6f, 0x6c, 0x66, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x16, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65,
 	0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0c, 0x63,
-	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0e, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f,
 	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf2, 0x01, 0x0a,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x94, 0x02, 0x0a,
 	0x17, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x49, 0x6d, 0x61, 0x67,
 	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x73, 0x73,
 	0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x40, 0x0a,
+	0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x4a, 0x0a,
 	0x0a, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
 	0x0e, 0x32, 0x21, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f,
 	0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65,
-	0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12,
-	0x14, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05,
-	0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x22, 0x5a, 0x0a, 0x18, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6e, 0x70, 0x75, 0x74,
-	0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a,
-	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
-	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x69, 0x6e, 0x70, 0x75, 0x74,
-	0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0c, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x49, 0x64, 0x22, 0x44, 0x0a,
-	0x1d, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73,
-	0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23,
-	0x0a, 0x0d, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x22, 0x62, 0x0a, 0x1e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74,
-	0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12,
-	0x26, 0x0a, 0x0f, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x69,
-	0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x49,
-	0x6d, 0x61, 0x67, 0x65, 0x49, 0x64, 0x73, 0x22, 0x62, 0x0a, 0x15, 0x52, 0x65, 0x61, 0x64, 0x49,
-	0x6e, 0x70, 0x75, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x42, 0x08, 0xa2, 0xbb, 0x18, 0x04, 0x18, 0x01, 0x20, 0x01, 0x52, 0x09,
+	0x69, 0x6d, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x05, 0x69, 0x6d, 0x61,
+	0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x06, 0xa2, 0xbb, 0x18, 0x02, 0x08, 0x01,
+	0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x28, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xa2, 0xbb,
+	0x18, 0x02, 0x08, 0x01, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x40, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x42, 0x06, 0xa2, 0xbb, 0x18, 0x02, 0x08, 0x01, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x22, 0x5a, 0x0a, 0x18, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6e, 0x70,
+	0x75, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x69, 0x6e, 0x70,
+	0x75, 0x74, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x49, 0x64, 0x22,
+	0x44, 0x0a, 0x1d, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x49, 0x6d, 0x61, 0x67,
+	0x65, 0x73, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
 	0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
 	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x24, 0x0a, 0x0e, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x69,
-	0x6d, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69,
-	0x6e, 0x70, 0x75, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x49, 0x64, 0x22, 0x8c, 0x03, 0x0a, 0x16,
-	0x52, 0x65, 0x61, 0x64, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x12, 0x40, 0x0a, 0x0a, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65,
-	0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x6d,
-	0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x52, 0x0a, 0x10, 0x63, 0x61, 0x6c, 0x69,
-	0x62, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x27, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70,
-	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x61, 0x6c, 0x69,
-	0x62, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0f, 0x63, 0x61, 0x6c,
-	0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x50, 0x0a, 0x10,
-	0x66, 0x65, 0x65, 0x74, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f,
-	0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x46, 0x65, 0x65, 0x74, 0x4c, 0x69, 0x6e, 0x65, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x0e,
-	0x66, 0x65, 0x65, 0x74, 0x4c, 0x69, 0x6e, 0x65, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x20,
-	0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
-	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x64, 0x0a, 0x17, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x24, 0x0a, 0x0e, 0x69, 0x6e,
-	0x70, 0x75, 0x74, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x49, 0x64,
-	0x22, 0x34, 0x0a, 0x18, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x49,
-	0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07,
-	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73,
-	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x90, 0x05, 0x0a, 0x1a, 0x43, 0x61, 0x6c, 0x69, 0x62,
-	0x72, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x62, 0x0a, 0x1e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x70,
+	0x75, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x12, 0x26, 0x0a, 0x0f, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65,
+	0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x69, 0x6e, 0x70, 0x75,
+	0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x49, 0x64, 0x73, 0x22, 0x6a, 0x0a, 0x15, 0x52, 0x65, 0x61,
+	0x64, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2c, 0x0a, 0x0e, 0x69, 0x6e, 0x70, 0x75, 0x74,
+	0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x06, 0xa2, 0xbb, 0x18, 0x02, 0x08, 0x01, 0x52, 0x0c, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x49, 0x6d,
+	0x61, 0x67, 0x65, 0x49, 0x64, 0x22, 0x8c, 0x03, 0x0a, 0x16, 0x52, 0x65, 0x61, 0x64, 0x49, 0x6e,
+	0x70, 0x75, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x40, 0x0a, 0x0a, 0x69, 0x6d,
+	0x61, 0x67, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21,
+	0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x09, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x69, 0x6d, 0x61,
+	0x67, 0x65, 0x12, 0x52, 0x0a, 0x10, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x27, 0x2e, 0x73,
+	0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0f, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x50, 0x0a, 0x10, 0x66, 0x65, 0x65, 0x74, 0x5f, 0x6c,
+	0x69, 0x6e, 0x65, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x26, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x65, 0x65, 0x74, 0x4c, 0x69,
+	0x6e, 0x65, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x0e, 0x66, 0x65, 0x65, 0x74, 0x4c, 0x69,
+	0x6e, 0x65, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x22, 0x6c, 0x0a, 0x17, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e,
+	0x70, 0x75, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x23, 0x0a, 0x0d, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2c, 0x0a, 0x0e, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x69, 0x6d,
+	0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xa2, 0xbb,
+	0x18, 0x02, 0x08, 0x01, 0x52, 0x0c, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65,
+	0x49, 0x64, 0x22, 0x34, 0x0a, 0x18, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x70, 0x75,
+	0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0xa8, 0x05, 0x0a, 0x1a, 0x43, 0x61, 0x6c,
+	0x69, 0x62, 0x72, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2c, 0x0a, 0x0e,
+	0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xa2, 0xbb, 0x18, 0x02, 0x08, 0x01, 0x52, 0x0c, 0x69, 0x6e,
+	0x70, 0x75, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x5a, 0x0a, 0x10, 0x63, 0x61,
+	0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x27, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65,
+	0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x61,
+	0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x42, 0x06, 0xa2,
+	0xbb, 0x18, 0x02, 0x18, 0x01, 0x52, 0x0f, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x58, 0x0a, 0x10, 0x66, 0x65, 0x65, 0x74, 0x5f, 0x6c,
+	0x69, 0x6e, 0x65, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x26, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x65, 0x65, 0x74, 0x4c, 0x69,
+	0x6e, 0x65, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x42, 0x06, 0xa2, 0xbb, 0x18, 0x02, 0x18, 0x01,
+	0x52, 0x0e, 0x66, 0x65, 0x65, 0x74, 0x4c, 0x69, 0x6e, 0x65, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64,
+	0x12, 0x34, 0x0a, 0x16, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x61, 0x78, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x14, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6d, 0x61,
+	0x67, 0x65, 0x41, 0x78, 0x65, 0x73, 0x12, 0x49, 0x0a, 0x21, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x76, 0x61, 0x6e, 0x69,
+	0x73, 0x68, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x1e, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6d,
+	0x61, 0x67, 0x65, 0x56, 0x61, 0x6e, 0x69, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x69, 0x6e,
+	0x74, 0x12, 0x3d, 0x0a, 0x09, 0x67, 0x6f, 0x6c, 0x66, 0x5f, 0x62, 0x61, 0x6c, 0x6c, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65,
+	0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4b, 0x65,
+	0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x08, 0x67, 0x6f, 0x6c, 0x66, 0x42, 0x61, 0x6c, 0x6c,
+	0x12, 0x3d, 0x0a, 0x09, 0x63, 0x6c, 0x75, 0x62, 0x5f, 0x62, 0x75, 0x74, 0x74, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4b, 0x65, 0x79,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x08, 0x63, 0x6c, 0x75, 0x62, 0x42, 0x75, 0x74, 0x74, 0x12,
+	0x3d, 0x0a, 0x09, 0x63, 0x6c, 0x75, 0x62, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4b, 0x65, 0x79, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x52, 0x08, 0x63, 0x6c, 0x75, 0x62, 0x48, 0x65, 0x61, 0x64, 0x12, 0x43,
+	0x0a, 0x0d, 0x73, 0x68, 0x6f, 0x75, 0x6c, 0x64, 0x65, 0x72, 0x5f, 0x74, 0x69, 0x6c, 0x74, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b,
+	0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44,
+	0x6f, 0x75, 0x62, 0x6c, 0x65, 0x52, 0x0c, 0x73, 0x68, 0x6f, 0x75, 0x6c, 0x64, 0x65, 0x72, 0x54,
+	0x69, 0x6c, 0x74, 0x22, 0x37, 0x0a, 0x1b, 0x43, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x65,
+	0x49, 0x6e, 0x70, 0x75, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x72, 0x0a, 0x1d,
+	0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x47, 0x6f, 0x6c, 0x66, 0x4b, 0x65, 0x79,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a,
+	0x0d, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x2c, 0x0a, 0x0e, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x69, 0x6d, 0x61, 0x67,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xa2, 0xbb, 0x18, 0x02,
+	0x08, 0x01, 0x52, 0x0c, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x49, 0x64,
+	0x22, 0xab, 0x01, 0x0a, 0x1e, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x47, 0x6f,
+	0x6c, 0x66, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x21, 0x0a,
+	0x0c, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x0b, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65,
+	0x12, 0x4c, 0x0a, 0x0e, 0x67, 0x6f, 0x6c, 0x66, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74,
+	0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x47, 0x6f, 0x6c, 0x66, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52,
+	0x0d, 0x67, 0x6f, 0x6c, 0x66, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22, 0x6d,
+	0x0a, 0x18, 0x52, 0x65, 0x61, 0x64, 0x47, 0x6f, 0x6c, 0x66, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x2c, 0x0a, 0x0e, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xa2, 0xbb, 0x18, 0x02, 0x08, 0x01, 0x52,
+	0x0c, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x49, 0x64, 0x22, 0xa6, 0x01,
+	0x0a, 0x19, 0x52, 0x65, 0x61, 0x64, 0x47, 0x6f, 0x6c, 0x66, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f,
+	0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x6f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x4c, 0x0a, 0x0e, 0x67, 0x6f, 0x6c, 0x66,
+	0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x25, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x6f, 0x6c, 0x66, 0x4b, 0x65,
+	0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x0d, 0x67, 0x6f, 0x6c, 0x66, 0x4b, 0x65, 0x79,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22, 0xda, 0x01, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x42, 0x6f, 0x64, 0x79, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x65,
 	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
 	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x24, 0x0a, 0x0e, 0x69, 0x6e,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2c, 0x0a, 0x0e, 0x69, 0x6e,
 	0x70, 0x75, 0x74, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x49, 0x64,
-	0x12, 0x52, 0x0a, 0x10, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x27, 0x2e, 0x73, 0x70, 0x6f,
-	0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54,
-	0x79, 0x70, 0x65, 0x52, 0x0f, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x54, 0x79, 0x70, 0x65, 0x12, 0x50, 0x0a, 0x10, 0x66, 0x65, 0x65, 0x74, 0x5f, 0x6c, 0x69, 0x6e,
-	0x65, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26,
-	0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74,
-	0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x65, 0x65, 0x74, 0x4c, 0x69, 0x6e, 0x65,
-	0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x0e, 0x66, 0x65, 0x65, 0x74, 0x4c, 0x69, 0x6e, 0x65,
-	0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x34, 0x0a, 0x16, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x61, 0x78, 0x65, 0x73,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x14, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x41, 0x78, 0x65, 0x73, 0x12, 0x49, 0x0a, 0x21,
-	0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x61, 0x67,
-	0x65, 0x5f, 0x76, 0x61, 0x6e, 0x69, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x6f, 0x69, 0x6e,
-	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x1e, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x56, 0x61, 0x6e, 0x69, 0x73, 0x68, 0x69,
-	0x6e, 0x67, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x3d, 0x0a, 0x09, 0x67, 0x6f, 0x6c, 0x66, 0x5f,
-	0x62, 0x61, 0x6c, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x70, 0x6f,
-	0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x08, 0x67, 0x6f,
-	0x6c, 0x66, 0x42, 0x61, 0x6c, 0x6c, 0x12, 0x3d, 0x0a, 0x09, 0x63, 0x6c, 0x75, 0x62, 0x5f, 0x62,
-	0x75, 0x74, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x70, 0x6f, 0x72,
-	0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x08, 0x63, 0x6c, 0x75,
-	0x62, 0x42, 0x75, 0x74, 0x74, 0x12, 0x3d, 0x0a, 0x09, 0x63, 0x6c, 0x75, 0x62, 0x5f, 0x68, 0x65,
-	0x61, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74,
+	0x28, 0x09, 0x42, 0x06, 0xa2, 0xbb, 0x18, 0x02, 0x08, 0x01, 0x52, 0x0c, 0x69, 0x6e, 0x70, 0x75,
+	0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x69, 0x0a, 0x16, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74,
 	0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x08, 0x63, 0x6c, 0x75, 0x62,
-	0x48, 0x65, 0x61, 0x64, 0x12, 0x43, 0x0a, 0x0d, 0x73, 0x68, 0x6f, 0x75, 0x6c, 0x64, 0x65, 0x72,
-	0x5f, 0x74, 0x69, 0x6c, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x70,
-	0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x52, 0x0c, 0x73, 0x68, 0x6f,
-	0x75, 0x6c, 0x64, 0x65, 0x72, 0x54, 0x69, 0x6c, 0x74, 0x22, 0x37, 0x0a, 0x1b, 0x43, 0x61, 0x6c,
-	0x69, 0x62, 0x72, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x22, 0x6a, 0x0a, 0x1d, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x47,
-	0x6f, 0x6c, 0x66, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74,
-	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x24, 0x0a, 0x0e, 0x69, 0x6e, 0x70, 0x75,
-	0x74, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0c, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x49, 0x64, 0x22, 0xab,
-	0x01, 0x0a, 0x1e, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x47, 0x6f, 0x6c, 0x66,
-	0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x6f,
-	0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x0b, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x4c,
-	0x0a, 0x0e, 0x67, 0x6f, 0x6c, 0x66, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f,
-	0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x47, 0x6f, 0x6c, 0x66, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x0d, 0x67,
-	0x6f, 0x6c, 0x66, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22, 0x65, 0x0a, 0x18,
-	0x52, 0x65, 0x61, 0x64, 0x47, 0x6f, 0x6c, 0x66, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x24, 0x0a,
-	0x0e, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x49, 0x6d, 0x61, 0x67,
-	0x65, 0x49, 0x64, 0x22, 0xa6, 0x01, 0x0a, 0x19, 0x52, 0x65, 0x61, 0x64, 0x47, 0x6f, 0x6c, 0x66,
-	0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x6f,
-	0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x0b, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x4c,
-	0x0a, 0x0e, 0x67, 0x6f, 0x6c, 0x66, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f,
-	0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x47, 0x6f, 0x6c, 0x66, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x0d, 0x67,
-	0x6f, 0x6c, 0x66, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22, 0xca, 0x01, 0x0a,
-	0x1a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x64, 0x79, 0x4b, 0x65, 0x79, 0x70, 0x6f,
-	0x69, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x73,
-	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x12, 0x24, 0x0a, 0x0e, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f,
-	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x49,
-	0x6d, 0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x61, 0x0a, 0x16, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f,
-	0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x42, 0x6f, 0x64, 0x79, 0x32, 0x35, 0x50, 0x6f, 0x73, 0x65, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69,
-	0x6e, 0x74, 0x73, 0x52, 0x14, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x42, 0x6f, 0x64, 0x79,
-	0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22, 0x94, 0x01, 0x0a, 0x1b, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x64, 0x79, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x12, 0x5b, 0x0a, 0x16, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x67,
-	0x6f, 0x6c, 0x66, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79,
-	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x6f, 0x6c,
-	0x66, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x14, 0x75, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x64, 0x47, 0x6f, 0x6c, 0x66, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73,
-	0x22, 0x67, 0x0a, 0x1a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x6f, 0x6c, 0x66, 0x4b, 0x65,
-	0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23,
-	0x0a, 0x0d, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x12, 0x24, 0x0a, 0x0e, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x69, 0x6d, 0x61,
-	0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e, 0x70,
+	0x6f, 0x2e, 0x42, 0x6f, 0x64, 0x79, 0x32, 0x35, 0x50, 0x6f, 0x73, 0x65, 0x4b, 0x65, 0x79, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x42, 0x06, 0xa2, 0xbb, 0x18, 0x02, 0x08, 0x01, 0x52, 0x14, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x73, 0x22, 0x94, 0x01, 0x0a, 0x1b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x6f,
+	0x64, 0x79, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x5b, 0x0a,
+	0x16, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x67, 0x6f, 0x6c, 0x66, 0x5f, 0x6b, 0x65,
+	0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e,
+	0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73,
+	0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x6f, 0x6c, 0x66, 0x4b, 0x65, 0x79, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x73, 0x52, 0x14, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x47, 0x6f, 0x6c,
+	0x66, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22, 0x83, 0x02, 0x0a, 0x1a, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x47, 0x6f, 0x6c, 0x66, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2c,
+	0x0a, 0x0e, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xa2, 0xbb, 0x18, 0x02, 0x08, 0x01, 0x52, 0x0c,
+	0x69, 0x6e, 0x70, 0x75, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x0d,
+	0x6f, 0x70, 0x65, 0x6e, 0x70, 0x6f, 0x73, 0x65, 0x5f, 0x6a, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0c, 0x42, 0x08, 0xa2, 0xbb, 0x18, 0x04, 0x30, 0x80, 0x80, 0x40, 0x48, 0x00, 0x52,
+	0x0c, 0x6f, 0x70, 0x65, 0x6e, 0x70, 0x6f, 0x73, 0x65, 0x4a, 0x73, 0x6f, 0x6e, 0x12, 0x54, 0x0a,
+	0x0e, 0x62, 0x6f, 0x64, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b,
+	0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42,
+	0x6f, 0x64, 0x79, 0x32, 0x35, 0x50, 0x6f, 0x73, 0x65, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x73, 0x48, 0x00, 0x52, 0x0d, 0x62, 0x6f, 0x64, 0x79, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x73, 0x42, 0x0b, 0x0a, 0x09, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73,
+	0x22, 0x85, 0x01, 0x0a, 0x1b, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x47, 0x6f, 0x6c, 0x66, 0x4b,
+	0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x4c, 0x0a, 0x0e, 0x67, 0x6f,
+	0x6c, 0x66, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x25, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x6f, 0x6c, 0x66,
+	0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x0d, 0x67, 0x6f, 0x6c, 0x66, 0x4b,
+	0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22, 0x6f, 0x0a, 0x1a, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x47, 0x6f, 0x6c, 0x66, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2c, 0x0a, 0x0e, 0x69,
+	0x6e, 0x70, 0x75, 0x74, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x06, 0xa2, 0xbb, 0x18, 0x02, 0x08, 0x01, 0x52, 0x0c, 0x69, 0x6e, 0x70,
 	0x75, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x49, 0x64, 0x22, 0x37, 0x0a, 0x1b, 0x44, 0x65, 0x6c,
 	0x65, 0x74, 0x65, 0x47, 0x6f, 0x6c, 0x66, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73,
 	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63,
 	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x22, 0xaa, 0x02, 0x0a, 0x0d, 0x47, 0x6f, 0x6c, 0x66, 0x4b, 0x65, 0x79, 0x70, 0x6f,
-	0x69, 0x6e, 0x74, 0x73, 0x12, 0x5d, 0x0a, 0x15, 0x64, 0x74, 0x6c, 0x5f, 0x67, 0x6f, 0x6c, 0x66,
-	0x5f, 0x73, 0x65, 0x74, 0x75, 0x70, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79,
-	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x54, 0x4c,
-	0x47, 0x6f, 0x6c, 0x66, 0x53, 0x65, 0x74, 0x75, 0x70, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52,
-	0x12, 0x64, 0x74, 0x6c, 0x47, 0x6f, 0x6c, 0x66, 0x53, 0x65, 0x74, 0x75, 0x70, 0x50, 0x6f, 0x69,
-	0x6e, 0x74, 0x73, 0x12, 0x66, 0x0a, 0x18, 0x66, 0x61, 0x63, 0x65, 0x6f, 0x6e, 0x5f, 0x67, 0x6f,
-	0x6c, 0x66, 0x5f, 0x73, 0x65, 0x74, 0x75, 0x70, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b,
-	0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46,
-	0x61, 0x63, 0x65, 0x4f, 0x6e, 0x47, 0x6f, 0x6c, 0x66, 0x53, 0x65, 0x74, 0x75, 0x70, 0x50, 0x6f,
-	0x69, 0x6e, 0x74, 0x73, 0x52, 0x15, 0x66, 0x61, 0x63, 0x65, 0x6f, 0x6e, 0x47, 0x6f, 0x6c, 0x66,
-	0x53, 0x65, 0x74, 0x75, 0x70, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x52, 0x0a, 0x0e, 0x62,
-	0x6f, 0x64, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79,
-	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6f, 0x64,
-	0x79, 0x32, 0x35, 0x50, 0x6f, 0x73, 0x65, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73,
-	0x52, 0x0d, 0x62, 0x6f, 0x64, 0x79, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22,
-	0x94, 0x05, 0x0a, 0x12, 0x44, 0x54, 0x4c, 0x47, 0x6f, 0x6c, 0x66, 0x53, 0x65, 0x74, 0x75, 0x70,
-	0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x3f, 0x0a, 0x0b, 0x73, 0x70, 0x69, 0x6e, 0x65, 0x5f,
-	0x61, 0x6e, 0x67, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x70,
+	0x73, 0x73, 0x22, 0xa8, 0x01, 0x0a, 0x1d, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6e, 0x70,
+	0x75, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x4d, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f,
+	0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x48, 0x00, 0x52, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x18, 0x0a, 0x06, 0x73,
+	0x68, 0x61, 0x32, 0x35, 0x36, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x73,
+	0x68, 0x61, 0x32, 0x35, 0x36, 0x42, 0x06, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x96, 0x02,
+	0x0a, 0x20, 0x43, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74,
+	0x49, 0x6d, 0x61, 0x67, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x50, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65,
+	0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x61,
+	0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x49, 0x6d, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x12, 0x45, 0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x61, 0x6c,
+	0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x43, 0x68, 0x75,
+	0x6e, 0x6b, 0x48, 0x00, 0x52, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x51, 0x0a, 0x09, 0x63,
+	0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31,
+	0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d,
+	0x73, 0x48, 0x00, 0x52, 0x09, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x73, 0x42, 0x06,
+	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x82, 0x01, 0x0a, 0x15, 0x43, 0x61, 0x6c, 0x69, 0x62,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x43, 0x68, 0x75, 0x6e, 0x6b,
+	0x12, 0x55, 0x0a, 0x11, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x28, 0x2e, 0x73, 0x70,
 	0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x52, 0x0a, 0x73, 0x70, 0x69,
-	0x6e, 0x65, 0x41, 0x6e, 0x67, 0x6c, 0x65, 0x12, 0x45, 0x0a, 0x0e, 0x66, 0x65, 0x65, 0x74, 0x5f,
-	0x61, 0x6c, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1e, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e,
-	0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x52,
-	0x0d, 0x66, 0x65, 0x65, 0x74, 0x41, 0x6c, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x45,
-	0x0a, 0x0e, 0x68, 0x65, 0x65, 0x6c, 0x5f, 0x61, 0x6c, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x10, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xb6, 0x01, 0x0a, 0x19,
+	0x43, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6d, 0x61, 0x67, 0x65,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x73, 0x12, 0x41, 0x0a, 0x1d, 0x63, 0x61, 0x6c,
+	0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x61,
+	0x78, 0x65, 0x73, 0x5f, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x1a, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6d, 0x61,
+	0x67, 0x65, 0x41, 0x78, 0x65, 0x73, 0x53, 0x68, 0x61, 0x32, 0x35, 0x36, 0x12, 0x56, 0x0a, 0x28,
+	0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x61, 0x67,
+	0x65, 0x5f, 0x76, 0x61, 0x6e, 0x69, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x5f, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x24,
+	0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6d, 0x61, 0x67, 0x65,
+	0x56, 0x61, 0x6e, 0x69, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x53, 0x68,
+	0x61, 0x32, 0x35, 0x36, 0x22, 0x6e, 0x0a, 0x19, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64,
+	0x49, 0x6e, 0x70, 0x75, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2c, 0x0a, 0x0e, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f,
+	0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06,
+	0xa2, 0xbb, 0x18, 0x02, 0x08, 0x01, 0x52, 0x0c, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x49, 0x6d, 0x61,
+	0x67, 0x65, 0x49, 0x64, 0x22, 0xd5, 0x01, 0x0a, 0x1f, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61,
+	0x64, 0x43, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6d, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2c, 0x0a,
+	0x0e, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xa2, 0xbb, 0x18, 0x02, 0x08, 0x01, 0x52, 0x0c, 0x69,
+	0x6e, 0x70, 0x75, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x5f, 0x0a, 0x11, 0x63,
+	0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x28, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f,
 	0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x52, 0x0d, 0x68, 0x65, 0x65, 0x6c, 0x41, 0x6c, 0x69, 0x67,
-	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x43, 0x0a, 0x0d, 0x74, 0x6f, 0x65, 0x5f, 0x61, 0x6c, 0x69,
-	0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73,
+	0x43, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6d, 0x61, 0x67, 0x65,
+	0x42, 0x08, 0xa2, 0xbb, 0x18, 0x04, 0x18, 0x01, 0x20, 0x01, 0x52, 0x10, 0x63, 0x61, 0x6c, 0x69,
+	0x62, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x22, 0x6f, 0x0a, 0x1a,
+	0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x49, 0x6d,
+	0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x2c, 0x0a, 0x0e, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xa2, 0xbb, 0x18, 0x02, 0x08, 0x01, 0x52,
+	0x0c, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x49, 0x64, 0x22, 0x70, 0x0a,
+	0x15, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65,
+	0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x6d,
+	0x61, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x12,
+	0x16, 0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00,
+	0x52, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x42, 0x06, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22,
+	0x37, 0x0a, 0x09, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x22, 0x6a, 0x0a, 0x15, 0x53, 0x75, 0x62, 0x6d,
+	0x69, 0x74, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2c, 0x0a, 0x0e, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f,
+	0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06,
+	0xa2, 0xbb, 0x18, 0x02, 0x08, 0x01, 0x52, 0x0c, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x49, 0x6d, 0x61,
+	0x67, 0x65, 0x49, 0x64, 0x22, 0x49, 0x0a, 0x16, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x41, 0x6e,
+	0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x22,
+	0x5b, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x4a, 0x6f,
+	0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1d, 0x0a,
+	0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xa2,
+	0xbb, 0x18, 0x02, 0x08, 0x01, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x22, 0x69, 0x0a, 0x16,
+	0x47, 0x65, 0x74, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x4a, 0x6f, 0x62, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x12, 0x35, 0x0a, 0x03, 0x6a, 0x6f, 0x62, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e,
+	0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73,
+	0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x4a,
+	0x6f, 0x62, 0x52, 0x03, 0x6a, 0x6f, 0x62, 0x22, 0x5d, 0x0a, 0x17, 0x57, 0x61, 0x74, 0x63, 0x68,
+	0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1d, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xa2, 0xbb, 0x18, 0x02, 0x08, 0x01, 0x52,
+	0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x22, 0x9f, 0x03, 0x0a, 0x0b, 0x41, 0x6e, 0x61, 0x6c, 0x79,
+	0x73, 0x69, 0x73, 0x4a, 0x6f, 0x62, 0x12, 0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x12, 0x24, 0x0a,
+	0x0e, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x49, 0x6d, 0x61, 0x67,
+	0x65, 0x49, 0x64, 0x12, 0x3e, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x28, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x6e, 0x61, 0x6c,
+	0x79, 0x73, 0x69, 0x73, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63,
+	0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x43, 0x6f, 0x64, 0x65, 0x12, 0x4c, 0x0a, 0x0e, 0x67, 0x6f, 0x6c, 0x66, 0x5f, 0x6b, 0x65, 0x79,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x73,
 	0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x52, 0x0c, 0x74, 0x6f,
-	0x65, 0x41, 0x6c, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x4d, 0x0a, 0x12, 0x73, 0x68,
-	0x6f, 0x75, 0x6c, 0x64, 0x65, 0x72, 0x5f, 0x61, 0x6c, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f,
-	0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x52, 0x11, 0x73, 0x68, 0x6f, 0x75, 0x6c, 0x64, 0x65, 0x72,
-	0x41, 0x6c, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x47, 0x0a, 0x0f, 0x77, 0x61, 0x69,
-	0x73, 0x74, 0x5f, 0x61, 0x6c, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x6f, 0x6c, 0x66, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x73, 0x52, 0x0d, 0x67, 0x6f, 0x6c, 0x66, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a,
+	0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xfb, 0x02, 0x0a, 0x0d, 0x47, 0x6f, 0x6c,
+	0x66, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x5d, 0x0a, 0x15, 0x64, 0x74,
+	0x6c, 0x5f, 0x67, 0x6f, 0x6c, 0x66, 0x5f, 0x73, 0x65, 0x74, 0x75, 0x70, 0x5f, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x73, 0x70, 0x6f, 0x72,
+	0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x44, 0x54, 0x4c, 0x47, 0x6f, 0x6c, 0x66, 0x53, 0x65, 0x74, 0x75, 0x70, 0x50,
+	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x12, 0x64, 0x74, 0x6c, 0x47, 0x6f, 0x6c, 0x66, 0x53, 0x65,
+	0x74, 0x75, 0x70, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x66, 0x0a, 0x18, 0x66, 0x61, 0x63,
+	0x65, 0x6f, 0x6e, 0x5f, 0x67, 0x6f, 0x6c, 0x66, 0x5f, 0x73, 0x65, 0x74, 0x75, 0x70, 0x5f, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x73, 0x70,
+	0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x61, 0x63, 0x65, 0x4f, 0x6e, 0x47, 0x6f, 0x6c, 0x66, 0x53,
+	0x65, 0x74, 0x75, 0x70, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x15, 0x66, 0x61, 0x63, 0x65,
+	0x6f, 0x6e, 0x47, 0x6f, 0x6c, 0x66, 0x53, 0x65, 0x74, 0x75, 0x70, 0x50, 0x6f, 0x69, 0x6e, 0x74,
+	0x73, 0x12, 0x52, 0x0a, 0x0e, 0x62, 0x6f, 0x64, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x73, 0x70, 0x6f, 0x72,
+	0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x42, 0x6f, 0x64, 0x79, 0x32, 0x35, 0x50, 0x6f, 0x73, 0x65, 0x4b, 0x65, 0x79,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x0d, 0x62, 0x6f, 0x64, 0x79, 0x4b, 0x65, 0x79, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x4f, 0x0a, 0x0f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26,
+	0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x0e, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x22, 0x94, 0x05, 0x0a, 0x12, 0x44, 0x54, 0x4c, 0x47, 0x6f,
+	0x6c, 0x66, 0x53, 0x65, 0x74, 0x75, 0x70, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x3f, 0x0a,
+	0x0b, 0x73, 0x70, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x6e, 0x67, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70,
 	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x75, 0x62,
-	0x6c, 0x65, 0x52, 0x0e, 0x77, 0x61, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x69, 0x67, 0x6e, 0x6d, 0x65,
-	0x6e, 0x74, 0x12, 0x3b, 0x0a, 0x09, 0x6b, 0x6e, 0x65, 0x65, 0x5f, 0x62, 0x65, 0x6e, 0x64, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b,
-	0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44,
-	0x6f, 0x75, 0x62, 0x6c, 0x65, 0x52, 0x08, 0x6b, 0x6e, 0x65, 0x65, 0x42, 0x65, 0x6e, 0x64, 0x12,
-	0x4c, 0x0a, 0x12, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d,
-	0x5f, 0x62, 0x61, 0x6c, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x70,
-	0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x52, 0x10, 0x64, 0x69, 0x73,
-	0x74, 0x61, 0x6e, 0x63, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x42, 0x61, 0x6c, 0x6c, 0x12, 0x47, 0x0a,
-	0x0f, 0x75, 0x6c, 0x6e, 0x61, 0x72, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f,
+	0x6c, 0x65, 0x52, 0x0a, 0x73, 0x70, 0x69, 0x6e, 0x65, 0x41, 0x6e, 0x67, 0x6c, 0x65, 0x12, 0x45,
+	0x0a, 0x0e, 0x66, 0x65, 0x65, 0x74, 0x5f, 0x61, 0x6c, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f,
 	0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x52, 0x0e, 0x75, 0x6c, 0x6e, 0x61, 0x72, 0x44, 0x65, 0x76,
-	0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xf9, 0x05, 0x0a, 0x15, 0x46, 0x61, 0x63, 0x65, 0x4f,
-	0x6e, 0x47, 0x6f, 0x6c, 0x66, 0x53, 0x65, 0x74, 0x75, 0x70, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73,
-	0x12, 0x3b, 0x0a, 0x09, 0x73, 0x69, 0x64, 0x65, 0x5f, 0x62, 0x65, 0x6e, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79,
-	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x75,
-	0x62, 0x6c, 0x65, 0x52, 0x08, 0x73, 0x69, 0x64, 0x65, 0x42, 0x65, 0x6e, 0x64, 0x12, 0x40, 0x0a,
-	0x0c, 0x6c, 0x5f, 0x66, 0x6f, 0x6f, 0x74, 0x5f, 0x66, 0x6c, 0x61, 0x72, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79,
-	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x75,
-	0x62, 0x6c, 0x65, 0x52, 0x0a, 0x6c, 0x46, 0x6f, 0x6f, 0x74, 0x46, 0x6c, 0x61, 0x72, 0x65, 0x12,
-	0x40, 0x0a, 0x0c, 0x72, 0x5f, 0x66, 0x6f, 0x6f, 0x74, 0x5f, 0x66, 0x6c, 0x61, 0x72, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b,
-	0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44,
-	0x6f, 0x75, 0x62, 0x6c, 0x65, 0x52, 0x0a, 0x72, 0x46, 0x6f, 0x6f, 0x74, 0x46, 0x6c, 0x61, 0x72,
-	0x65, 0x12, 0x41, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x77, 0x69, 0x64, 0x74,
-	0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73,
-	0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x57,
-	0x69, 0x64, 0x74, 0x68, 0x12, 0x43, 0x0a, 0x0d, 0x73, 0x68, 0x6f, 0x75, 0x6c, 0x64, 0x65, 0x72,
-	0x5f, 0x74, 0x69, 0x6c, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x70,
-	0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x52, 0x0c, 0x73, 0x68, 0x6f,
-	0x75, 0x6c, 0x64, 0x65, 0x72, 0x54, 0x69, 0x6c, 0x74, 0x12, 0x3d, 0x0a, 0x0a, 0x77, 0x61, 0x69,
-	0x73, 0x74, 0x5f, 0x74, 0x69, 0x6c, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
+	0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x52, 0x0d, 0x66, 0x65, 0x65, 0x74, 0x41, 0x6c, 0x69, 0x67,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x45, 0x0a, 0x0e, 0x68, 0x65, 0x65, 0x6c, 0x5f, 0x61, 0x6c,
+	0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
 	0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73,
-	0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x52, 0x09, 0x77,
-	0x61, 0x69, 0x73, 0x74, 0x54, 0x69, 0x6c, 0x74, 0x12, 0x3d, 0x0a, 0x0a, 0x73, 0x68, 0x61, 0x66,
-	0x74, 0x5f, 0x6c, 0x65, 0x61, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73,
-	0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x52, 0x09, 0x73, 0x68,
-	0x61, 0x66, 0x74, 0x4c, 0x65, 0x61, 0x6e, 0x12, 0x43, 0x0a, 0x0d, 0x62, 0x61, 0x6c, 0x6c, 0x5f,
-	0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e,
-	0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74,
-	0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x52, 0x0c,
-	0x62, 0x61, 0x6c, 0x6c, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x43, 0x0a, 0x0d,
-	0x68, 0x65, 0x61, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20,
+	0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x52, 0x0d, 0x68,
+	0x65, 0x65, 0x6c, 0x41, 0x6c, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x43, 0x0a, 0x0d,
+	0x74, 0x6f, 0x65, 0x5f, 0x61, 0x6c, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79,
 	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x75,
-	0x62, 0x6c, 0x65, 0x52, 0x0c, 0x68, 0x65, 0x61, 0x64, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x45, 0x0a, 0x0e, 0x63, 0x68, 0x65, 0x73, 0x74, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x70, 0x6f, 0x72,
+	0x62, 0x6c, 0x65, 0x52, 0x0c, 0x74, 0x6f, 0x65, 0x41, 0x6c, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x4d, 0x0a, 0x12, 0x73, 0x68, 0x6f, 0x75, 0x6c, 0x64, 0x65, 0x72, 0x5f, 0x61, 0x6c,
+	0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
+	0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73,
+	0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x52, 0x11, 0x73,
+	0x68, 0x6f, 0x75, 0x6c, 0x64, 0x65, 0x72, 0x41, 0x6c, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x47, 0x0a, 0x0f, 0x77, 0x61, 0x69, 0x73, 0x74, 0x5f, 0x61, 0x6c, 0x69, 0x67, 0x6e, 0x6d,
+	0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x70, 0x6f, 0x72,
 	0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x52, 0x0d, 0x63, 0x68, 0x65, 0x73, 0x74,
-	0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x48, 0x0a, 0x10, 0x6d, 0x69, 0x64, 0x5f,
-	0x68, 0x69, 0x70, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01,
+	0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x52, 0x0e, 0x77, 0x61, 0x69, 0x73, 0x74,
+	0x41, 0x6c, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x3b, 0x0a, 0x09, 0x6b, 0x6e, 0x65,
+	0x65, 0x5f, 0x62, 0x65, 0x6e, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73,
+	0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x52, 0x08, 0x6b, 0x6e,
+	0x65, 0x65, 0x42, 0x65, 0x6e, 0x64, 0x12, 0x4c, 0x0a, 0x12, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x62, 0x61, 0x6c, 0x6c, 0x18, 0x08, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70,
 	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x75, 0x62,
-	0x6c, 0x65, 0x52, 0x0e, 0x6d, 0x69, 0x64, 0x48, 0x69, 0x70, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69,
-	0x6f, 0x6e, 0x2a, 0x3d, 0x0a, 0x09, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12,
-	0x1a, 0x0a, 0x16, 0x49, 0x4d, 0x41, 0x47, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e,
-	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x46,
-	0x41, 0x43, 0x45, 0x5f, 0x4f, 0x4e, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x44, 0x54, 0x4c, 0x10,
-	0x02, 0x2a, 0x80, 0x01, 0x0a, 0x0f, 0x43, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x0e, 0x4e, 0x4f, 0x5f, 0x43, 0x41, 0x4c, 0x49,
-	0x42, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x41, 0x58, 0x45,
-	0x53, 0x5f, 0x43, 0x41, 0x4c, 0x49, 0x42, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4f, 0x4e,
-	0x4c, 0x59, 0x10, 0x01, 0x12, 0x28, 0x0a, 0x24, 0x41, 0x58, 0x45, 0x53, 0x5f, 0x41, 0x4e, 0x44,
-	0x5f, 0x56, 0x41, 0x4e, 0x49, 0x53, 0x48, 0x49, 0x4e, 0x47, 0x5f, 0x50, 0x4f, 0x49, 0x4e, 0x54,
-	0x5f, 0x43, 0x41, 0x4c, 0x49, 0x42, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x02, 0x12, 0x14,
-	0x0a, 0x10, 0x46, 0x55, 0x4c, 0x4c, 0x5f, 0x43, 0x41, 0x4c, 0x49, 0x42, 0x52, 0x41, 0x54, 0x49,
-	0x4f, 0x4e, 0x10, 0x03, 0x2a, 0x57, 0x0a, 0x0e, 0x46, 0x65, 0x65, 0x74, 0x4c, 0x69, 0x6e, 0x65,
-	0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x20, 0x0a, 0x1c, 0x46, 0x45, 0x45, 0x54, 0x5f, 0x4c,
-	0x49, 0x4e, 0x45, 0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
-	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x55, 0x53, 0x45, 0x5f,
-	0x48, 0x45, 0x45, 0x4c, 0x5f, 0x4c, 0x49, 0x4e, 0x45, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x55,
-	0x53, 0x45, 0x5f, 0x54, 0x4f, 0x45, 0x5f, 0x4c, 0x49, 0x4e, 0x45, 0x10, 0x02, 0x32, 0x98, 0x09,
-	0x0a, 0x14, 0x47, 0x6f, 0x6c, 0x66, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x77, 0x0a, 0x10, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64,
-	0x49, 0x6e, 0x70, 0x75, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x2f, 0x2e, 0x73, 0x70, 0x6f,
+	0x6c, 0x65, 0x52, 0x10, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x46, 0x72, 0x6f, 0x6d,
+	0x42, 0x61, 0x6c, 0x6c, 0x12, 0x47, 0x0a, 0x0f, 0x75, 0x6c, 0x6e, 0x61, 0x72, 0x5f, 0x64, 0x65,
+	0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
+	0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73,
+	0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x52, 0x0e, 0x75,
+	0x6c, 0x6e, 0x61, 0x72, 0x44, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xf9, 0x05,
+	0x0a, 0x15, 0x46, 0x61, 0x63, 0x65, 0x4f, 0x6e, 0x47, 0x6f, 0x6c, 0x66, 0x53, 0x65, 0x74, 0x75,
+	0x70, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x3b, 0x0a, 0x09, 0x73, 0x69, 0x64, 0x65, 0x5f,
+	0x62, 0x65, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x70, 0x6f,
 	0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x49,
-	0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x73, 0x70,
-	0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6e, 0x70, 0x75, 0x74,
-	0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x89, 0x01, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x49, 0x6d, 0x61,
-	0x67, 0x65, 0x73, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x12, 0x35, 0x2e, 0x73, 0x70, 0x6f,
+	0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x52, 0x08, 0x73, 0x69, 0x64, 0x65,
+	0x42, 0x65, 0x6e, 0x64, 0x12, 0x40, 0x0a, 0x0c, 0x6c, 0x5f, 0x66, 0x6f, 0x6f, 0x74, 0x5f, 0x66,
+	0x6c, 0x61, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x70, 0x6f,
 	0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x49, 0x6d, 0x61,
-	0x67, 0x65, 0x73, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x36, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f,
-	0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49,
-	0x6e, 0x70, 0x75, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65,
-	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x71, 0x0a, 0x0e, 0x52,
-	0x65, 0x61, 0x64, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x2d, 0x2e,
-	0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73,
-	0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x49, 0x6e, 0x70, 0x75, 0x74,
-	0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x73,
+	0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x52, 0x0a, 0x6c, 0x46, 0x6f, 0x6f,
+	0x74, 0x46, 0x6c, 0x61, 0x72, 0x65, 0x12, 0x40, 0x0a, 0x0c, 0x72, 0x5f, 0x66, 0x6f, 0x6f, 0x74,
+	0x5f, 0x66, 0x6c, 0x61, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73,
 	0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x49,
-	0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x77,
-	0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x49, 0x6d, 0x61,
-	0x67, 0x65, 0x12, 0x2f, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70,
-	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79,
-	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x80, 0x01, 0x0a, 0x13, 0x43, 0x61, 0x6c, 0x69,
-	0x62, 0x72, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12,
-	0x32, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e,
-	0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61,
-	0x74, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79,
-	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x61, 0x6c,
-	0x69, 0x62, 0x72, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x89, 0x01, 0x0a, 0x16, 0x43,
-	0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x47, 0x6f, 0x6c, 0x66, 0x4b, 0x65, 0x79, 0x70,
-	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x35, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b,
-	0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43,
-	0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x47, 0x6f, 0x6c, 0x66, 0x4b, 0x65, 0x79, 0x70,
-	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e, 0x73,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x52, 0x0a, 0x72, 0x46,
+	0x6f, 0x6f, 0x74, 0x46, 0x6c, 0x61, 0x72, 0x65, 0x12, 0x41, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x5f, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e,
+	0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x52, 0x0b,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x57, 0x69, 0x64, 0x74, 0x68, 0x12, 0x43, 0x0a, 0x0d, 0x73,
+	0x68, 0x6f, 0x75, 0x6c, 0x64, 0x65, 0x72, 0x5f, 0x74, 0x69, 0x6c, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x75, 0x62,
+	0x6c, 0x65, 0x52, 0x0c, 0x73, 0x68, 0x6f, 0x75, 0x6c, 0x64, 0x65, 0x72, 0x54, 0x69, 0x6c, 0x74,
+	0x12, 0x3d, 0x0a, 0x0a, 0x77, 0x61, 0x69, 0x73, 0x74, 0x5f, 0x74, 0x69, 0x6c, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65,
+	0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f,
+	0x75, 0x62, 0x6c, 0x65, 0x52, 0x09, 0x77, 0x61, 0x69, 0x73, 0x74, 0x54, 0x69, 0x6c, 0x74, 0x12,
+	0x3d, 0x0a, 0x0a, 0x73, 0x68, 0x61, 0x66, 0x74, 0x5f, 0x6c, 0x65, 0x61, 0x6e, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x75,
+	0x62, 0x6c, 0x65, 0x52, 0x09, 0x73, 0x68, 0x61, 0x66, 0x74, 0x4c, 0x65, 0x61, 0x6e, 0x12, 0x43,
+	0x0a, 0x0d, 0x62, 0x61, 0x6c, 0x6c, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b,
+	0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44,
+	0x6f, 0x75, 0x62, 0x6c, 0x65, 0x52, 0x0c, 0x62, 0x61, 0x6c, 0x6c, 0x50, 0x6f, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x43, 0x0a, 0x0d, 0x68, 0x65, 0x61, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x70, 0x6f,
+	0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x52, 0x0c, 0x68, 0x65, 0x61, 0x64,
+	0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x45, 0x0a, 0x0e, 0x63, 0x68, 0x65, 0x73,
+	0x74, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1e, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65,
+	0x52, 0x0d, 0x63, 0x68, 0x65, 0x73, 0x74, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x48, 0x0a, 0x10, 0x6d, 0x69, 0x64, 0x5f, 0x68, 0x69, 0x70, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x70, 0x6f, 0x72,
+	0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x52, 0x0e, 0x6d, 0x69, 0x64, 0x48, 0x69,
+	0x70, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x2a, 0x99, 0x01, 0x0a, 0x10, 0x41, 0x6e,
+	0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x22,
+	0x0a, 0x1e, 0x41, 0x4e, 0x41, 0x4c, 0x59, 0x53, 0x49, 0x53, 0x5f, 0x4a, 0x4f, 0x42, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x41, 0x4e, 0x41, 0x4c, 0x59, 0x53, 0x49, 0x53, 0x5f, 0x4a,
+	0x4f, 0x42, 0x5f, 0x51, 0x55, 0x45, 0x55, 0x45, 0x44, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x41,
+	0x4e, 0x41, 0x4c, 0x59, 0x53, 0x49, 0x53, 0x5f, 0x4a, 0x4f, 0x42, 0x5f, 0x52, 0x55, 0x4e, 0x4e,
+	0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x15, 0x0a, 0x11, 0x41, 0x4e, 0x41, 0x4c, 0x59, 0x53, 0x49,
+	0x53, 0x5f, 0x4a, 0x4f, 0x42, 0x5f, 0x44, 0x4f, 0x4e, 0x45, 0x10, 0x03, 0x12, 0x17, 0x0a, 0x13,
+	0x41, 0x4e, 0x41, 0x4c, 0x59, 0x53, 0x49, 0x53, 0x5f, 0x4a, 0x4f, 0x42, 0x5f, 0x46, 0x41, 0x49,
+	0x4c, 0x45, 0x44, 0x10, 0x04, 0x2a, 0x3d, 0x0a, 0x09, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x1a, 0x0a, 0x16, 0x49, 0x4d, 0x41, 0x47, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0b,
+	0x0a, 0x07, 0x46, 0x41, 0x43, 0x45, 0x5f, 0x4f, 0x4e, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x44,
+	0x54, 0x4c, 0x10, 0x02, 0x2a, 0x80, 0x01, 0x0a, 0x0f, 0x43, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x0e, 0x4e, 0x4f, 0x5f, 0x43,
+	0x41, 0x4c, 0x49, 0x42, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15,
+	0x41, 0x58, 0x45, 0x53, 0x5f, 0x43, 0x41, 0x4c, 0x49, 0x42, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x4f, 0x4e, 0x4c, 0x59, 0x10, 0x01, 0x12, 0x28, 0x0a, 0x24, 0x41, 0x58, 0x45, 0x53, 0x5f,
+	0x41, 0x4e, 0x44, 0x5f, 0x56, 0x41, 0x4e, 0x49, 0x53, 0x48, 0x49, 0x4e, 0x47, 0x5f, 0x50, 0x4f,
+	0x49, 0x4e, 0x54, 0x5f, 0x43, 0x41, 0x4c, 0x49, 0x42, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10,
+	0x02, 0x12, 0x14, 0x0a, 0x10, 0x46, 0x55, 0x4c, 0x4c, 0x5f, 0x43, 0x41, 0x4c, 0x49, 0x42, 0x52,
+	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x03, 0x2a, 0x78, 0x0a, 0x10, 0x43, 0x61, 0x6c, 0x69, 0x62,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x21, 0x0a, 0x1d, 0x43,
+	0x41, 0x4c, 0x49, 0x42, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x49, 0x4d, 0x41, 0x47, 0x45,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1a,
+	0x0a, 0x16, 0x43, 0x41, 0x4c, 0x49, 0x42, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x49, 0x4d,
+	0x41, 0x47, 0x45, 0x5f, 0x41, 0x58, 0x45, 0x53, 0x10, 0x01, 0x12, 0x25, 0x0a, 0x21, 0x43, 0x41,
+	0x4c, 0x49, 0x42, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x49, 0x4d, 0x41, 0x47, 0x45, 0x5f,
+	0x56, 0x41, 0x4e, 0x49, 0x53, 0x48, 0x49, 0x4e, 0x47, 0x5f, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x10,
+	0x02, 0x2a, 0x57, 0x0a, 0x0e, 0x46, 0x65, 0x65, 0x74, 0x4c, 0x69, 0x6e, 0x65, 0x4d, 0x65, 0x74,
+	0x68, 0x6f, 0x64, 0x12, 0x20, 0x0a, 0x1c, 0x46, 0x45, 0x45, 0x54, 0x5f, 0x4c, 0x49, 0x4e, 0x45,
+	0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x55, 0x53, 0x45, 0x5f, 0x48, 0x45, 0x45,
+	0x4c, 0x5f, 0x4c, 0x49, 0x4e, 0x45, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x55, 0x53, 0x45, 0x5f,
+	0x54, 0x4f, 0x45, 0x5f, 0x4c, 0x49, 0x4e, 0x45, 0x10, 0x02, 0x32, 0x8b, 0x12, 0x0a, 0x14, 0x47,
+	0x6f, 0x6c, 0x66, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x12, 0x77, 0x0a, 0x10, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6e, 0x70,
+	0x75, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x2f, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73,
+	0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x49, 0x6d, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74,
+	0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x49, 0x6d, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x89, 0x01, 0x0a,
+	0x16, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73,
+	0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x12, 0x35, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73,
+	0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73,
+	0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x36,
+	0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x70, 0x75,
+	0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x71, 0x0a, 0x0e, 0x52, 0x65, 0x61, 0x64,
+	0x49, 0x6e, 0x70, 0x75, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x2d, 0x2e, 0x73, 0x70, 0x6f,
+	0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x49, 0x6d, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x73, 0x70, 0x6f, 0x72,
+	0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x49, 0x6d, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x77, 0x0a, 0x10, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12,
+	0x2f, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49,
+	0x6e, 0x70, 0x75, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x30, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x49, 0x6e, 0x70, 0x75, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x80, 0x01, 0x0a, 0x13, 0x43, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61,
+	0x74, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x32, 0x2e, 0x73,
 	0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x47,
-	0x6f, 0x6c, 0x66, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x7a, 0x0a, 0x11, 0x52, 0x65, 0x61, 0x64, 0x47, 0x6f,
-	0x6c, 0x66, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x30, 0x2e, 0x73, 0x70,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x65, 0x49,
+	0x6e, 0x70, 0x75, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x33, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x61, 0x6c, 0x69, 0x62, 0x72,
+	0x61, 0x74, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x89, 0x01, 0x0a, 0x16, 0x43, 0x61, 0x6c, 0x63,
+	0x75, 0x6c, 0x61, 0x74, 0x65, 0x47, 0x6f, 0x6c, 0x66, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x73, 0x12, 0x35, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x61, 0x6c, 0x63,
+	0x75, 0x6c, 0x61, 0x74, 0x65, 0x47, 0x6f, 0x6c, 0x66, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e, 0x73, 0x70, 0x6f, 0x72,
+	0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x47, 0x6f, 0x6c, 0x66,
+	0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x7a, 0x0a, 0x11, 0x52, 0x65, 0x61, 0x64, 0x47, 0x6f, 0x6c, 0x66, 0x4b,
+	0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x30, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74,
+	0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x47, 0x6f, 0x6c, 0x66, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x73, 0x70, 0x6f,
+	0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x47, 0x6f, 0x6c, 0x66, 0x4b, 0x65, 0x79, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x80, 0x01, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x64, 0x79, 0x4b, 0x65,
+	0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x32, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73,
+	0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x64, 0x79, 0x4b, 0x65, 0x79, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x73, 0x70,
 	0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x47, 0x6f, 0x6c, 0x66, 0x4b, 0x65, 0x79,
-	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e,
-	0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73,
-	0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x47, 0x6f, 0x6c, 0x66, 0x4b,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x64, 0x79, 0x4b,
 	0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x80, 0x01, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x64,
-	0x79, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x32, 0x2e, 0x73, 0x70, 0x6f,
+	0x22, 0x00, 0x12, 0x80, 0x01, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x6f, 0x6c,
+	0x66, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x32, 0x2e, 0x73, 0x70, 0x6f,
 	0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x64, 0x79, 0x4b, 0x65,
+	0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x6f, 0x6c, 0x66, 0x4b, 0x65,
 	0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33,
 	0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74,
-	0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x6f,
-	0x64, 0x79, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x80, 0x01, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x6f,
+	0x6c, 0x66, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x80, 0x01, 0x0a, 0x13, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
 	0x47, 0x6f, 0x6c, 0x66, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x32, 0x2e,
 	0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73,
-	0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x6f, 0x6c,
+	0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x47, 0x6f, 0x6c,
 	0x66, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
 	0x74, 0x1a, 0x33, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f,
-	0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x47, 0x6f, 0x6c, 0x66, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x47, 0x6f, 0x6c, 0x66, 0x4b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x85, 0x01, 0x0a, 0x16, 0x55, 0x70, 0x6c,
+	0x6f, 0x61, 0x64, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x12, 0x35, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x6c,
+	0x6f, 0x61, 0x64, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x73, 0x70, 0x6f,
+	0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x49,
+	0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01,
+	0x12, 0x8e, 0x01, 0x0a, 0x19, 0x43, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x65, 0x49, 0x6e,
+	0x70, 0x75, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x38,
+	0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74,
+	0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74,
+	0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x43, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74,
+	0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28,
+	0x01, 0x12, 0x7a, 0x0a, 0x12, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6e, 0x70,
+	0x75, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x31, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73,
+	0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x49, 0x6d,
+	0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x73, 0x70, 0x6f,
+	0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6d, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x86, 0x01,
+	0x0a, 0x18, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x61, 0x6c, 0x69, 0x62, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x37, 0x2e, 0x73, 0x70, 0x6f,
+	0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x61, 0x6c, 0x69,
+	0x62, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x77,
+	0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x7c, 0x0a, 0x13, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f,
+	0x61, 0x64, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x32, 0x2e,
+	0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73,
+	0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x4f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2d, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c,
+	0x6f, 0x61, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x30, 0x01, 0x12, 0x71, 0x0a, 0x0e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x41, 0x6e,
+	0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x12, 0x2d, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f,
+	0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b,
+	0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53,
+	0x75, 0x62, 0x6d, 0x69, 0x74, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x71, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x41, 0x6e,
+	0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x4a, 0x6f, 0x62, 0x12, 0x2d, 0x2e, 0x73, 0x70, 0x6f, 0x72,
+	0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x4a, 0x6f,
+	0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74,
+	0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x4a, 0x6f, 0x62,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6c, 0x0a, 0x10, 0x57, 0x61,
+	0x74, 0x63, 0x68, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x4a, 0x6f, 0x62, 0x12, 0x2f,
+	0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x41, 0x6e, 0x61,
+	0x6c, 0x79, 0x73, 0x69, 0x73, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x23, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69,
+	0x73, 0x4a, 0x6f, 0x62, 0x22, 0x00, 0x30, 0x01, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2020,101 +3558,154 @@ func file_golfkeypoints_proto_rawDescGZIP() []byte {
 	return file_golfkeypoints_proto_rawDescData
 }
 
-var file_golfkeypoints_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_golfkeypoints_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
+var file_golfkeypoints_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_golfkeypoints_proto_msgTypes = make([]protoimpl.MessageInfo, 38)
 var file_golfkeypoints_proto_goTypes = []interface{}{
-	(ImageType)(0),                         // 0: sports_keypoints_proto.ImageType
-	(CalibrationType)(0),                   // 1: sports_keypoints_proto.CalibrationType
-	(FeetLineMethod)(0),                    // 2: sports_keypoints_proto.FeetLineMethod
-	(*UploadInputImageRequest)(nil),        // 3: sports_keypoints_proto.UploadInputImageRequest
-	(*UploadInputImageResponse)(nil),       // 4: sports_keypoints_proto.UploadInputImageResponse
-	(*ListInputImagesForUserRequest)(nil),  // 5: sports_keypoints_proto.ListInputImagesForUserRequest
-	(*ListInputImagesForUserResponse)(nil), // 6: sports_keypoints_proto.ListInputImagesForUserResponse
-	(*ReadInputImageRequest)(nil),          // 7: sports_keypoints_proto.ReadInputImageRequest
-	(*ReadInputImageResponse)(nil),         // 8: sports_keypoints_proto.ReadInputImageResponse
-	(*DeleteInputImageRequest)(nil),        // 9: sports_keypoints_proto.DeleteInputImageRequest
-	(*DeleteInputImageResponse)(nil),       // 10: sports_keypoints_proto.DeleteInputImageResponse
-	(*CalibrateInputImageRequest)(nil),     // 11: sports_keypoints_proto.CalibrateInputImageRequest
-	(*CalibrateInputImageResponse)(nil),    // 12: sports_keypoints_proto.CalibrateInputImageResponse
-	(*CalculateGolfKeypointsRequest)(nil),  // 13: sports_keypoints_proto.CalculateGolfKeypointsRequest
-	(*CalculateGolfKeypointsResponse)(nil), // 14: sports_keypoints_proto.CalculateGolfKeypointsResponse
-	(*ReadGolfKeypointsRequest)(nil),       // 15: sports_keypoints_proto.ReadGolfKeypointsRequest
-	(*ReadGolfKeypointsResponse)(nil),      // 16: sports_keypoints_proto.ReadGolfKeypointsResponse
-	(*UpdateBodyKeypointsRequest)(nil),     // 17: sports_keypoints_proto.UpdateBodyKeypointsRequest
-	(*UpdateBodyKeypointsResponse)(nil),    // 18: sports_keypoints_proto.UpdateBodyKeypointsResponse
-	(*DeleteGolfKeypointsRequest)(nil),     // 19: sports_keypoints_proto.DeleteGolfKeypointsRequest
-	(*DeleteGolfKeypointsResponse)(nil),    // 20: sports_keypoints_proto.DeleteGolfKeypointsResponse
-	(*GolfKeypoints)(nil),                  // 21: sports_keypoints_proto.GolfKeypoints
-	(*DTLGolfSetupPoints)(nil),             // 22: sports_keypoints_proto.DTLGolfSetupPoints
-	(*FaceOnGolfSetupPoints)(nil),          // 23: sports_keypoints_proto.FaceOnGolfSetupPoints
-	(*timestamp.Timestamp)(nil),            // 24: google.protobuf.Timestamp
-	(*Keypoint)(nil),                       // 25: sports_keypoints_proto.Keypoint
-	(*Double)(nil),                         // 26: sports_keypoints_proto.Double
-	(*Body25PoseKeypoints)(nil),            // 27: sports_keypoints_proto.Body25PoseKeypoints
+	(AnalysisJobState)(0),                    // 0: sports_keypoints_proto.AnalysisJobState
+	(ImageType)(0),                           // 1: sports_keypoints_proto.ImageType
+	(CalibrationType)(0),                     // 2: sports_keypoints_proto.CalibrationType
+	(CalibrationImage)(0),                    // 3: sports_keypoints_proto.CalibrationImage
+	(FeetLineMethod)(0),                      // 4: sports_keypoints_proto.FeetLineMethod
+	(*UploadInputImageRequest)(nil),          // 5: sports_keypoints_proto.UploadInputImageRequest
+	(*UploadInputImageResponse)(nil),         // 6: sports_keypoints_proto.UploadInputImageResponse
+	(*ListInputImagesForUserRequest)(nil),    // 7: sports_keypoints_proto.ListInputImagesForUserRequest
+	(*ListInputImagesForUserResponse)(nil),   // 8: sports_keypoints_proto.ListInputImagesForUserResponse
+	(*ReadInputImageRequest)(nil),            // 9: sports_keypoints_proto.ReadInputImageRequest
+	(*ReadInputImageResponse)(nil),           // 10: sports_keypoints_proto.ReadInputImageResponse
+	(*DeleteInputImageRequest)(nil),          // 11: sports_keypoints_proto.DeleteInputImageRequest
+	(*DeleteInputImageResponse)(nil),         // 12: sports_keypoints_proto.DeleteInputImageResponse
+	(*CalibrateInputImageRequest)(nil),       // 13: sports_keypoints_proto.CalibrateInputImageRequest
+	(*CalibrateInputImageResponse)(nil),      // 14: sports_keypoints_proto.CalibrateInputImageResponse
+	(*CalculateGolfKeypointsRequest)(nil),    // 15: sports_keypoints_proto.CalculateGolfKeypointsRequest
+	(*CalculateGolfKeypointsResponse)(nil),   // 16: sports_keypoints_proto.CalculateGolfKeypointsResponse
+	(*ReadGolfKeypointsRequest)(nil),         // 17: sports_keypoints_proto.ReadGolfKeypointsRequest
+	(*ReadGolfKeypointsResponse)(nil),        // 18: sports_keypoints_proto.ReadGolfKeypointsResponse
+	(*UpdateBodyKeypointsRequest)(nil),       // 19: sports_keypoints_proto.UpdateBodyKeypointsRequest
+	(*UpdateBodyKeypointsResponse)(nil),      // 20: sports_keypoints_proto.UpdateBodyKeypointsResponse
+	(*ImportGolfKeypointsRequest)(nil),       // 21: sports_keypoints_proto.ImportGolfKeypointsRequest
+	(*ImportGolfKeypointsResponse)(nil),      // 22: sports_keypoints_proto.ImportGolfKeypointsResponse
+	(*DeleteGolfKeypointsRequest)(nil),       // 23: sports_keypoints_proto.DeleteGolfKeypointsRequest
+	(*DeleteGolfKeypointsResponse)(nil),      // 24: sports_keypoints_proto.DeleteGolfKeypointsResponse
+	(*UploadInputImageStreamRequest)(nil),    // 25: sports_keypoints_proto.UploadInputImageStreamRequest
+	(*CalibrateInputImageStreamRequest)(nil), // 26: sports_keypoints_proto.CalibrateInputImageStreamRequest
+	(*CalibrationImageChunk)(nil),            // 27: sports_keypoints_proto.CalibrationImageChunk
+	(*CalibrationImageChecksums)(nil),        // 28: sports_keypoints_proto.CalibrationImageChecksums
+	(*DownloadInputImageRequest)(nil),        // 29: sports_keypoints_proto.DownloadInputImageRequest
+	(*DownloadCalibrationImageRequest)(nil),  // 30: sports_keypoints_proto.DownloadCalibrationImageRequest
+	(*DownloadOutputImageRequest)(nil),       // 31: sports_keypoints_proto.DownloadOutputImageRequest
+	(*DownloadImageResponse)(nil),            // 32: sports_keypoints_proto.DownloadImageResponse
+	(*ImageInfo)(nil),                        // 33: sports_keypoints_proto.ImageInfo
+	(*SubmitAnalysisRequest)(nil),            // 34: sports_keypoints_proto.SubmitAnalysisRequest
+	(*SubmitAnalysisResponse)(nil),           // 35: sports_keypoints_proto.SubmitAnalysisResponse
+	(*GetAnalysisJobRequest)(nil),            // 36: sports_keypoints_proto.GetAnalysisJobRequest
+	(*GetAnalysisJobResponse)(nil),           // 37: sports_keypoints_proto.GetAnalysisJobResponse
+	(*WatchAnalysisJobRequest)(nil),          // 38: sports_keypoints_proto.WatchAnalysisJobRequest
+	(*AnalysisJob)(nil),                      // 39: sports_keypoints_proto.AnalysisJob
+	(*GolfKeypoints)(nil),                    // 40: sports_keypoints_proto.GolfKeypoints
+	(*DTLGolfSetupPoints)(nil),               // 41: sports_keypoints_proto.DTLGolfSetupPoints
+	(*FaceOnGolfSetupPoints)(nil),            // 42: sports_keypoints_proto.FaceOnGolfSetupPoints
+	(*timestamppb.Timestamp)(nil),            // 43: google.protobuf.Timestamp
+	(*Keypoint)(nil),                         // 44: sports_keypoints_proto.Keypoint
+	(*Double)(nil),                           // 45: sports_keypoints_proto.Double
+	(*Body25PoseKeypoints)(nil),              // 46: sports_keypoints_proto.Body25PoseKeypoints
+	(KeypointSchema)(0),                      // 47: sports_keypoints_proto.KeypointSchema
 }
 var file_golfkeypoints_proto_depIdxs = []int32{
-	0,  // 0: sports_keypoints_proto.UploadInputImageRequest.image_type:type_name -> sports_keypoints_proto.ImageType
-	24, // 1: sports_keypoints_proto.UploadInputImageRequest.timestamp:type_name -> google.protobuf.Timestamp
-	0,  // 2: sports_keypoints_proto.ReadInputImageResponse.image_type:type_name -> sports_keypoints_proto.ImageType
-	1,  // 3: sports_keypoints_proto.ReadInputImageResponse.calibration_type:type_name -> sports_keypoints_proto.CalibrationType
-	2,  // 4: sports_keypoints_proto.ReadInputImageResponse.feet_line_method:type_name -> sports_keypoints_proto.FeetLineMethod
-	24, // 5: sports_keypoints_proto.ReadInputImageResponse.timestamp:type_name -> google.protobuf.Timestamp
-	1,  // 6: sports_keypoints_proto.CalibrateInputImageRequest.calibration_type:type_name -> sports_keypoints_proto.CalibrationType
-	2,  // 7: sports_keypoints_proto.CalibrateInputImageRequest.feet_line_method:type_name -> sports_keypoints_proto.FeetLineMethod
-	25, // 8: sports_keypoints_proto.CalibrateInputImageRequest.golf_ball:type_name -> sports_keypoints_proto.Keypoint
-	25, // 9: sports_keypoints_proto.CalibrateInputImageRequest.club_butt:type_name -> sports_keypoints_proto.Keypoint
-	25, // 10: sports_keypoints_proto.CalibrateInputImageRequest.club_head:type_name -> sports_keypoints_proto.Keypoint
-	26, // 11: sports_keypoints_proto.CalibrateInputImageRequest.shoulder_tilt:type_name -> sports_keypoints_proto.Double
-	21, // 12: sports_keypoints_proto.CalculateGolfKeypointsResponse.golf_keypoints:type_name -> sports_keypoints_proto.GolfKeypoints
-	21, // 13: sports_keypoints_proto.ReadGolfKeypointsResponse.golf_keypoints:type_name -> sports_keypoints_proto.GolfKeypoints
-	27, // 14: sports_keypoints_proto.UpdateBodyKeypointsRequest.updated_body_keypoints:type_name -> sports_keypoints_proto.Body25PoseKeypoints
-	21, // 15: sports_keypoints_proto.UpdateBodyKeypointsResponse.updated_golf_keypoints:type_name -> sports_keypoints_proto.GolfKeypoints
-	22, // 16: sports_keypoints_proto.GolfKeypoints.dtl_golf_setup_points:type_name -> sports_keypoints_proto.DTLGolfSetupPoints
-	23, // 17: sports_keypoints_proto.GolfKeypoints.faceon_golf_setup_points:type_name -> sports_keypoints_proto.FaceOnGolfSetupPoints
-	27, // 18: sports_keypoints_proto.GolfKeypoints.body_keypoints:type_name -> sports_keypoints_proto.Body25PoseKeypoints
-	26, // 19: sports_keypoints_proto.DTLGolfSetupPoints.spine_angle:type_name -> sports_keypoints_proto.Double
-	26, // 20: sports_keypoints_proto.DTLGolfSetupPoints.feet_alignment:type_name -> sports_keypoints_proto.Double
-	26, // 21: sports_keypoints_proto.DTLGolfSetupPoints.heel_alignment:type_name -> sports_keypoints_proto.Double
-	26, // 22: sports_keypoints_proto.DTLGolfSetupPoints.toe_alignment:type_name -> sports_keypoints_proto.Double
-	26, // 23: sports_keypoints_proto.DTLGolfSetupPoints.shoulder_alignment:type_name -> sports_keypoints_proto.Double
-	26, // 24: sports_keypoints_proto.DTLGolfSetupPoints.waist_alignment:type_name -> sports_keypoints_proto.Double
-	26, // 25: sports_keypoints_proto.DTLGolfSetupPoints.knee_bend:type_name -> sports_keypoints_proto.Double
-	26, // 26: sports_keypoints_proto.DTLGolfSetupPoints.distance_from_ball:type_name -> sports_keypoints_proto.Double
-	26, // 27: sports_keypoints_proto.DTLGolfSetupPoints.ulnar_deviation:type_name -> sports_keypoints_proto.Double
-	26, // 28: sports_keypoints_proto.FaceOnGolfSetupPoints.side_bend:type_name -> sports_keypoints_proto.Double
-	26, // 29: sports_keypoints_proto.FaceOnGolfSetupPoints.l_foot_flare:type_name -> sports_keypoints_proto.Double
-	26, // 30: sports_keypoints_proto.FaceOnGolfSetupPoints.r_foot_flare:type_name -> sports_keypoints_proto.Double
-	26, // 31: sports_keypoints_proto.FaceOnGolfSetupPoints.stance_width:type_name -> sports_keypoints_proto.Double
-	26, // 32: sports_keypoints_proto.FaceOnGolfSetupPoints.shoulder_tilt:type_name -> sports_keypoints_proto.Double
-	26, // 33: sports_keypoints_proto.FaceOnGolfSetupPoints.waist_tilt:type_name -> sports_keypoints_proto.Double
-	26, // 34: sports_keypoints_proto.FaceOnGolfSetupPoints.shaft_lean:type_name -> sports_keypoints_proto.Double
-	26, // 35: sports_keypoints_proto.FaceOnGolfSetupPoints.ball_position:type_name -> sports_keypoints_proto.Double
-	26, // 36: sports_keypoints_proto.FaceOnGolfSetupPoints.head_position:type_name -> sports_keypoints_proto.Double
-	26, // 37: sports_keypoints_proto.FaceOnGolfSetupPoints.chest_position:type_name -> sports_keypoints_proto.Double
-	26, // 38: sports_keypoints_proto.FaceOnGolfSetupPoints.mid_hip_position:type_name -> sports_keypoints_proto.Double
-	3,  // 39: sports_keypoints_proto.GolfKeypointsService.UploadInputImage:input_type -> sports_keypoints_proto.UploadInputImageRequest
-	5,  // 40: sports_keypoints_proto.GolfKeypointsService.ListInputImagesForUser:input_type -> sports_keypoints_proto.ListInputImagesForUserRequest
-	7,  // 41: sports_keypoints_proto.GolfKeypointsService.ReadInputImage:input_type -> sports_keypoints_proto.ReadInputImageRequest
-	9,  // 42: sports_keypoints_proto.GolfKeypointsService.DeleteInputImage:input_type -> sports_keypoints_proto.DeleteInputImageRequest
-	11, // 43: sports_keypoints_proto.GolfKeypointsService.CalibrateInputImage:input_type -> sports_keypoints_proto.CalibrateInputImageRequest
-	13, // 44: sports_keypoints_proto.GolfKeypointsService.CalculateGolfKeypoints:input_type -> sports_keypoints_proto.CalculateGolfKeypointsRequest
-	15, // 45: sports_keypoints_proto.GolfKeypointsService.ReadGolfKeypoints:input_type -> sports_keypoints_proto.ReadGolfKeypointsRequest
-	17, // 46: sports_keypoints_proto.GolfKeypointsService.UpdateBodyKeypoints:input_type -> sports_keypoints_proto.UpdateBodyKeypointsRequest
-	19, // 47: sports_keypoints_proto.GolfKeypointsService.DeleteGolfKeypoints:input_type -> sports_keypoints_proto.DeleteGolfKeypointsRequest
-	4,  // 48: sports_keypoints_proto.GolfKeypointsService.UploadInputImage:output_type -> sports_keypoints_proto.UploadInputImageResponse
-	6,  // 49: sports_keypoints_proto.GolfKeypointsService.ListInputImagesForUser:output_type -> sports_keypoints_proto.ListInputImagesForUserResponse
-	8,  // 50: sports_keypoints_proto.GolfKeypointsService.ReadInputImage:output_type -> sports_keypoints_proto.ReadInputImageResponse
-	10, // 51: sports_keypoints_proto.GolfKeypointsService.DeleteInputImage:output_type -> sports_keypoints_proto.DeleteInputImageResponse
-	12, // 52: sports_keypoints_proto.GolfKeypointsService.CalibrateInputImage:output_type -> sports_keypoints_proto.CalibrateInputImageResponse
-	14, // 53: sports_keypoints_proto.GolfKeypointsService.CalculateGolfKeypoints:output_type -> sports_keypoints_proto.CalculateGolfKeypointsResponse
-	16, // 54: sports_keypoints_proto.GolfKeypointsService.ReadGolfKeypoints:output_type -> sports_keypoints_proto.ReadGolfKeypointsResponse
-	18, // 55: sports_keypoints_proto.GolfKeypointsService.UpdateBodyKeypoints:output_type -> sports_keypoints_proto.UpdateBodyKeypointsResponse
-	20, // 56: sports_keypoints_proto.GolfKeypointsService.DeleteGolfKeypoints:output_type -> sports_keypoints_proto.DeleteGolfKeypointsResponse
-	48, // [48:57] is the sub-list for method output_type
-	39, // [39:48] is the sub-list for method input_type
-	39, // [39:39] is the sub-list for extension type_name
-	39, // [39:39] is the sub-list for extension extendee
-	0,  // [0:39] is the sub-list for field type_name
+	1,  // 0: sports_keypoints_proto.UploadInputImageRequest.image_type:type_name -> sports_keypoints_proto.ImageType
+	43, // 1: sports_keypoints_proto.UploadInputImageRequest.timestamp:type_name -> google.protobuf.Timestamp
+	1,  // 2: sports_keypoints_proto.ReadInputImageResponse.image_type:type_name -> sports_keypoints_proto.ImageType
+	2,  // 3: sports_keypoints_proto.ReadInputImageResponse.calibration_type:type_name -> sports_keypoints_proto.CalibrationType
+	4,  // 4: sports_keypoints_proto.ReadInputImageResponse.feet_line_method:type_name -> sports_keypoints_proto.FeetLineMethod
+	43, // 5: sports_keypoints_proto.ReadInputImageResponse.timestamp:type_name -> google.protobuf.Timestamp
+	2,  // 6: sports_keypoints_proto.CalibrateInputImageRequest.calibration_type:type_name -> sports_keypoints_proto.CalibrationType
+	4,  // 7: sports_keypoints_proto.CalibrateInputImageRequest.feet_line_method:type_name -> sports_keypoints_proto.FeetLineMethod
+	44, // 8: sports_keypoints_proto.CalibrateInputImageRequest.golf_ball:type_name -> sports_keypoints_proto.Keypoint
+	44, // 9: sports_keypoints_proto.CalibrateInputImageRequest.club_butt:type_name -> sports_keypoints_proto.Keypoint
+	44, // 10: sports_keypoints_proto.CalibrateInputImageRequest.club_head:type_name -> sports_keypoints_proto.Keypoint
+	45, // 11: sports_keypoints_proto.CalibrateInputImageRequest.shoulder_tilt:type_name -> sports_keypoints_proto.Double
+	40, // 12: sports_keypoints_proto.CalculateGolfKeypointsResponse.golf_keypoints:type_name -> sports_keypoints_proto.GolfKeypoints
+	40, // 13: sports_keypoints_proto.ReadGolfKeypointsResponse.golf_keypoints:type_name -> sports_keypoints_proto.GolfKeypoints
+	46, // 14: sports_keypoints_proto.UpdateBodyKeypointsRequest.updated_body_keypoints:type_name -> sports_keypoints_proto.Body25PoseKeypoints
+	40, // 15: sports_keypoints_proto.UpdateBodyKeypointsResponse.updated_golf_keypoints:type_name -> sports_keypoints_proto.GolfKeypoints
+	46, // 16: sports_keypoints_proto.ImportGolfKeypointsRequest.body_keypoints:type_name -> sports_keypoints_proto.Body25PoseKeypoints
+	40, // 17: sports_keypoints_proto.ImportGolfKeypointsResponse.golf_keypoints:type_name -> sports_keypoints_proto.GolfKeypoints
+	5,  // 18: sports_keypoints_proto.UploadInputImageStreamRequest.metadata:type_name -> sports_keypoints_proto.UploadInputImageRequest
+	13, // 19: sports_keypoints_proto.CalibrateInputImageStreamRequest.metadata:type_name -> sports_keypoints_proto.CalibrateInputImageRequest
+	27, // 20: sports_keypoints_proto.CalibrateInputImageStreamRequest.chunk:type_name -> sports_keypoints_proto.CalibrationImageChunk
+	28, // 21: sports_keypoints_proto.CalibrateInputImageStreamRequest.checksums:type_name -> sports_keypoints_proto.CalibrationImageChecksums
+	3,  // 22: sports_keypoints_proto.CalibrationImageChunk.calibration_image:type_name -> sports_keypoints_proto.CalibrationImage
+	3,  // 23: sports_keypoints_proto.DownloadCalibrationImageRequest.calibration_image:type_name -> sports_keypoints_proto.CalibrationImage
+	33, // 24: sports_keypoints_proto.DownloadImageResponse.info:type_name -> sports_keypoints_proto.ImageInfo
+	39, // 25: sports_keypoints_proto.GetAnalysisJobResponse.job:type_name -> sports_keypoints_proto.AnalysisJob
+	0,  // 26: sports_keypoints_proto.AnalysisJob.state:type_name -> sports_keypoints_proto.AnalysisJobState
+	40, // 27: sports_keypoints_proto.AnalysisJob.golf_keypoints:type_name -> sports_keypoints_proto.GolfKeypoints
+	43, // 28: sports_keypoints_proto.AnalysisJob.created_at:type_name -> google.protobuf.Timestamp
+	43, // 29: sports_keypoints_proto.AnalysisJob.updated_at:type_name -> google.protobuf.Timestamp
+	41, // 30: sports_keypoints_proto.GolfKeypoints.dtl_golf_setup_points:type_name -> sports_keypoints_proto.DTLGolfSetupPoints
+	42, // 31: sports_keypoints_proto.GolfKeypoints.faceon_golf_setup_points:type_name -> sports_keypoints_proto.FaceOnGolfSetupPoints
+	46, // 32: sports_keypoints_proto.GolfKeypoints.body_keypoints:type_name -> sports_keypoints_proto.Body25PoseKeypoints
+	47, // 33: sports_keypoints_proto.GolfKeypoints.keypoint_schema:type_name -> sports_keypoints_proto.KeypointSchema
+	45, // 34: sports_keypoints_proto.DTLGolfSetupPoints.spine_angle:type_name -> sports_keypoints_proto.Double
+	45, // 35: sports_keypoints_proto.DTLGolfSetupPoints.feet_alignment:type_name -> sports_keypoints_proto.Double
+	45, // 36: sports_keypoints_proto.DTLGolfSetupPoints.heel_alignment:type_name -> sports_keypoints_proto.Double
+	45, // 37: sports_keypoints_proto.DTLGolfSetupPoints.toe_alignment:type_name -> sports_keypoints_proto.Double
+	45, // 38: sports_keypoints_proto.DTLGolfSetupPoints.shoulder_alignment:type_name -> sports_keypoints_proto.Double
+	45, // 39: sports_keypoints_proto.DTLGolfSetupPoints.waist_alignment:type_name -> sports_keypoints_proto.Double
+	45, // 40: sports_keypoints_proto.DTLGolfSetupPoints.knee_bend:type_name -> sports_keypoints_proto.Double
+	45, // 41: sports_keypoints_proto.DTLGolfSetupPoints.distance_from_ball:type_name -> sports_keypoints_proto.Double
+	45, // 42: sports_keypoints_proto.DTLGolfSetupPoints.ulnar_deviation:type_name -> sports_keypoints_proto.Double
+	45, // 43: sports_keypoints_proto.FaceOnGolfSetupPoints.side_bend:type_name -> sports_keypoints_proto.Double
+	45, // 44: sports_keypoints_proto.FaceOnGolfSetupPoints.l_foot_flare:type_name -> sports_keypoints_proto.Double
+	45, // 45: sports_keypoints_proto.FaceOnGolfSetupPoints.r_foot_flare:type_name -> sports_keypoints_proto.Double
+	45, // 46: sports_keypoints_proto.FaceOnGolfSetupPoints.stance_width:type_name -> sports_keypoints_proto.Double
+	45, // 47: sports_keypoints_proto.FaceOnGolfSetupPoints.shoulder_tilt:type_name -> sports_keypoints_proto.Double
+	45, // 48: sports_keypoints_proto.FaceOnGolfSetupPoints.waist_tilt:type_name -> sports_keypoints_proto.Double
+	45, // 49: sports_keypoints_proto.FaceOnGolfSetupPoints.shaft_lean:type_name -> sports_keypoints_proto.Double
+	45, // 50: sports_keypoints_proto.FaceOnGolfSetupPoints.ball_position:type_name -> sports_keypoints_proto.Double
+	45, // 51: sports_keypoints_proto.FaceOnGolfSetupPoints.head_position:type_name -> sports_keypoints_proto.Double
+	45, // 52: sports_keypoints_proto.FaceOnGolfSetupPoints.chest_position:type_name -> sports_keypoints_proto.Double
+	45, // 53: sports_keypoints_proto.FaceOnGolfSetupPoints.mid_hip_position:type_name -> sports_keypoints_proto.Double
+	5,  // 54: sports_keypoints_proto.GolfKeypointsService.UploadInputImage:input_type -> sports_keypoints_proto.UploadInputImageRequest
+	7,  // 55: sports_keypoints_proto.GolfKeypointsService.ListInputImagesForUser:input_type -> sports_keypoints_proto.ListInputImagesForUserRequest
+	9,  // 56: sports_keypoints_proto.GolfKeypointsService.ReadInputImage:input_type -> sports_keypoints_proto.ReadInputImageRequest
+	11, // 57: sports_keypoints_proto.GolfKeypointsService.DeleteInputImage:input_type -> sports_keypoints_proto.DeleteInputImageRequest
+	13, // 58: sports_keypoints_proto.GolfKeypointsService.CalibrateInputImage:input_type -> sports_keypoints_proto.CalibrateInputImageRequest
+	15, // 59: sports_keypoints_proto.GolfKeypointsService.CalculateGolfKeypoints:input_type -> sports_keypoints_proto.CalculateGolfKeypointsRequest
+	17, // 60: sports_keypoints_proto.GolfKeypointsService.ReadGolfKeypoints:input_type -> sports_keypoints_proto.ReadGolfKeypointsRequest
+	19, // 61: sports_keypoints_proto.GolfKeypointsService.UpdateBodyKeypoints:input_type -> sports_keypoints_proto.UpdateBodyKeypointsRequest
+	23, // 62: sports_keypoints_proto.GolfKeypointsService.DeleteGolfKeypoints:input_type -> sports_keypoints_proto.DeleteGolfKeypointsRequest
+	21, // 63: sports_keypoints_proto.GolfKeypointsService.ImportGolfKeypoints:input_type -> sports_keypoints_proto.ImportGolfKeypointsRequest
+	25, // 64: sports_keypoints_proto.GolfKeypointsService.UploadInputImageStream:input_type -> sports_keypoints_proto.UploadInputImageStreamRequest
+	26, // 65: sports_keypoints_proto.GolfKeypointsService.CalibrateInputImageStream:input_type -> sports_keypoints_proto.CalibrateInputImageStreamRequest
+	29, // 66: sports_keypoints_proto.GolfKeypointsService.DownloadInputImage:input_type -> sports_keypoints_proto.DownloadInputImageRequest
+	30, // 67: sports_keypoints_proto.GolfKeypointsService.DownloadCalibrationImage:input_type -> sports_keypoints_proto.DownloadCalibrationImageRequest
+	31, // 68: sports_keypoints_proto.GolfKeypointsService.DownloadOutputImage:input_type -> sports_keypoints_proto.DownloadOutputImageRequest
+	34, // 69: sports_keypoints_proto.GolfKeypointsService.SubmitAnalysis:input_type -> sports_keypoints_proto.SubmitAnalysisRequest
+	36, // 70: sports_keypoints_proto.GolfKeypointsService.GetAnalysisJob:input_type -> sports_keypoints_proto.GetAnalysisJobRequest
+	38, // 71: sports_keypoints_proto.GolfKeypointsService.WatchAnalysisJob:input_type -> sports_keypoints_proto.WatchAnalysisJobRequest
+	6,  // 72: sports_keypoints_proto.GolfKeypointsService.UploadInputImage:output_type -> sports_keypoints_proto.UploadInputImageResponse
+	8,  // 73: sports_keypoints_proto.GolfKeypointsService.ListInputImagesForUser:output_type -> sports_keypoints_proto.ListInputImagesForUserResponse
+	10, // 74: sports_keypoints_proto.GolfKeypointsService.ReadInputImage:output_type -> sports_keypoints_proto.ReadInputImageResponse
+	12, // 75: sports_keypoints_proto.GolfKeypointsService.DeleteInputImage:output_type -> sports_keypoints_proto.DeleteInputImageResponse
+	14, // 76: sports_keypoints_proto.GolfKeypointsService.CalibrateInputImage:output_type -> sports_keypoints_proto.CalibrateInputImageResponse
+	16, // 77: sports_keypoints_proto.GolfKeypointsService.CalculateGolfKeypoints:output_type -> sports_keypoints_proto.CalculateGolfKeypointsResponse
+	18, // 78: sports_keypoints_proto.GolfKeypointsService.ReadGolfKeypoints:output_type -> sports_keypoints_proto.ReadGolfKeypointsResponse
+	20, // 79: sports_keypoints_proto.GolfKeypointsService.UpdateBodyKeypoints:output_type -> sports_keypoints_proto.UpdateBodyKeypointsResponse
+	24, // 80: sports_keypoints_proto.GolfKeypointsService.DeleteGolfKeypoints:output_type -> sports_keypoints_proto.DeleteGolfKeypointsResponse
+	22, // 81: sports_keypoints_proto.GolfKeypointsService.ImportGolfKeypoints:output_type -> sports_keypoints_proto.ImportGolfKeypointsResponse
+	6,  // 82: sports_keypoints_proto.GolfKeypointsService.UploadInputImageStream:output_type -> sports_keypoints_proto.UploadInputImageResponse
+	14, // 83: sports_keypoints_proto.GolfKeypointsService.CalibrateInputImageStream:output_type -> sports_keypoints_proto.CalibrateInputImageResponse
+	32, // 84: sports_keypoints_proto.GolfKeypointsService.DownloadInputImage:output_type -> sports_keypoints_proto.DownloadImageResponse
+	32, // 85: sports_keypoints_proto.GolfKeypointsService.DownloadCalibrationImage:output_type -> sports_keypoints_proto.DownloadImageResponse
+	32, // 86: sports_keypoints_proto.GolfKeypointsService.DownloadOutputImage:output_type -> sports_keypoints_proto.DownloadImageResponse
+	35, // 87: sports_keypoints_proto.GolfKeypointsService.SubmitAnalysis:output_type -> sports_keypoints_proto.SubmitAnalysisResponse
+	37, // 88: sports_keypoints_proto.GolfKeypointsService.GetAnalysisJob:output_type -> sports_keypoints_proto.GetAnalysisJobResponse
+	39, // 89: sports_keypoints_proto.GolfKeypointsService.WatchAnalysisJob:output_type -> sports_keypoints_proto.AnalysisJob
+	72, // [72:90] is the sub-list for method output_type
+	54, // [54:72] is the sub-list for method input_type
+	54, // [54:54] is the sub-list for extension type_name
+	54, // [54:54] is the sub-list for extension extendee
+	0,  // [0:54] is the sub-list for field type_name
 }
 
 func init() { file_golfkeypoints_proto_init() }
@@ -2123,6 +3714,7 @@ func file_golfkeypoints_proto_init() {
 		return
 	}
 	file_common_proto_init()
+	file_validate_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_golfkeypoints_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UploadInputImageRequest); i {
@@ -2317,7 +3909,7 @@ func file_golfkeypoints_proto_init() {
 			}
 		}
 		file_golfkeypoints_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteGolfKeypointsRequest); i {
+			switch v := v.(*ImportGolfKeypointsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2329,7 +3921,7 @@ func file_golfkeypoints_proto_init() {
 			}
 		}
 		file_golfkeypoints_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteGolfKeypointsResponse); i {
+			switch v := v.(*ImportGolfKeypointsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2341,7 +3933,7 @@ func file_golfkeypoints_proto_init() {
 			}
 		}
 		file_golfkeypoints_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GolfKeypoints); i {
+			switch v := v.(*DeleteGolfKeypointsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2353,7 +3945,7 @@ func file_golfkeypoints_proto_init() {
 			}
 		}
 		file_golfkeypoints_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DTLGolfSetupPoints); i {
+			switch v := v.(*DeleteGolfKeypointsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2365,6 +3957,210 @@ func file_golfkeypoints_proto_init() {
 			}
 		}
 		file_golfkeypoints_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadInputImageStreamRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_golfkeypoints_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CalibrateInputImageStreamRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_golfkeypoints_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CalibrationImageChunk); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_golfkeypoints_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CalibrationImageChecksums); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_golfkeypoints_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DownloadInputImageRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_golfkeypoints_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DownloadCalibrationImageRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_golfkeypoints_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DownloadOutputImageRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_golfkeypoints_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DownloadImageResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_golfkeypoints_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImageInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_golfkeypoints_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmitAnalysisRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_golfkeypoints_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmitAnalysisResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_golfkeypoints_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAnalysisJobRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_golfkeypoints_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAnalysisJobResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_golfkeypoints_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchAnalysisJobRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_golfkeypoints_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AnalysisJob); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_golfkeypoints_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GolfKeypoints); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_golfkeypoints_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DTLGolfSetupPoints); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_golfkeypoints_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FaceOnGolfSetupPoints); i {
 			case 0:
 				return &v.state
@@ -2377,13 +4173,31 @@ func file_golfkeypoints_proto_init() {
 			}
 		}
 	}
+	file_golfkeypoints_proto_msgTypes[16].OneofWrappers = []interface{}{
+		(*ImportGolfKeypointsRequest_OpenposeJson)(nil),
+		(*ImportGolfKeypointsRequest_BodyKeypoints)(nil),
+	}
+	file_golfkeypoints_proto_msgTypes[20].OneofWrappers = []interface{}{
+		(*UploadInputImageStreamRequest_Metadata)(nil),
+		(*UploadInputImageStreamRequest_Chunk)(nil),
+		(*UploadInputImageStreamRequest_Sha256)(nil),
+	}
+	file_golfkeypoints_proto_msgTypes[21].OneofWrappers = []interface{}{
+		(*CalibrateInputImageStreamRequest_Metadata)(nil),
+		(*CalibrateInputImageStreamRequest_Chunk)(nil),
+		(*CalibrateInputImageStreamRequest_Checksums)(nil),
+	}
+	file_golfkeypoints_proto_msgTypes[27].OneofWrappers = []interface{}{
+		(*DownloadImageResponse_Info)(nil),
+		(*DownloadImageResponse_Chunk)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_golfkeypoints_proto_rawDesc,
-			NumEnums:      3,
-			NumMessages:   21,
+			NumEnums:      5,
+			NumMessages:   38,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -1,4 +1,8 @@
 // Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.13.0
+// source: golfkeypoints.proto
 
 package sports_keypoints_proto
 
@@ -29,6 +33,23 @@ type GolfKeypointsServiceClient interface {
 	// if estimated body keypoints are off or have low confidence, client can manually input where body parts are
 	UpdateBodyKeypoints(ctx context.Context, in *UpdateBodyKeypointsRequest, opts ...grpc.CallOption) (*UpdateBodyKeypointsResponse, error)
 	DeleteGolfKeypoints(ctx context.Context, in *DeleteGolfKeypointsRequest, opts ...grpc.CallOption) (*DeleteGolfKeypointsResponse, error)
+	// stores body keypoints detected outside of the server (eg. by openpose) as the keypoints of the input image and
+	// calculates the golf setup points from them like CalculateGolfKeypoints, without running computervision
+	ImportGolfKeypoints(ctx context.Context, in *ImportGolfKeypointsRequest, opts ...grpc.CallOption) (*ImportGolfKeypointsResponse, error)
+	// streaming versions of UploadInputImage and CalibrateInputImage for images larger than the max message size,
+	// the first message has the request without images, then the images are sent in chunks and their checksums last
+	UploadInputImageStream(ctx context.Context, opts ...grpc.CallOption) (GolfKeypointsService_UploadInputImageStreamClient, error)
+	CalibrateInputImageStream(ctx context.Context, opts ...grpc.CallOption) (GolfKeypointsService_CalibrateInputImageStreamClient, error)
+	// the first message has the size and checksum of the image, then the image is sent in chunks
+	DownloadInputImage(ctx context.Context, in *DownloadInputImageRequest, opts ...grpc.CallOption) (GolfKeypointsService_DownloadInputImageClient, error)
+	DownloadCalibrationImage(ctx context.Context, in *DownloadCalibrationImageRequest, opts ...grpc.CallOption) (GolfKeypointsService_DownloadCalibrationImageClient, error)
+	DownloadOutputImage(ctx context.Context, in *DownloadOutputImageRequest, opts ...grpc.CallOption) (GolfKeypointsService_DownloadOutputImageClient, error)
+	// queues CalculateGolfKeypoints and returns the job id right away, the job is run by the server's workers and
+	// survives restarts. The output image is read with ReadGolfKeypoints or DownloadOutputImage once the job is done.
+	SubmitAnalysis(ctx context.Context, in *SubmitAnalysisRequest, opts ...grpc.CallOption) (*SubmitAnalysisResponse, error)
+	GetAnalysisJob(ctx context.Context, in *GetAnalysisJobRequest, opts ...grpc.CallOption) (*GetAnalysisJobResponse, error)
+	// sends the job once and then every time its state changes, the stream ends when the job is done or failed
+	WatchAnalysisJob(ctx context.Context, in *WatchAnalysisJobRequest, opts ...grpc.CallOption) (GolfKeypointsService_WatchAnalysisJobClient, error)
 }
 
 type golfKeypointsServiceClient struct {
@@ -120,6 +141,229 @@ func (c *golfKeypointsServiceClient) DeleteGolfKeypoints(ctx context.Context, in
 	return out, nil
 }
 
+func (c *golfKeypointsServiceClient) ImportGolfKeypoints(ctx context.Context, in *ImportGolfKeypointsRequest, opts ...grpc.CallOption) (*ImportGolfKeypointsResponse, error) {
+	out := new(ImportGolfKeypointsResponse)
+	err := c.cc.Invoke(ctx, "/sports_keypoints_proto.GolfKeypointsService/ImportGolfKeypoints", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *golfKeypointsServiceClient) UploadInputImageStream(ctx context.Context, opts ...grpc.CallOption) (GolfKeypointsService_UploadInputImageStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GolfKeypointsService_ServiceDesc.Streams[0], "/sports_keypoints_proto.GolfKeypointsService/UploadInputImageStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &golfKeypointsServiceUploadInputImageStreamClient{stream}
+	return x, nil
+}
+
+type GolfKeypointsService_UploadInputImageStreamClient interface {
+	Send(*UploadInputImageStreamRequest) error
+	CloseAndRecv() (*UploadInputImageResponse, error)
+	grpc.ClientStream
+}
+
+type golfKeypointsServiceUploadInputImageStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *golfKeypointsServiceUploadInputImageStreamClient) Send(m *UploadInputImageStreamRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *golfKeypointsServiceUploadInputImageStreamClient) CloseAndRecv() (*UploadInputImageResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(UploadInputImageResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *golfKeypointsServiceClient) CalibrateInputImageStream(ctx context.Context, opts ...grpc.CallOption) (GolfKeypointsService_CalibrateInputImageStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GolfKeypointsService_ServiceDesc.Streams[1], "/sports_keypoints_proto.GolfKeypointsService/CalibrateInputImageStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &golfKeypointsServiceCalibrateInputImageStreamClient{stream}
+	return x, nil
+}
+
+type GolfKeypointsService_CalibrateInputImageStreamClient interface {
+	Send(*CalibrateInputImageStreamRequest) error
+	CloseAndRecv() (*CalibrateInputImageResponse, error)
+	grpc.ClientStream
+}
+
+type golfKeypointsServiceCalibrateInputImageStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *golfKeypointsServiceCalibrateInputImageStreamClient) Send(m *CalibrateInputImageStreamRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *golfKeypointsServiceCalibrateInputImageStreamClient) CloseAndRecv() (*CalibrateInputImageResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(CalibrateInputImageResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *golfKeypointsServiceClient) DownloadInputImage(ctx context.Context, in *DownloadInputImageRequest, opts ...grpc.CallOption) (GolfKeypointsService_DownloadInputImageClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GolfKeypointsService_ServiceDesc.Streams[2], "/sports_keypoints_proto.GolfKeypointsService/DownloadInputImage", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &golfKeypointsServiceDownloadInputImageClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GolfKeypointsService_DownloadInputImageClient interface {
+	Recv() (*DownloadImageResponse, error)
+	grpc.ClientStream
+}
+
+type golfKeypointsServiceDownloadInputImageClient struct {
+	grpc.ClientStream
+}
+
+func (x *golfKeypointsServiceDownloadInputImageClient) Recv() (*DownloadImageResponse, error) {
+	m := new(DownloadImageResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *golfKeypointsServiceClient) DownloadCalibrationImage(ctx context.Context, in *DownloadCalibrationImageRequest, opts ...grpc.CallOption) (GolfKeypointsService_DownloadCalibrationImageClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GolfKeypointsService_ServiceDesc.Streams[3], "/sports_keypoints_proto.GolfKeypointsService/DownloadCalibrationImage", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &golfKeypointsServiceDownloadCalibrationImageClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GolfKeypointsService_DownloadCalibrationImageClient interface {
+	Recv() (*DownloadImageResponse, error)
+	grpc.ClientStream
+}
+
+type golfKeypointsServiceDownloadCalibrationImageClient struct {
+	grpc.ClientStream
+}
+
+func (x *golfKeypointsServiceDownloadCalibrationImageClient) Recv() (*DownloadImageResponse, error) {
+	m := new(DownloadImageResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *golfKeypointsServiceClient) DownloadOutputImage(ctx context.Context, in *DownloadOutputImageRequest, opts ...grpc.CallOption) (GolfKeypointsService_DownloadOutputImageClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GolfKeypointsService_ServiceDesc.Streams[4], "/sports_keypoints_proto.GolfKeypointsService/DownloadOutputImage", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &golfKeypointsServiceDownloadOutputImageClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GolfKeypointsService_DownloadOutputImageClient interface {
+	Recv() (*DownloadImageResponse, error)
+	grpc.ClientStream
+}
+
+type golfKeypointsServiceDownloadOutputImageClient struct {
+	grpc.ClientStream
+}
+
+func (x *golfKeypointsServiceDownloadOutputImageClient) Recv() (*DownloadImageResponse, error) {
+	m := new(DownloadImageResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *golfKeypointsServiceClient) SubmitAnalysis(ctx context.Context, in *SubmitAnalysisRequest, opts ...grpc.CallOption) (*SubmitAnalysisResponse, error) {
+	out := new(SubmitAnalysisResponse)
+	err := c.cc.Invoke(ctx, "/sports_keypoints_proto.GolfKeypointsService/SubmitAnalysis", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *golfKeypointsServiceClient) GetAnalysisJob(ctx context.Context, in *GetAnalysisJobRequest, opts ...grpc.CallOption) (*GetAnalysisJobResponse, error) {
+	out := new(GetAnalysisJobResponse)
+	err := c.cc.Invoke(ctx, "/sports_keypoints_proto.GolfKeypointsService/GetAnalysisJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *golfKeypointsServiceClient) WatchAnalysisJob(ctx context.Context, in *WatchAnalysisJobRequest, opts ...grpc.CallOption) (GolfKeypointsService_WatchAnalysisJobClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GolfKeypointsService_ServiceDesc.Streams[5], "/sports_keypoints_proto.GolfKeypointsService/WatchAnalysisJob", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &golfKeypointsServiceWatchAnalysisJobClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GolfKeypointsService_WatchAnalysisJobClient interface {
+	Recv() (*AnalysisJob, error)
+	grpc.ClientStream
+}
+
+type golfKeypointsServiceWatchAnalysisJobClient struct {
+	grpc.ClientStream
+}
+
+func (x *golfKeypointsServiceWatchAnalysisJobClient) Recv() (*AnalysisJob, error) {
+	m := new(AnalysisJob)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // GolfKeypointsServiceServer is the server API for GolfKeypointsService service.
 // All implementations must embed UnimplementedGolfKeypointsServiceServer
 // for forward compatibility
@@ -135,6 +379,23 @@ type GolfKeypointsServiceServer interface {
 	// if estimated body keypoints are off or have low confidence, client can manually input where body parts are
 	UpdateBodyKeypoints(context.Context, *UpdateBodyKeypointsRequest) (*UpdateBodyKeypointsResponse, error)
 	DeleteGolfKeypoints(context.Context, *DeleteGolfKeypointsRequest) (*DeleteGolfKeypointsResponse, error)
+	// stores body keypoints detected outside of the server (eg. by openpose) as the keypoints of the input image and
+	// calculates the golf setup points from them like CalculateGolfKeypoints, without running computervision
+	ImportGolfKeypoints(context.Context, *ImportGolfKeypointsRequest) (*ImportGolfKeypointsResponse, error)
+	// streaming versions of UploadInputImage and CalibrateInputImage for images larger than the max message size,
+	// the first message has the request without images, then the images are sent in chunks and their checksums last
+	UploadInputImageStream(GolfKeypointsService_UploadInputImageStreamServer) error
+	CalibrateInputImageStream(GolfKeypointsService_CalibrateInputImageStreamServer) error
+	// the first message has the size and checksum of the image, then the image is sent in chunks
+	DownloadInputImage(*DownloadInputImageRequest, GolfKeypointsService_DownloadInputImageServer) error
+	DownloadCalibrationImage(*DownloadCalibrationImageRequest, GolfKeypointsService_DownloadCalibrationImageServer) error
+	DownloadOutputImage(*DownloadOutputImageRequest, GolfKeypointsService_DownloadOutputImageServer) error
+	// queues CalculateGolfKeypoints and returns the job id right away, the job is run by the server's workers and
+	// survives restarts. The output image is read with ReadGolfKeypoints or DownloadOutputImage once the job is done.
+	SubmitAnalysis(context.Context, *SubmitAnalysisRequest) (*SubmitAnalysisResponse, error)
+	GetAnalysisJob(context.Context, *GetAnalysisJobRequest) (*GetAnalysisJobResponse, error)
+	// sends the job once and then every time its state changes, the stream ends when the job is done or failed
+	WatchAnalysisJob(*WatchAnalysisJobRequest, GolfKeypointsService_WatchAnalysisJobServer) error
 	mustEmbedUnimplementedGolfKeypointsServiceServer()
 }
 
@@ -169,6 +430,33 @@ func (UnimplementedGolfKeypointsServiceServer) UpdateBodyKeypoints(context.Conte
 func (UnimplementedGolfKeypointsServiceServer) DeleteGolfKeypoints(context.Context, *DeleteGolfKeypointsRequest) (*DeleteGolfKeypointsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteGolfKeypoints not implemented")
 }
+func (UnimplementedGolfKeypointsServiceServer) ImportGolfKeypoints(context.Context, *ImportGolfKeypointsRequest) (*ImportGolfKeypointsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportGolfKeypoints not implemented")
+}
+func (UnimplementedGolfKeypointsServiceServer) UploadInputImageStream(GolfKeypointsService_UploadInputImageStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method UploadInputImageStream not implemented")
+}
+func (UnimplementedGolfKeypointsServiceServer) CalibrateInputImageStream(GolfKeypointsService_CalibrateInputImageStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method CalibrateInputImageStream not implemented")
+}
+func (UnimplementedGolfKeypointsServiceServer) DownloadInputImage(*DownloadInputImageRequest, GolfKeypointsService_DownloadInputImageServer) error {
+	return status.Errorf(codes.Unimplemented, "method DownloadInputImage not implemented")
+}
+func (UnimplementedGolfKeypointsServiceServer) DownloadCalibrationImage(*DownloadCalibrationImageRequest, GolfKeypointsService_DownloadCalibrationImageServer) error {
+	return status.Errorf(codes.Unimplemented, "method DownloadCalibrationImage not implemented")
+}
+func (UnimplementedGolfKeypointsServiceServer) DownloadOutputImage(*DownloadOutputImageRequest, GolfKeypointsService_DownloadOutputImageServer) error {
+	return status.Errorf(codes.Unimplemented, "method DownloadOutputImage not implemented")
+}
+func (UnimplementedGolfKeypointsServiceServer) SubmitAnalysis(context.Context, *SubmitAnalysisRequest) (*SubmitAnalysisResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitAnalysis not implemented")
+}
+func (UnimplementedGolfKeypointsServiceServer) GetAnalysisJob(context.Context, *GetAnalysisJobRequest) (*GetAnalysisJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAnalysisJob not implemented")
+}
+func (UnimplementedGolfKeypointsServiceServer) WatchAnalysisJob(*WatchAnalysisJobRequest, GolfKeypointsService_WatchAnalysisJobServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchAnalysisJob not implemented")
+}
 func (UnimplementedGolfKeypointsServiceServer) mustEmbedUnimplementedGolfKeypointsServiceServer() {}
 
 // UnsafeGolfKeypointsServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -344,6 +632,196 @@ func _GolfKeypointsService_DeleteGolfKeypoints_Handler(srv interface{}, ctx cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GolfKeypointsService_ImportGolfKeypoints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportGolfKeypointsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GolfKeypointsServiceServer).ImportGolfKeypoints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sports_keypoints_proto.GolfKeypointsService/ImportGolfKeypoints",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GolfKeypointsServiceServer).ImportGolfKeypoints(ctx, req.(*ImportGolfKeypointsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GolfKeypointsService_UploadInputImageStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(GolfKeypointsServiceServer).UploadInputImageStream(&golfKeypointsServiceUploadInputImageStreamServer{stream})
+}
+
+type GolfKeypointsService_UploadInputImageStreamServer interface {
+	SendAndClose(*UploadInputImageResponse) error
+	Recv() (*UploadInputImageStreamRequest, error)
+	grpc.ServerStream
+}
+
+type golfKeypointsServiceUploadInputImageStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *golfKeypointsServiceUploadInputImageStreamServer) SendAndClose(m *UploadInputImageResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *golfKeypointsServiceUploadInputImageStreamServer) Recv() (*UploadInputImageStreamRequest, error) {
+	m := new(UploadInputImageStreamRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _GolfKeypointsService_CalibrateInputImageStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(GolfKeypointsServiceServer).CalibrateInputImageStream(&golfKeypointsServiceCalibrateInputImageStreamServer{stream})
+}
+
+type GolfKeypointsService_CalibrateInputImageStreamServer interface {
+	SendAndClose(*CalibrateInputImageResponse) error
+	Recv() (*CalibrateInputImageStreamRequest, error)
+	grpc.ServerStream
+}
+
+type golfKeypointsServiceCalibrateInputImageStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *golfKeypointsServiceCalibrateInputImageStreamServer) SendAndClose(m *CalibrateInputImageResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *golfKeypointsServiceCalibrateInputImageStreamServer) Recv() (*CalibrateInputImageStreamRequest, error) {
+	m := new(CalibrateInputImageStreamRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _GolfKeypointsService_DownloadInputImage_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DownloadInputImageRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GolfKeypointsServiceServer).DownloadInputImage(m, &golfKeypointsServiceDownloadInputImageServer{stream})
+}
+
+type GolfKeypointsService_DownloadInputImageServer interface {
+	Send(*DownloadImageResponse) error
+	grpc.ServerStream
+}
+
+type golfKeypointsServiceDownloadInputImageServer struct {
+	grpc.ServerStream
+}
+
+func (x *golfKeypointsServiceDownloadInputImageServer) Send(m *DownloadImageResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GolfKeypointsService_DownloadCalibrationImage_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DownloadCalibrationImageRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GolfKeypointsServiceServer).DownloadCalibrationImage(m, &golfKeypointsServiceDownloadCalibrationImageServer{stream})
+}
+
+type GolfKeypointsService_DownloadCalibrationImageServer interface {
+	Send(*DownloadImageResponse) error
+	grpc.ServerStream
+}
+
+type golfKeypointsServiceDownloadCalibrationImageServer struct {
+	grpc.ServerStream
+}
+
+func (x *golfKeypointsServiceDownloadCalibrationImageServer) Send(m *DownloadImageResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GolfKeypointsService_DownloadOutputImage_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DownloadOutputImageRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GolfKeypointsServiceServer).DownloadOutputImage(m, &golfKeypointsServiceDownloadOutputImageServer{stream})
+}
+
+type GolfKeypointsService_DownloadOutputImageServer interface {
+	Send(*DownloadImageResponse) error
+	grpc.ServerStream
+}
+
+type golfKeypointsServiceDownloadOutputImageServer struct {
+	grpc.ServerStream
+}
+
+func (x *golfKeypointsServiceDownloadOutputImageServer) Send(m *DownloadImageResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GolfKeypointsService_SubmitAnalysis_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitAnalysisRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GolfKeypointsServiceServer).SubmitAnalysis(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sports_keypoints_proto.GolfKeypointsService/SubmitAnalysis",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GolfKeypointsServiceServer).SubmitAnalysis(ctx, req.(*SubmitAnalysisRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GolfKeypointsService_GetAnalysisJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAnalysisJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GolfKeypointsServiceServer).GetAnalysisJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sports_keypoints_proto.GolfKeypointsService/GetAnalysisJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GolfKeypointsServiceServer).GetAnalysisJob(ctx, req.(*GetAnalysisJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GolfKeypointsService_WatchAnalysisJob_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchAnalysisJobRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GolfKeypointsServiceServer).WatchAnalysisJob(m, &golfKeypointsServiceWatchAnalysisJobServer{stream})
+}
+
+type GolfKeypointsService_WatchAnalysisJobServer interface {
+	Send(*AnalysisJob) error
+	grpc.ServerStream
+}
+
+type golfKeypointsServiceWatchAnalysisJobServer struct {
+	grpc.ServerStream
+}
+
+func (x *golfKeypointsServiceWatchAnalysisJobServer) Send(m *AnalysisJob) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // GolfKeypointsService_ServiceDesc is the grpc.ServiceDesc for GolfKeypointsService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -387,7 +865,50 @@ var GolfKeypointsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteGolfKeypoints",
 			Handler:    _GolfKeypointsService_DeleteGolfKeypoints_Handler,
 		},
+		{
+			MethodName: "ImportGolfKeypoints",
+			Handler:    _GolfKeypointsService_ImportGolfKeypoints_Handler,
+		},
+		{
+			MethodName: "SubmitAnalysis",
+			Handler:    _GolfKeypointsService_SubmitAnalysis_Handler,
+		},
+		{
+			MethodName: "GetAnalysisJob",
+			Handler:    _GolfKeypointsService_GetAnalysisJob_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "UploadInputImageStream",
+			Handler:       _GolfKeypointsService_UploadInputImageStream_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "CalibrateInputImageStream",
+			Handler:       _GolfKeypointsService_CalibrateInputImageStream_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "DownloadInputImage",
+			Handler:       _GolfKeypointsService_DownloadInputImage_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "DownloadCalibrationImage",
+			Handler:       _GolfKeypointsService_DownloadCalibrationImage_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "DownloadOutputImage",
+			Handler:       _GolfKeypointsService_DownloadOutputImage_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchAnalysisJob",
+			Handler:       _GolfKeypointsService_WatchAnalysisJob_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "golfkeypoints.proto",
 }
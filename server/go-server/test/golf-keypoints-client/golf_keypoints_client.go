@@ -3,26 +3,13 @@ package gclient
 import (
 	"context"
 	"fmt"
+	"io"
+	"log"
 
 	skp "github.com/sirfrank96/go-server/sports-keypoints-proto"
 	testutil "github.com/sirfrank96/go-server/test/test-util"
-
-	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 )
 
-// Middle arg is a close function, should be called by calling function
-func InitGolfKeypointsServiceGrpcClient(serveraddr string) (skp.GolfKeypointsServiceClient, func() error, error) {
-	// Set up a connection to the cv_api server.
-	conn, err := grpc.NewClient(serveraddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
-	if err != nil {
-		return nil, conn.Close, err
-	}
-	// Init ComputerVisionGolf grpc client
-	c := skp.NewGolfKeypointsServiceClient(conn)
-	return c, conn.Close, nil
-}
-
 func UploadInputImage(ctx context.Context, gclient skp.GolfKeypointsServiceClient, sessionToken string, inputImgPath string, imageType skp.ImageType) (*skp.UploadInputImageResponse, error) {
 	file, closeFile, err := testutil.GetFileFromPath(inputImgPath)
 	if err != nil {
@@ -101,6 +88,36 @@ func CalculateGolfKeypoints(ctx context.Context, gclient skp.GolfKeypointsServic
 	return response, nil
 }
 
+// Submits an analysis job and watches it until it is done or failed, returns the last state of the job
+func SubmitAnalysisAndWait(ctx context.Context, gclient skp.GolfKeypointsServiceClient, sessionToken string, inputImgId string) (*skp.AnalysisJob, error) {
+	submitResponse, err := gclient.SubmitAnalysis(ctx, &skp.SubmitAnalysisRequest{
+		SessionToken: sessionToken,
+		InputImageId: inputImgId,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not submit analysis: %w", err)
+	}
+	stream, err := gclient.WatchAnalysisJob(ctx, &skp.WatchAnalysisJobRequest{
+		SessionToken: sessionToken,
+		JobId:        submitResponse.JobId,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not watch analysis job %s: %w", submitResponse.JobId, err)
+	}
+	var job *skp.AnalysisJob
+	for {
+		update, err := stream.Recv()
+		if err == io.EOF {
+			return job, nil
+		}
+		if err != nil {
+			return job, fmt.Errorf("could not receive analysis job %s: %w", submitResponse.JobId, err)
+		}
+		log.Printf("Analysis job %s is %s (attempt %d)", update.JobId, update.State.String(), update.Attempts)
+		job = update
+	}
+}
+
 func UpdateBodyKeypoints(ctx context.Context, gclient skp.GolfKeypointsServiceClient, sessionToken string, inputImgId string, newBodyKeypoints *skp.Body25PoseKeypoints) (*skp.UpdateBodyKeypointsResponse, error) {
 	request := &skp.UpdateBodyKeypointsRequest{
 		SessionToken:         sessionToken,
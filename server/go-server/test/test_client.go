@@ -13,13 +13,20 @@ import (
 	"path"
 	"path/filepath"
 
+	keypointsclient "github.com/sirfrank96/go-server/keypoints-client"
 	skp "github.com/sirfrank96/go-server/sports-keypoints-proto"
 	gclient "github.com/sirfrank96/go-server/test/golf-keypoints-client"
 	uclient "github.com/sirfrank96/go-server/test/user-client"
+
+	"google.golang.org/protobuf/encoding/protojson"
 )
 
 var (
 	cvsportsserveraddr = flag.String("addr", "localhost:50052", "the address to connect to")
+	useInsecure        = flag.Bool("insecure", false, "connect over plain TCP instead of TLS")
+	caFile             = flag.String("ca_file", "", "CA file to verify the server certificate with")
+	certFile           = flag.String("cert_file", "", "client cert file, if the server requires client certificates")
+	keyFile            = flag.String("key_file", "", "client key file, if the server requires client certificates")
 )
 
 var currentFileDirectory string
@@ -56,6 +63,15 @@ func testMainCodeFlowDtl(ctx context.Context, uClient skp.UserServiceClient, gCl
 		log.Fatalf("Failed to calculate golf keypoints: %s", err.Error())
 	}
 	log.Printf("Calculate golf keypoints dtl: %+v", calculateGolfKeypointsResponse.GolfKeypoints.DtlGolfSetupPoints)
+
+	analysisJob, err := gclient.SubmitAnalysisAndWait(ctx, gClient, registerUserResponse.SessionToken, uploadInputImageResponse.InputImageId)
+	if err != nil {
+		log.Fatalf("Failed to run analysis job: %s", err.Error())
+	}
+	if analysisJob.State != skp.AnalysisJobState_ANALYSIS_JOB_DONE {
+		log.Fatalf("Analysis job %s failed: %s", analysisJob.JobId, analysisJob.Error)
+	}
+	log.Printf("Analysis job dtl: %+v", analysisJob.GolfKeypoints.DtlGolfSetupPoints)
 	newBodyKeypoints := &skp.Body25PoseKeypoints{
 		LShoulder: &skp.Keypoint{
 			X:          408,
@@ -68,6 +84,22 @@ func testMainCodeFlowDtl(ctx context.Context, uClient skp.UserServiceClient, gCl
 		log.Fatalf("Failed to update body keypoints: %s", err.Error())
 	}
 	log.Printf("Update body keypoints dtl: %+v", updateBodyKeypointsResponse.UpdatedGolfKeypoints.DtlGolfSetupPoints)
+
+	// import the updated keypoints as if they came from another app
+	keypointsJson, err := protojson.Marshal(updateBodyKeypointsResponse.UpdatedGolfKeypoints.BodyKeypoints)
+	if err != nil {
+		log.Fatalf("Failed to marshal body keypoints: %s", err.Error())
+	}
+	keypointsPath := filepath.Join(os.TempDir(), "dtl-feetalign-neutral-keypoints.json")
+	if err := os.WriteFile(keypointsPath, keypointsJson, 0o600); err != nil {
+		log.Fatalf("Failed to write body keypoints: %s", err.Error())
+	}
+	defer os.Remove(keypointsPath)
+	importGolfKeypointsResponse, err := keypointsclient.ImportGolfKeypoints(ctx, gClient, registerUserResponse.SessionToken, uploadInputImageResponse.InputImageId, keypointsPath)
+	if err != nil {
+		log.Fatalf("Failed to import golf keypoints: %s", err.Error())
+	}
+	log.Printf("Import golf keypoints dtl: %+v", importGolfKeypointsResponse.GolfKeypoints.DtlGolfSetupPoints)
 }
 
 func testMainCodeFlowFaceOn(ctx context.Context, uClient skp.UserServiceClient, gClient skp.GolfKeypointsServiceClient) {
@@ -169,14 +201,18 @@ func main() {
 	}
 	currentFileDirectory = path.Dir(executable)
 
+	creds, err := keypointsclient.GetTransportCredentials(*useInsecure, *caFile, *certFile, *keyFile)
+	if err != nil {
+		log.Fatalf("Failed to get transport credentials: %v", err)
+	}
 	// init user grpc client
-	uClient, closeUserConn, err := uclient.InitUserServiceGrpcClient(*cvsportsserveraddr)
+	uClient, closeUserConn, err := uclient.InitUserServiceGrpcClient(*cvsportsserveraddr, creds)
 	if err != nil {
 		log.Fatalf("Failed to connect to user: %v", err)
 	}
 	defer closeUserConn()
 	// init golf keypoints grpc client
-	gClient, closeGolfConn, err := gclient.InitGolfKeypointsServiceGrpcClient(*cvsportsserveraddr)
+	gClient, closeGolfConn, err := keypointsclient.InitGolfKeypointsServiceGrpcClient(*cvsportsserveraddr, creds)
 	if err != nil {
 		log.Fatalf("Failed to connect to golf keypoints: %v", err)
 	}
@@ -6,13 +6,13 @@ import (
 	skp "github.com/sirfrank96/go-server/sports-keypoints-proto"
 
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/credentials"
 )
 
 // Middle arg is a close function, should be called by calling function
-func InitUserServiceGrpcClient(serveraddr string) (skp.UserServiceClient, func() error, error) {
+func InitUserServiceGrpcClient(serveraddr string, creds credentials.TransportCredentials) (skp.UserServiceClient, func() error, error) {
 	// Set up a connection to the cv_api server.
-	conn, err := grpc.NewClient(serveraddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	conn, err := grpc.NewClient(serveraddr, grpc.WithTransportCredentials(creds))
 	if err != nil {
 		return nil, conn.Close, err
 	}
@@ -0,0 +1,84 @@
+// OpenTelemetry tracing for the keypoints server, computervision calls and database operations
+// Spans are exported over OTLP (eg. to a collector, Jaeger or Tempo) or printed to stdout for local use
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+
+	"github.com/sirfrank96/go-server/config"
+	"github.com/sirfrank96/go-server/logging"
+)
+
+const serviceName = "go-server"
+
+var logger = logging.Logger("tracing")
+
+type TracingManager struct {
+	config         config.TracingConfig
+	tracerProvider *sdktrace.TracerProvider
+}
+
+func NewTracingManager(tracingConfig config.TracingConfig) *TracingManager {
+	t := &TracingManager{config: tracingConfig}
+	logger.Info("new tracing mgr")
+	return t
+}
+
+// Sets the global tracer provider and propagator, trace context is propagated through grpc metadata with the w3c traceparent header
+func (t *TracingManager) StartTracing(ctx context.Context) error {
+	logger.InfoContext(ctx, "starting tracing")
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+	var exporter sdktrace.SpanExporter
+	var err error
+	switch t.config.Exporter {
+	case "none", "":
+		logger.InfoContext(ctx, "trace exporter disabled")
+		return nil
+	case "stdout":
+		exporter, err = stdouttrace.New(stdouttrace.WithWriter(os.Stdout), stdouttrace.WithPrettyPrint())
+	case "otlp":
+		var opts []otlptracegrpc.Option
+		if t.config.OtlpEndpoint != "" {
+			opts = append(opts, otlptracegrpc.WithEndpoint(t.config.OtlpEndpoint))
+		}
+		if t.config.OtlpInsecure {
+			opts = append(opts, otlptracegrpc.WithInsecure())
+		}
+		exporter, err = otlptracegrpc.New(ctx, opts...)
+	default:
+		return fmt.Errorf("unknown trace exporter %s, expected none, stdout or otlp", t.config.Exporter)
+	}
+	if err != nil {
+		return fmt.Errorf("could not create %s trace exporter: %w", t.config.Exporter, err)
+	}
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceName(serviceName)))
+	if err != nil {
+		return fmt.Errorf("could not create trace resource: %w", err)
+	}
+	t.tracerProvider = sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(t.config.SampleRatio))),
+	)
+	otel.SetTracerProvider(t.tracerProvider)
+	logger.InfoContext(ctx, "exporting traces", "exporter", t.config.Exporter)
+	return nil
+}
+
+// Flushes spans that have not been exported yet
+func (t *TracingManager) StopTracing(ctx context.Context) error {
+	if t.tracerProvider == nil {
+		return nil
+	}
+	return t.tracerProvider.Shutdown(ctx)
+}
@@ -4,9 +4,26 @@ import (
 	"fmt"
 	"math"
 
+	"github.com/sirfrank96/go-server/config"
 	skp "github.com/sirfrank96/go-server/sports-keypoints-proto"
 )
 
+// Keypoint verification settings from the keypoints section of the config, set once at startup
+var (
+	minKeypointConfidence = 0.5
+	axesToleranceDegrees  = 10.0
+)
+
+func ConfigureKeypointVerification(keypointsConfig config.KeypointsConfig) {
+	minKeypointConfidence = keypointsConfig.MinConfidence
+	axesToleranceDegrees = keypointsConfig.AxesToleranceDegrees
+}
+
+// Keypoints less confident than this get a warning from VerifyKeypoint
+func MinKeypointConfidence() float64 {
+	return minKeypointConfidence
+}
+
 type CalibrationInfo struct {
 	CalibrationType skp.CalibrationType `bson:"calibration_type,omitempty"`
 	FeetLineMethod  skp.FeetLineMethod  `bson:"feet_line_method,omitempty"`
@@ -64,7 +81,6 @@ func VerifyKeypoint(keypoint *skp.Keypoint, keypointName string, threshold float
 	return nil
 }
 
-// TODO: Configure confidence level, configure how far off 90 degrees axes can be
 func VerifyCalibrationImageAxes(keypoints *skp.Body25PoseKeypoints, calibrationInfo *CalibrationInfo) (*CalibrationInfo, Warning) {
 	// Get horizontal axis
 	feetLine, warning := GetFeetLine(keypoints, calibrationInfo.FeetLineMethod)
@@ -73,10 +89,10 @@ func VerifyCalibrationImageAxes(keypoints *skp.Body25PoseKeypoints, calibrationI
 	}
 	horAxisLine := feetLine.Line
 	// Get vertical axis
-	if warning := VerifyKeypoint(keypoints.Midhip, "midhip", 0.5); warning != nil {
+	if warning := VerifyKeypoint(keypoints.Midhip, "midhip", minKeypointConfidence); warning != nil {
 		return nil, warning
 	}
-	if warning := VerifyKeypoint(keypoints.Neck, "neck", 0.5); warning != nil {
+	if warning := VerifyKeypoint(keypoints.Neck, "neck", minKeypointConfidence); warning != nil {
 		return nil, warning
 	}
 	vertAxisLine := GetLine(ConvertKeypointToPoint(keypoints.Midhip), ConvertKeypointToPoint(keypoints.Neck))
@@ -84,13 +100,12 @@ func VerifyCalibrationImageAxes(keypoints *skp.Body25PoseKeypoints, calibrationI
 	horDeg := ConvertSlopeToDegrees(horAxisLine.Slope)
 	vertDeg := ConvertSlopeToDegrees(vertAxisLine.Slope)
 	diff := math.Abs(vertDeg) + math.Abs(horDeg) - 90
-	if math.Abs(diff) > 10 { // make this 5 or less after better test images
+	if math.Abs(diff) > axesToleranceDegrees {
 		return nil, WarningImpl{
 			Severity: SEVERE,
 			Message:  fmt.Sprintf("axes calibration image off. horizontal axis between heels is %f degrees. vertical axis between midhip and neck is %f degrees. difference of %f degrees is too large. please adjust camera, stance, or posture. recommend using alignment sticks to help calibration", horDeg, vertDeg, diff),
 		}
 	}
-	fmt.Printf("Good axes calibration. Horizontal axis between heels is %f degrees. vertical axis between midhip and neck is %f degrees\n", horDeg, vertDeg)
 	calibrationInfo.HorAxisLine = horAxisLine
 	calibrationInfo.VertAxisLine = *vertAxisLine
 	return calibrationInfo, nil
@@ -109,7 +124,6 @@ func VerifyCalibrationImageVanishingPoint(keypoints *skp.Body25PoseKeypoints, ca
 			Message:  fmt.Sprintf("vanishing point calibration image off. feet line slope %f and vertaxis line slope %f are too close (%f). make sure feet line is off centered or make sure alignment stick is pointed at target (parallel lines converge in distance)", feetLine.Line.Slope, calibrationInfo.VertAxisLine.Slope, slopeDiff),
 		}
 	}
-	fmt.Printf("Good vanishing point calibration. feet line is %+v, and vertaxis line is %+v", feetLine.Line, calibrationInfo.VertAxisLine)
 	intersection := GetIntersection(&feetLine.Line, &calibrationInfo.VertAxisLine)
 	calibrationInfo.VanishingPoint = intersection.IntersectPoint
 	return calibrationInfo, nil
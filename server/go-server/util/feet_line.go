@@ -32,14 +32,18 @@ func GetFeetLine(keypoints *skp.Body25PoseKeypoints, feetLineMethod skp.FeetLine
 	return feetLine, warning
 }
 
-// TODO: Configure threshold
 func GetFeetLineInfo(keypoints *skp.Body25PoseKeypoints, feetLineMethod skp.FeetLineMethod) *FeetLineInfo {
-	feetLineInfo := &FeetLineInfo{FeetLineMethod: feetLineMethod, Threshold: 0.5}
+	feetLineInfo := &FeetLineInfo{FeetLineMethod: feetLineMethod, Threshold: minKeypointConfidence}
+	// a missing foot keypoint is left as the zero keypoint, which VerifyFeetLineInfo reports as not found
 	lKeypoint, lKeypointName := GetLeftFootPoint(keypoints, feetLineMethod)
-	feetLineInfo.LKeypoint = *lKeypoint
+	if lKeypoint != nil {
+		feetLineInfo.LKeypoint = *lKeypoint
+	}
 	feetLineInfo.LKeypointName = lKeypointName
 	rKeypoint, rKeypointName := GetRightFootPoint(keypoints, feetLineMethod)
-	feetLineInfo.RKeypoint = *rKeypoint
+	if rKeypoint != nil {
+		feetLineInfo.RKeypoint = *rKeypoint
+	}
 	feetLineInfo.RKeypointName = rKeypointName
 	return feetLineInfo
 }
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/sirfrank96/go-server/config"
+
 	jwt "github.com/golang-jwt/jwt/v5"
 )
 
@@ -11,16 +13,29 @@ import (
 
 var secretKey = []byte("secret-key-example")
 
+// From the auth section of the config, set once at startup
+var sessionTokenLifetime = 24 * time.Hour
+
+func ConfigureAuth(authConfig config.AuthConfig) {
+	sessionTokenLifetime = authConfig.SessionTokenLifetime
+}
+
 type ContextKey string
 
 const UserIdKey ContextKey = "userId"
 const ExpirationKey ContextKey = "exp"
+const PurposeKey ContextKey = "purpose"
+const IssuedAtKey ContextKey = "iat"
+
+// totp challenge tokens are only good for the second step of logging in, not as session tokens
+const totpChallengePurpose = "totp_challenge"
 
 func CreateJWTSessionToken(userId string) (string, error) {
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256,
 		jwt.MapClaims{
 			string(UserIdKey):     userId,
-			string(ExpirationKey): time.Now().Add(time.Hour * 24).Unix(),
+			string(IssuedAtKey):   time.Now().Unix(),
+			string(ExpirationKey): time.Now().Add(sessionTokenLifetime).Unix(),
 		},
 	)
 	sessionToken, err := token.SignedString(secretKey)
@@ -31,9 +46,47 @@ func CreateJWTSessionToken(userId string) (string, error) {
 }
 
 func VerifyJWTSessionToken(sessionToken string) (*jwt.MapClaims, error) {
-	token, err := jwt.Parse(sessionToken, func(token *jwt.Token) (interface{}, error) {
+	claims, err := verifyJWTToken(sessionToken)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := (*claims)[string(PurposeKey)]; ok {
+		return nil, fmt.Errorf("token is not a session token")
+	}
+	return claims, nil
+}
+
+func CreateJWTTotpChallengeToken(userId string) (string, error) {
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256,
+		jwt.MapClaims{
+			string(UserIdKey):     userId,
+			string(PurposeKey):    totpChallengePurpose,
+			string(IssuedAtKey):   time.Now().Unix(),
+			string(ExpirationKey): time.Now().Add(time.Minute * 5).Unix(),
+		},
+	)
+	challengeToken, err := token.SignedString(secretKey)
+	if err != nil {
+		return "", fmt.Errorf("could not sign token: %w", err)
+	}
+	return challengeToken, nil
+}
+
+func VerifyJWTTotpChallengeToken(challengeToken string) (*jwt.MapClaims, error) {
+	claims, err := verifyJWTToken(challengeToken)
+	if err != nil {
+		return nil, err
+	}
+	if purpose, ok := (*claims)[string(PurposeKey)]; !ok || purpose != totpChallengePurpose {
+		return nil, fmt.Errorf("token is not a totp challenge token")
+	}
+	return claims, nil
+}
+
+func verifyJWTToken(tokenString string) (*jwt.MapClaims, error) {
+	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
 		return secretKey, nil
-	})
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}))
 	if err != nil {
 		return nil, fmt.Errorf("unable to verify session token: %w", err)
 	}
@@ -55,3 +108,12 @@ func GetUserIdFromClaims(claims *jwt.MapClaims) (string, error) {
 	}
 	return fmt.Sprint(userId), nil
 }
+
+// Unix seconds, 0 for tokens issued before session tokens had an issued at claim
+func GetIssuedAtFromClaims(claims *jwt.MapClaims) int64 {
+	issuedAt, err := claims.GetIssuedAt()
+	if err != nil || issuedAt == nil {
+		return 0
+	}
+	return issuedAt.Unix()
+}
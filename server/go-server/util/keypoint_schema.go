@@ -0,0 +1,73 @@
+package util
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+
+	skp "github.com/sirfrank96/go-server/sports-keypoints-proto"
+)
+
+// Body25PoseKeypoints field of every landmark of a pose model, by landmark index. Landmarks without a Body25 keypoint
+// are dropped.
+var schemaLandmarks = map[skp.KeypointSchema][]string{
+	// openpose order, the same as the Body25PoseKeypoints fields
+	skp.KeypointSchema_KEYPOINT_SCHEMA_BODY25: {
+		"Nose", "Neck", "RShoulder", "RElbow", "RWrist", "LShoulder", "LElbow", "LWrist",
+		"Midhip", "RHip", "RKnee", "RAnkle", "LHip", "LKnee", "LAnkle",
+		"REye", "LEye", "REar", "LEar",
+		"LBigToe", "LSmallToe", "LHeel", "RBigToe", "RSmallToe", "RHeel",
+	},
+	skp.KeypointSchema_KEYPOINT_SCHEMA_COCO17: {
+		"Nose", "LEye", "REye", "LEar", "REar",
+		"LShoulder", "RShoulder", "LElbow", "RElbow", "LWrist", "RWrist",
+		"LHip", "RHip", "LKnee", "RKnee", "LAnkle", "RAnkle",
+	},
+	skp.KeypointSchema_KEYPOINT_SCHEMA_MEDIAPIPE33: {
+		// inner and outer eyes and the mouth
+		"Nose", "", "LEye", "", "", "REye", "", "LEar", "REar", "", "",
+		"LShoulder", "RShoulder", "LElbow", "RElbow", "LWrist", "RWrist",
+		// pinkies, index fingers and thumbs
+		"", "", "", "", "", "",
+		"LHip", "RHip", "LKnee", "RKnee", "LAnkle", "RAnkle",
+		// the foot index is the tip of the foot, closest to the big toe
+		"LHeel", "RHeel", "LBigToe", "RBigToe",
+	},
+}
+
+// Converts the landmarks of a pose model to Body25. Keypoints the model does not have (eg. heels in COCO-17) are left
+// nil so VerifyKeypoint reports them missing. Models without a neck or midhip get the midpoints of the shoulders and hips.
+func ConvertLandmarksToBody25(schema skp.KeypointSchema, landmarks []*skp.Keypoint) (*skp.Body25PoseKeypoints, error) {
+	fields, ok := schemaLandmarks[schema]
+	if !ok {
+		return nil, fmt.Errorf("can not convert %s landmarks to body25", schema.String())
+	}
+	if len(landmarks) != len(fields) {
+		return nil, fmt.Errorf("got %d %s landmarks, expected %d", len(landmarks), schema.String(), len(fields))
+	}
+	keypoints := &skp.Body25PoseKeypoints{}
+	keypointsVal := reflect.ValueOf(keypoints).Elem()
+	for i, field := range fields {
+		if field == "" || !CheckIfKeypointExists(landmarks[i]) {
+			continue
+		}
+		keypointsVal.FieldByName(field).Set(reflect.ValueOf(landmarks[i]))
+	}
+	if schema != skp.KeypointSchema_KEYPOINT_SCHEMA_BODY25 {
+		keypoints.Neck = getMidpointKeypoint(keypoints.LShoulder, keypoints.RShoulder)
+		keypoints.Midhip = getMidpointKeypoint(keypoints.LHip, keypoints.RHip)
+	}
+	return keypoints, nil
+}
+
+// Midpoint with the lower confidence of the two keypoints, nil if either is missing
+func getMidpointKeypoint(keypoint1 *skp.Keypoint, keypoint2 *skp.Keypoint) *skp.Keypoint {
+	if !CheckIfKeypointExists(keypoint1) || !CheckIfKeypointExists(keypoint2) {
+		return nil
+	}
+	return &skp.Keypoint{
+		X:          (keypoint1.X + keypoint2.X) / 2,
+		Y:          (keypoint1.Y + keypoint2.Y) / 2,
+		Confidence: math.Min(keypoint1.Confidence, keypoint2.Confidence),
+	}
+}
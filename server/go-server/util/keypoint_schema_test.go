@@ -0,0 +1,67 @@
+package util
+
+import (
+	"testing"
+
+	skp "github.com/sirfrank96/go-server/sports-keypoints-proto"
+)
+
+// Landmark i is at i+1,i+1 so every landmark exists
+func newLandmarks(n int) []*skp.Keypoint {
+	landmarks := make([]*skp.Keypoint, n)
+	for i := range landmarks {
+		landmarks[i] = &skp.Keypoint{X: float64(i + 1), Y: float64(i + 1), Confidence: 0.9}
+	}
+	return landmarks
+}
+
+func TestConvertCoco17ToBody25(t *testing.T) {
+	landmarks := newLandmarks(17)
+	// the left knee was not detected
+	landmarks[13] = &skp.Keypoint{}
+	keypoints, err := ConvertLandmarksToBody25(skp.KeypointSchema_KEYPOINT_SCHEMA_COCO17, landmarks)
+	if err != nil {
+		t.Fatalf("ConvertLandmarksToBody25 had an unexpected error: %s", err.Error())
+	}
+	if keypoints.Nose.X != 1 || keypoints.LShoulder.X != 6 || keypoints.RShoulder.X != 7 || keypoints.RAnkle.X != 17 {
+		t.Errorf("coco17 landmarks were mapped to the wrong keypoints: %v", keypoints)
+	}
+	if keypoints.Neck.X != 6.5 || keypoints.Midhip.X != 12.5 {
+		t.Errorf("neck is at %f and midhip at %f, expected the midpoints 6.5 and 12.5", keypoints.Neck.X, keypoints.Midhip.X)
+	}
+	if keypoints.LKnee != nil {
+		t.Errorf("left knee is %v, expected it missing since it was not detected", keypoints.LKnee)
+	}
+	// coco17 has no feet
+	for name, keypoint := range map[string]*skp.Keypoint{"left heel": keypoints.LHeel, "right big toe": keypoints.RBigToe, "left small toe": keypoints.LSmallToe} {
+		warning := VerifyKeypoint(keypoint, name, 0.5)
+		if warning == nil || warning.GetSeverity() != SEVERE {
+			t.Errorf("VerifyKeypoint(%s) returned %v, expected a severe warning for a missing keypoint", name, warning)
+		}
+	}
+}
+
+func TestConvertMediaPipe33ToBody25(t *testing.T) {
+	keypoints, err := ConvertLandmarksToBody25(skp.KeypointSchema_KEYPOINT_SCHEMA_MEDIAPIPE33, newLandmarks(33))
+	if err != nil {
+		t.Fatalf("ConvertLandmarksToBody25 had an unexpected error: %s", err.Error())
+	}
+	if keypoints.LEye.X != 3 || keypoints.REar.X != 9 || keypoints.RWrist.X != 17 || keypoints.LHip.X != 24 {
+		t.Errorf("mediapipe33 landmarks were mapped to the wrong keypoints: %v", keypoints)
+	}
+	if keypoints.LHeel.X != 30 || keypoints.RHeel.X != 31 || keypoints.LBigToe.X != 32 || keypoints.RBigToe.X != 33 {
+		t.Errorf("mediapipe33 feet were mapped to the wrong keypoints: %v", keypoints)
+	}
+	if keypoints.LSmallToe != nil || keypoints.RSmallToe != nil {
+		t.Errorf("small toes are %v and %v, expected them missing", keypoints.LSmallToe, keypoints.RSmallToe)
+	}
+}
+
+func TestConvertLandmarksToBody25Errors(t *testing.T) {
+	if _, err := ConvertLandmarksToBody25(skp.KeypointSchema_KEYPOINT_SCHEMA_COCO17, newLandmarks(33)); err == nil {
+		t.Errorf("converting 33 coco17 landmarks is supposed to fail")
+	}
+	if _, err := ConvertLandmarksToBody25(skp.KeypointSchema_KEYPOINT_SCHEMA_UNSPECIFIED, newLandmarks(25)); err == nil {
+		t.Errorf("converting landmarks without a schema is supposed to fail")
+	}
+}
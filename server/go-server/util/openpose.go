@@ -0,0 +1,47 @@
+package util
+
+import (
+	"encoding/json"
+	"fmt"
+
+	skp "github.com/sirfrank96/go-server/sports-keypoints-proto"
+)
+
+// Output of openpose --write_json, one *_keypoints.json per image. Only the body keypoints are read.
+type openPoseOutput struct {
+	People []struct {
+		// x, y and confidence of every body25 keypoint in openpose order
+		PoseKeypoints2d []float64 `json:"pose_keypoints_2d"`
+	} `json:"people"`
+}
+
+// Parses openpose *_keypoints.json output into Body25 keypoints. The person with the highest total confidence is used
+// if openpose found several (eg. someone in the background). Keypoints openpose did not find (0,0) are left nil.
+func ParseOpenPoseKeypoints(data []byte) (*skp.Body25PoseKeypoints, error) {
+	var output openPoseOutput
+	if err := json.Unmarshal(data, &output); err != nil {
+		return nil, fmt.Errorf("could not parse openpose json: %w", err)
+	}
+	if len(output.People) == 0 {
+		return nil, fmt.Errorf("openpose did not find a person")
+	}
+	var best []float64
+	bestConfidence := -1.0
+	for i, person := range output.People {
+		if len(person.PoseKeypoints2d) != 25*3 {
+			return nil, fmt.Errorf("person %d has %d pose_keypoints_2d values, expected %d for body25", i, len(person.PoseKeypoints2d), 25*3)
+		}
+		confidence := 0.0
+		for j := 2; j < len(person.PoseKeypoints2d); j += 3 {
+			confidence += person.PoseKeypoints2d[j]
+		}
+		if confidence > bestConfidence {
+			best, bestConfidence = person.PoseKeypoints2d, confidence
+		}
+	}
+	landmarks := make([]*skp.Keypoint, 25)
+	for i := range landmarks {
+		landmarks[i] = &skp.Keypoint{X: best[3*i], Y: best[3*i+1], Confidence: best[3*i+2]}
+	}
+	return ConvertLandmarksToBody25(skp.KeypointSchema_KEYPOINT_SCHEMA_BODY25, landmarks)
+}
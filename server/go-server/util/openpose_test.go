@@ -0,0 +1,43 @@
+package util
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+// openpose output with one person per confidence, every keypoint of person i is at i+1,i+1
+func newOpenPoseJson(t *testing.T, confidences ...float64) []byte {
+	var people []map[string]any
+	for i, confidence := range confidences {
+		keypoints := make([]float64, 0, 25*3)
+		for range 25 {
+			keypoints = append(keypoints, float64(i+1), float64(i+1), confidence)
+		}
+		people = append(people, map[string]any{"person_id": []int{-1}, "pose_keypoints_2d": keypoints, "face_keypoints_2d": []float64{}})
+	}
+	data, err := json.Marshal(map[string]any{"version": 1.3, "people": people})
+	if err != nil {
+		t.Fatalf("could not marshal openpose json: %s", err.Error())
+	}
+	return data
+}
+
+func TestParseOpenPoseKeypoints(t *testing.T) {
+	// the second person is the golfer
+	keypoints, err := ParseOpenPoseKeypoints(newOpenPoseJson(t, 0.2, 0.9))
+	if err != nil {
+		t.Fatalf("ParseOpenPoseKeypoints had an unexpected error: %s", err.Error())
+	}
+	if keypoints.Nose.X != 2 || keypoints.RHeel.X != 2 || keypoints.Neck.Confidence != 0.9 {
+		t.Errorf("ParseOpenPoseKeypoints returned %v, expected the keypoints of the most confident person", keypoints)
+	}
+	if _, err := ParseOpenPoseKeypoints(newOpenPoseJson(t)); err == nil {
+		t.Errorf("parsing openpose json without people is supposed to fail")
+	}
+	if _, err := ParseOpenPoseKeypoints([]byte(`{"people": [{"pose_keypoints_2d": [1, 2, 0.5]}]}`)); err == nil {
+		t.Errorf("parsing openpose json that is not body25 is supposed to fail")
+	}
+	if _, err := ParseOpenPoseKeypoints([]byte(`not json`)); err == nil {
+		t.Errorf("parsing invalid json is supposed to fail")
+	}
+}
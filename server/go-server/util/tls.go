@@ -0,0 +1,112 @@
+package util
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/sirfrank96/go-server/logging"
+)
+
+var logger = logging.Logger("util")
+
+// minimum time between checking the cert and key files for changes
+const certReloadInterval = 10 * time.Second
+
+// Serves a certificate and key pair from files, reloads them when the files change so certificates can be rotated without a restart
+type CertReloader struct {
+	mutex       sync.Mutex
+	certFile    string
+	keyFile     string
+	cert        *tls.Certificate
+	certModTime time.Time
+	keyModTime  time.Time
+	lastCheck   time.Time
+}
+
+func NewCertReloader(certFile string, keyFile string) (*CertReloader, error) {
+	if certFile == "" || keyFile == "" {
+		return nil, fmt.Errorf("tls needs both a cert file and a key file")
+	}
+	c := &CertReloader{
+		certFile: certFile,
+		keyFile:  keyFile,
+	}
+	if err := c.reload(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// For tls.Config.GetCertificate (server side)
+func (c *CertReloader) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	return c.getCertificate(), nil
+}
+
+// For tls.Config.GetClientCertificate (client side of mtls)
+func (c *CertReloader) GetClientCertificate(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+	return c.getCertificate(), nil
+}
+
+// If a reload fails (eg. cert written but key not written yet), keeps serving the last good certificate
+func (c *CertReloader) getCertificate() *tls.Certificate {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if time.Since(c.lastCheck) >= certReloadInterval {
+		c.lastCheck = time.Now()
+		if c.filesChanged() {
+			if err := c.reload(); err != nil {
+				logger.Error("could not reload certificate, keeping previous certificate", "cert_file", c.certFile, "error", err)
+			}
+		}
+	}
+	return c.cert
+}
+
+func (c *CertReloader) filesChanged() bool {
+	certInfo, err := os.Stat(c.certFile)
+	if err != nil {
+		return false
+	}
+	keyInfo, err := os.Stat(c.keyFile)
+	if err != nil {
+		return false
+	}
+	return !certInfo.ModTime().Equal(c.certModTime) || !keyInfo.ModTime().Equal(c.keyModTime)
+}
+
+func (c *CertReloader) reload() error {
+	certInfo, err := os.Stat(c.certFile)
+	if err != nil {
+		return fmt.Errorf("could not stat cert file: %w", err)
+	}
+	keyInfo, err := os.Stat(c.keyFile)
+	if err != nil {
+		return fmt.Errorf("could not stat key file: %w", err)
+	}
+	cert, err := tls.LoadX509KeyPair(c.certFile, c.keyFile)
+	if err != nil {
+		return fmt.Errorf("could not load key pair %s, %s: %w", c.certFile, c.keyFile, err)
+	}
+	c.cert = &cert
+	c.certModTime = certInfo.ModTime()
+	c.keyModTime = keyInfo.ModTime()
+	logger.Info("loaded certificate", "cert_file", c.certFile)
+	return nil
+}
+
+// Reads a pem file with one or more CA certificates
+func LoadCertPool(caFile string) (*x509.CertPool, error) {
+	bytes, err := os.ReadFile(caFile)
+	if err != nil {
+		return nil, fmt.Errorf("could not read ca file %s: %w", caFile, err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(bytes) {
+		return nil, fmt.Errorf("no certificates found in ca file %s", caFile)
+	}
+	return pool, nil
+}
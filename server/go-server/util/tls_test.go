@@ -0,0 +1,99 @@
+package util
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// Writes a self signed cert and key with the given common name to the given files
+func writeSelfSignedCert(t *testing.T, certFile string, keyFile string, commonName string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("could not generate key: %s", err.Error())
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		IsCA:         true,
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("could not create certificate: %s", err.Error())
+	}
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("could not marshal key: %s", err.Error())
+	}
+	if err := os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600); err != nil {
+		t.Fatalf("could not write cert: %s", err.Error())
+	}
+	if err := os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0600); err != nil {
+		t.Fatalf("could not write key: %s", err.Error())
+	}
+}
+
+func getCommonName(t *testing.T, c *CertReloader) string {
+	cert, err := c.GetCertificate(nil)
+	if err != nil {
+		t.Fatalf("GetCertificate had an unexpected error: %s", err.Error())
+	}
+	leaf, err := x509.ParseCertificate(cert.Certificate[0])
+	if err != nil {
+		t.Fatalf("could not parse certificate: %s", err.Error())
+	}
+	return leaf.Subject.CommonName
+}
+
+func TestCertReloader(t *testing.T) {
+	dir := t.TempDir()
+	certFile := filepath.Join(dir, "server.crt")
+	keyFile := filepath.Join(dir, "server.key")
+	// missing files
+	if _, err := NewCertReloader(certFile, keyFile); err == nil {
+		t.Errorf("NewCertReloader with missing files is supposed to have an error")
+	}
+	writeSelfSignedCert(t, certFile, keyFile, "first")
+	c, err := NewCertReloader(certFile, keyFile)
+	if err != nil {
+		t.Fatalf("NewCertReloader had an unexpected error: %s", err.Error())
+	}
+	if name := getCommonName(t, c); name != "first" {
+		t.Errorf("GetCertificate returned cert %s, expected first", name)
+	}
+	// rotated cert is picked up on the next check
+	writeSelfSignedCert(t, certFile, keyFile, "second")
+	future := time.Now().Add(time.Minute)
+	os.Chtimes(certFile, future, future)
+	os.Chtimes(keyFile, future, future)
+	c.lastCheck = time.Time{}
+	if name := getCommonName(t, c); name != "second" {
+		t.Errorf("GetCertificate returned cert %s after rotation, expected second", name)
+	}
+	// broken rotation keeps serving the previous cert
+	os.WriteFile(keyFile, []byte("not a key"), 0600)
+	future = future.Add(time.Minute)
+	os.Chtimes(keyFile, future, future)
+	c.lastCheck = time.Time{}
+	if name := getCommonName(t, c); name != "second" {
+		t.Errorf("GetCertificate returned cert %s after bad rotation, expected second", name)
+	}
+	// ca pool
+	if _, err := LoadCertPool(certFile); err != nil {
+		t.Errorf("LoadCertPool had an unexpected error: %s", err.Error())
+	}
+	if _, err := LoadCertPool(keyFile); err == nil {
+		t.Errorf("LoadCertPool with no certificates is supposed to have an error")
+	}
+}
@@ -0,0 +1,107 @@
+package util
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// RFC 6238 TOTP with the defaults authenticator apps expect (HMAC-SHA1, 6 digits, 30 second steps)
+const (
+	TotpIssuer         = "sports-keypoints"
+	totpSecretSize     = 20
+	totpDigits         = 6
+	totpPeriod         = 30
+	totpAllowedSkew    = 1 // number of steps before/after the current step that are still accepted
+	recoveryCodeCount  = 10
+	recoveryCodeLength = 10
+)
+
+var base32NoPadding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+func GenerateTotpSecret() (string, error) {
+	secret := make([]byte, totpSecretSize)
+	if _, err := rand.Read(secret); err != nil {
+		return "", fmt.Errorf("could not generate totp secret: %w", err)
+	}
+	return base32NoPadding.EncodeToString(secret), nil
+}
+
+// Returns an otpauth:// uri (https://github.com/google/google-authenticator/wiki/Key-Uri-Format) that can be rendered as a qr code
+func GetTotpProvisioningURI(accountName string, secret string) string {
+	label := url.PathEscape(fmt.Sprintf("%s:%s", TotpIssuer, accountName))
+	params := url.Values{}
+	params.Set("secret", secret)
+	params.Set("issuer", TotpIssuer)
+	params.Set("algorithm", "SHA1")
+	params.Set("digits", fmt.Sprint(totpDigits))
+	params.Set("period", fmt.Sprint(totpPeriod))
+	return fmt.Sprintf("otpauth://totp/%s?%s", label, params.Encode())
+}
+
+func GetTotpStep(t time.Time) int64 {
+	return t.Unix() / totpPeriod
+}
+
+func GenerateTotpCode(secret string, t time.Time) (string, error) {
+	return generateTotpCodeForStep(secret, GetTotpStep(t))
+}
+
+// Checks code against the current step and the steps within the allowed skew
+// Returns the matched step so callers can reject a code that has already been used
+func VerifyTotpCode(secret string, code string, t time.Time) (int64, bool) {
+	if len(code) != totpDigits {
+		return 0, false
+	}
+	currStep := GetTotpStep(t)
+	for step := currStep - totpAllowedSkew; step <= currStep+totpAllowedSkew; step++ {
+		expected, err := generateTotpCodeForStep(secret, step)
+		if err != nil {
+			return 0, false
+		}
+		if subtle.ConstantTimeCompare([]byte(expected), []byte(code)) == 1 {
+			return step, true
+		}
+	}
+	return 0, false
+}
+
+func generateTotpCodeForStep(secret string, step int64) (string, error) {
+	key, err := base32NoPadding.DecodeString(strings.ToUpper(strings.TrimRight(secret, "=")))
+	if err != nil {
+		return "", fmt.Errorf("could not decode totp secret: %w", err)
+	}
+	msg := make([]byte, 8)
+	binary.BigEndian.PutUint64(msg, uint64(step))
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg)
+	sum := mac.Sum(nil)
+	// dynamic truncation (RFC 4226 section 5.3)
+	offset := sum[len(sum)-1] & 0x0f
+	binCode := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+	mod := uint32(1)
+	for i := 0; i < totpDigits; i++ {
+		mod *= 10
+	}
+	return fmt.Sprintf("%0*d", totpDigits, binCode%mod), nil
+}
+
+// Recovery codes are random lowercase base32 strings so they are easy to type
+func GenerateRecoveryCodes() ([]string, error) {
+	var codes []string
+	for i := 0; i < recoveryCodeCount; i++ {
+		raw := make([]byte, recoveryCodeLength)
+		if _, err := rand.Read(raw); err != nil {
+			return nil, fmt.Errorf("could not generate recovery code: %w", err)
+		}
+		codes = append(codes, strings.ToLower(base32NoPadding.EncodeToString(raw)[:recoveryCodeLength]))
+	}
+	return codes, nil
+}
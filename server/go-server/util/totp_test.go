@@ -0,0 +1,69 @@
+package util
+
+import (
+	"encoding/base32"
+	"strings"
+	"testing"
+	"time"
+)
+
+// RFC 6238 appendix B test vectors (SHA1), truncated to 6 digits
+func TestGenerateTotpCode(t *testing.T) {
+	secret := base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString([]byte("12345678901234567890"))
+	testVectors := map[int64]string{
+		59:          "287082",
+		1111111109:  "081804",
+		1111111111:  "050471",
+		1234567890:  "005924",
+		2000000000:  "279037",
+		20000000000: "353130",
+	}
+	for unixTime, expected := range testVectors {
+		res, err := GenerateTotpCode(secret, time.Unix(unixTime, 0))
+		if err != nil {
+			t.Errorf("GenerateTotpCode(%d) had an unexpected error: %s", unixTime, err.Error())
+		}
+		if res != expected {
+			t.Errorf("GenerateTotpCode(%d) returned %s, expected %s", unixTime, res, expected)
+		}
+	}
+}
+
+func TestVerifyTotpCode(t *testing.T) {
+	secret, err := GenerateTotpSecret()
+	if err != nil {
+		t.Fatalf("GenerateTotpSecret() had an unexpected error: %s", err.Error())
+	}
+	now := time.Now()
+	code, err := GenerateTotpCode(secret, now)
+	if err != nil {
+		t.Fatalf("GenerateTotpCode() had an unexpected error: %s", err.Error())
+	}
+	// current step
+	step, ok := VerifyTotpCode(secret, code, now)
+	if !ok || step != GetTotpStep(now) {
+		t.Errorf("VerifyTotpCode(%s) is supposed to verify for current step", code)
+	}
+	// 30 seconds later the code is from the previous step, which is within skew
+	if _, ok := VerifyTotpCode(secret, code, now.Add(30*time.Second)); !ok {
+		t.Errorf("VerifyTotpCode(%s) is supposed to verify 30 seconds later", code)
+	}
+	// too old
+	if _, ok := VerifyTotpCode(secret, code, now.Add(5*time.Minute)); ok {
+		t.Errorf("VerifyTotpCode(%s) is not supposed to verify 5 minutes later", code)
+	}
+	// bad format
+	if _, ok := VerifyTotpCode(secret, "abc", now); ok {
+		t.Errorf("VerifyTotpCode(abc) is not supposed to verify")
+	}
+}
+
+func TestGetTotpProvisioningURI(t *testing.T) {
+	uri := GetTotpProvisioningURI("user 1", "ABCDEF")
+	if !strings.HasPrefix(uri, "otpauth://totp/sports-keypoints:user%201?") {
+		t.Errorf("GetTotpProvisioningURI returned %s, has the wrong label", uri)
+	}
+	if !strings.Contains(uri, "secret=ABCDEF") || !strings.Contains(uri, "issuer=sports-keypoints") {
+		t.Errorf("GetTotpProvisioningURI returned %s, missing secret or issuer", uri)
+	}
+}